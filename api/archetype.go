@@ -0,0 +1,234 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+type ArchetypeDatabase interface {
+	CreateArchetype(archetype *model.Archetype, dedicationFeatID uint, featIDs []uint) error
+	GetArchetypeByID(id uint) (*model.Archetype, error)
+	GetArchetypes() ([]*model.Archetype, error)
+	UpdateArchetype(archetype *model.Archetype, dedicationFeatID uint, featIDs []uint) error
+	DeleteArchetype(id uint) error
+	GetFeatByID(id uint) (*model.Feat, error)
+	GetCharacterClassByID(id uint) (*model.CharacterClass, error)
+}
+
+type ArchetypeApi struct {
+	DB ArchetypeDatabase
+}
+
+// CreateArchetype godoc
+//
+// @Summary Create and returns Archetype
+// @Description Permissions for Admin, a multiclass archetype links the class it grants proficiencies or spellcasting from,
+// @Description proficiency target is a defence column (e.g. martial_weapon, heavy_armor, will) or a skill name
+// @Tags Archetype
+// @Accept json
+// @Produce json
+// @Param archetype body model.ArchetypeCreate true "Archetype data"
+// @Success 201 {object} model.ArchetypeExternal "Archetype details"
+// @Failure 400 {string} string "Wrong archetype data"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /archetype [post]
+func (a *ArchetypeApi) CreateArchetype(ctx *gin.Context) {
+	archetype := &model.ArchetypeCreate{}
+	if err := ctx.ShouldBindJSON(archetype); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal, ok := a.toInternalArchetype(ctx, archetype)
+	if !ok {
+		return
+	}
+	err := a.DB.CreateArchetype(internal, archetype.DedicationFeatID, archetype.FeatIDs)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	newArchetype, err := a.DB.GetArchetypeByID(internal.ID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToExternalArchetype(newArchetype))
+}
+
+// GetArchetypes godoc
+//
+// @Summary Returns Archetypes
+// @Description Permissions for auth users
+// @Tags Archetype
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.ArchetypeExternal "Archetype details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /archetype [get]
+func (a *ArchetypeApi) GetArchetypes(ctx *gin.Context) {
+	archetypes, err := a.DB.GetArchetypes()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.ArchetypeExternal{}
+	for _, archetype := range archetypes {
+		resp = append(resp, ToExternalArchetype(archetype))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetArchetypeByID godoc
+//
+// @Summary Returns Archetype by ID
+// @Description Permissions for auth users
+// @Tags Archetype
+// @Accept json
+// @Produce json
+// @Param id path int true "Archetype id"
+// @Success 200 {object} model.ArchetypeExternal "Archetype details"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Archetype doesn't exist"
+// @Router /archetype/{id} [get]
+func (a *ArchetypeApi) GetArchetypeByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		archetype, err := a.DB.GetArchetypeByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if archetype == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Archetype doesn't exist"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalArchetype(archetype))
+	})
+}
+
+// UpdateArchetype godoc
+//
+// @Summary Updates Archetype by ID
+// @Description Permissions for Admin, feats and proficiencies are replaced
+// @Tags Archetype
+// @Accept json
+// @Produce json
+// @Param id path int true "Archetype id"
+// @Param archetype body model.ArchetypeCreate true "Archetype data"
+// @Success 200 {object} model.ArchetypeExternal "Archetype details"
+// @Failure 400 {string} string "Wrong archetype data"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Archetype doesn't exist"
+// @Router /archetype/{id} [patch]
+func (a *ArchetypeApi) UpdateArchetype(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		archetype := &model.ArchetypeCreate{}
+		if err := ctx.ShouldBindJSON(archetype); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldArchetype, err := a.DB.GetArchetypeByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldArchetype == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Archetype doesn't exist"})
+			return
+		}
+		internal, ok := a.toInternalArchetype(ctx, archetype)
+		if !ok {
+			return
+		}
+		internal.ID = oldArchetype.ID
+		err = a.DB.UpdateArchetype(internal, archetype.DedicationFeatID, archetype.FeatIDs)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		newArchetype, err := a.DB.GetArchetypeByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalArchetype(newArchetype))
+	})
+}
+
+// DeleteArchetype godoc
+//
+// @Summary Deletes Archetype by ID
+// @Description Permissions for Admin, its feats stay as plain feats
+// @Tags Archetype
+// @Accept json
+// @Produce json
+// @Param id path int true "Archetype id"
+// @Success 204
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Archetype doesn't exist"
+// @Router /archetype/{id} [delete]
+func (a *ArchetypeApi) DeleteArchetype(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		archetype, err := a.DB.GetArchetypeByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if archetype == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Archetype doesn't exist"})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteArchetype(id)); !success {
+			return
+		}
+		ctx.JSON(http.StatusNoContent, gin.H{"error": "Archetype was deleted"})
+	})
+}
+
+func (a *ArchetypeApi) toInternalArchetype(ctx *gin.Context, archetype *model.ArchetypeCreate) (*model.Archetype, bool) {
+	if feat, err := a.DB.GetFeatByID(archetype.DedicationFeatID); err != nil || feat == nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Dedication feat not found"})
+		return nil, false
+	}
+	if archetype.CharacterClassID != nil {
+		if characterClass, err := a.DB.GetCharacterClassByID(*archetype.CharacterClassID); err != nil || characterClass == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character class not found"})
+			return nil, false
+		}
+	} else if archetype.Spellcasting {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Only a multiclass archetype grants spellcasting"})
+		return nil, false
+	}
+	internal := &model.Archetype{
+		Name:             archetype.Name,
+		Description:      archetype.Description,
+		CharacterClassID: archetype.CharacterClassID,
+		Spellcasting:     archetype.Spellcasting,
+	}
+	for _, proficiency := range archetype.Proficiencies {
+		internal.Proficiencies = append(internal.Proficiencies, model.ArchetypeProficiency{
+			Target:  proficiency.Target,
+			Mastery: proficiency.Mastery,
+		})
+	}
+	return internal, true
+}
+
+func ToExternalArchetype(archetype *model.Archetype) *model.ArchetypeExternal {
+	external := &model.ArchetypeExternal{
+		ID:               archetype.ID,
+		Name:             archetype.Name,
+		Description:      archetype.Description,
+		CharacterClassID: archetype.CharacterClassID,
+		Spellcasting:     archetype.Spellcasting,
+		Feats:            []*model.FeatExternal{},
+		Proficiencies:    []model.ArchetypeProficiencyExternal{},
+	}
+	for i := range archetype.Feats {
+		feat := &archetype.Feats[i]
+		if feat.Dedication {
+			external.DedicationFeatID = &feat.ID
+		}
+		external.Feats = append(external.Feats, ToExternalFeat(feat))
+	}
+	for _, proficiency := range archetype.Proficiencies {
+		external.Proficiencies = append(external.Proficiencies, model.ArchetypeProficiencyExternal{
+			Target:  proficiency.Target,
+			Mastery: proficiency.Mastery,
+		})
+	}
+	return external
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
 	"net/http"
@@ -21,8 +22,28 @@ import (
 func (a *ItemApi) CreateArmor(ctx *gin.Context) {
 	armor := &model.CreateArmor{}
 	if err := ctx.ShouldBindJSON(armor); err == nil {
+		if armor.ArmorClass == nil || armor.Level == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "armor_class and level are required"})
+			return
+		}
+		traits, err := a.findArmorTraits(armor.TraitsID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		category := armor.Category
+		if category == "" {
+			category = model.LightArmor
+		}
 		internalArmor := &model.Armor{
-			ArmorClass: *armor.ArmorClass,
+			ArmorClass:   *armor.ArmorClass,
+			Category:     category,
+			DexCap:       armor.DexCap,
+			CheckPenalty: armor.CheckPenalty,
+			SpeedPenalty: armor.SpeedPenalty,
+			Strength:     armor.Strength,
+			ArmorGroup:   armor.ArmorGroup,
+			Traits:       traits,
 		}
 		internalItem := &model.Item{
 			Name:        armor.Name,
@@ -32,6 +53,9 @@ func (a *ItemApi) CreateArmor(ctx *gin.Context) {
 			Price:       armor.Price,
 			OwnerType:   "armors",
 		}
+		if !priceItem(ctx, internalItem) {
+			return
+		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateArmor(internalArmor, internalItem)); !success {
 			ctx.JSON(http.StatusInternalServerError, success)
 			return
@@ -110,36 +134,86 @@ func (a *ItemApi) UpdateArmor(ctx *gin.Context) {
 				return
 			}
 			internalArmor := &model.Armor{
-				ArmorClass: *armor.ArmorClass,
-				ID:         oldArmor.ID,
+				ID:           oldArmor.ID,
+				ArmorClass:   oldArmor.ArmorClass,
+				Category:     oldArmor.Category,
+				DexCap:       armor.DexCap,
+				CheckPenalty: armor.CheckPenalty,
+				SpeedPenalty: armor.SpeedPenalty,
+				Strength:     armor.Strength,
+				ArmorGroup:   armor.ArmorGroup,
+				Traits:       oldArmor.Traits,
+			}
+			if armor.ArmorClass != nil {
+				internalArmor.ArmorClass = *armor.ArmorClass
+			}
+			if armor.Category != "" {
+				internalArmor.Category = armor.Category
+			}
+			if armor.TraitsID != nil {
+				if internalArmor.Traits, err = a.findArmorTraits(armor.TraitsID); err != nil {
+					ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
 			}
 			internalItem := &model.Item{
 				ID:          oldArmor.Item.ID,
 				Name:        armor.Name,
 				Description: armor.Description,
 				Bulk:        armor.Bulk,
-				Level:       *armor.Level,
+				Level:       oldArmor.Item.Level,
 				Price:       armor.Price,
 			}
+			if armor.Level != nil {
+				internalItem.Level = *armor.Level
+			}
 			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateArmor(internalArmor, internalItem)); !success {
 				ctx.JSON(http.StatusInternalServerError, success)
 				return
 			}
 			newArmor, _ := a.DB.GetArmorByID(id)
 			ctx.JSON(http.StatusOK, ToExternalArmor(newArmor, &newArmor.Item))
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		}
 	})
 }
 
+// findArmorTraits returns the traits with the IDs, every ID must exist
+func (a *ItemApi) findArmorTraits(traitsID []uint) ([]model.Trait, error) {
+	if len(traitsID) == 0 {
+		return []model.Trait{}, nil
+	}
+	traits, err := a.DB.FindTraits(traitsID)
+	if err != nil {
+		return nil, err
+	}
+	if len(traits) != len(traitsID) {
+		return nil, fmt.Errorf("unknown trait in %v", traitsID)
+	}
+	return traits, nil
+}
+
 func ToExternalArmor(armor *model.Armor, item *model.Item) *model.ArmorExternal {
-	return &model.ArmorExternal{
-		ID:          armor.ID,
-		Name:        item.Name,
-		Description: item.Description,
-		Level:       item.Level,
-		Bulk:        item.Bulk,
-		Price:       item.Price,
-		ArmorClass:  armor.ArmorClass,
-		ItemID:      item.ID,
+	external := &model.ArmorExternal{
+		ID:           armor.ID,
+		Name:         item.Name,
+		Description:  item.Description,
+		Level:        item.Level,
+		Bulk:         item.Bulk,
+		Price:        item.Price,
+		ArmorClass:   armor.ArmorClass,
+		ItemID:       item.ID,
+		Category:     armor.Category,
+		DexCap:       armor.DexCap,
+		CheckPenalty: armor.CheckPenalty,
+		SpeedPenalty: armor.SpeedPenalty,
+		Strength:     armor.Strength,
+		ArmorGroup:   armor.ArmorGroup,
+		Traits:       []string{},
+	}
+	for _, trait := range armor.Traits {
+		external.Traits = append(external.Traits, trait.Name)
 	}
+	return external
 }
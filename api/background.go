@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
@@ -33,12 +34,17 @@ type BackgroundApi struct {
 func (a *BackgroundApi) CreateBackground(ctx *gin.Context) {
 	background := &model.BackgroundCreate{}
 	if err := ctx.ShouldBindJSON(background); err == nil {
+		loreSkill, ok := backgroundLore(ctx, background.LoreSkill)
+		if !ok {
+			return
+		}
 		internal := &model.Background{
 			Name:          background.Name,
 			Description:   background.Description,
 			FeatID:        &background.FeatID,
 			FirstSkillID:  &background.FirstSkillID,
 			SecondSkillID: &background.SecondSkillID,
+			LoreSkill:     loreSkill,
 		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateBackground(internal)); !success {
 			return
@@ -115,6 +121,10 @@ func (a *BackgroundApi) UpdateBackground(ctx *gin.Context) {
 				return
 			}
 			if oldBackground != nil {
+				loreSkill, ok := backgroundLore(ctx, background.LoreSkill)
+				if !ok {
+					return
+				}
 				internal := &model.Background{
 					ID:            oldBackground.ID,
 					Name:          background.Name,
@@ -122,6 +132,7 @@ func (a *BackgroundApi) UpdateBackground(ctx *gin.Context) {
 					FeatID:        &background.FeatID,
 					FirstSkillID:  &background.FirstSkillID,
 					SecondSkillID: &background.SecondSkillID,
+					LoreSkill:     loreSkill,
 				}
 				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateBackground(internal)); !success {
 					return
@@ -171,5 +182,19 @@ func ToBackgroundExternal(Background *model.Background) *model.BackgroundExterna
 		FeatID:        Background.FeatID,
 		FirstSkillID:  Background.FirstSkillID,
 		SecondSkillID: Background.SecondSkillID,
+		LoreSkill:     Background.LoreSkill,
+	}
+}
+
+// backgroundLore normalizes the Lore skill a background trains, empty when it trains none
+func backgroundLore(ctx *gin.Context, loreSkill string) (string, bool) {
+	if loreSkill == "" {
+		return "", true
+	}
+	name, err := rules.LoreName(loreSkill)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return "", false
 	}
+	return name, true
 }
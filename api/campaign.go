@@ -0,0 +1,495 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+type CampaignDatabase interface {
+	CreateCampaign(campaign *model.Campaign) error
+	GetCampaignByID(id uint) (*model.Campaign, error)
+	GetCampaigns(userID uint) ([]*model.Campaign, error)
+	UpdateCampaign(campaign *model.Campaign) error
+	DeleteCampaign(id uint) error
+	CreateCampaignInvite(invite *model.CampaignInvite) error
+	GetCampaignInvite(campaignID uint, email string) (*model.CampaignInvite, error)
+	GetCampaignInvitesByEmail(email string) ([]*model.CampaignInvite, error)
+	JoinCampaign(invite *model.CampaignInvite, characterID uint) error
+	LeaveCampaign(campaignID uint, characterID uint) error
+	ApplyHealthChange(defence *model.CharacterDefence, healthLog *model.HealthLog) error
+	GetCharacterByID(id uint) (*model.Character, error)
+	GetUserByID(id uint) (*model.User, error)
+	GetConditionByID(id uint) (*model.Condition, error)
+	SetCharacterCondition(characterCondition *model.CharacterCondition) error
+	DeleteCharacterCondition(characterID uint, conditionID uint) error
+	UpdateCharacterConditions(conditions []model.CharacterCondition) error
+	UpdateDyingWounded(defence *model.CharacterDefence) error
+	AwardExperience(characters []*model.Character, logs []*model.ExperienceLog) error
+}
+
+type CampaignConsumer interface {
+	PublishInvite(email string, campaign string)
+}
+
+type CampaignApi struct {
+	DB       CampaignDatabase
+	Consumer CampaignConsumer
+}
+
+// CreateCampaign godoc
+//
+// @Summary Create and returns Campaign
+// @Description Current user becomes Game Master of the Campaign
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param campaign body model.CreateCampaign true "Campaign data"
+// @Success 201 {object} model.CampaignExternal "Campaign details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /campaign [post]
+func (a *CampaignApi) CreateCampaign(ctx *gin.Context) {
+	campaign := &model.CreateCampaign{}
+	if err := ctx.ShouldBindJSON(campaign); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal := &model.Campaign{
+		Name:         campaign.Name,
+		Description:  campaign.Description,
+		Milestone:    campaign.Milestone,
+		GameMasterID: auth.GetUserID(ctx),
+	}
+	if success := SuccessOrAbort(ctx, 500, a.DB.CreateCampaign(internal)); !success {
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToExternalCampaign(internal))
+}
+
+// GetCampaigns godoc
+//
+// @Summary Returns Campaigns of current user
+// @Description Campaigns the user runs as Game Master or plays in
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.CampaignExternal "Campaign details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /campaign [get]
+func (a *CampaignApi) GetCampaigns(ctx *gin.Context) {
+	campaigns, err := a.DB.GetCampaigns(auth.GetUserID(ctx))
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.CampaignExternal{}
+	for _, campaign := range campaigns {
+		resp = append(resp, ToExternalCampaign(campaign))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCampaignByID godoc
+//
+// @Summary Returns Campaign by ID
+// @Description Permissions for Game Master and players of the Campaign
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Success 200 {object} model.CampaignExternal "Campaign details"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id} [get]
+func (a *CampaignApi) GetCampaignByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		campaign, err := a.DB.GetCampaignByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if campaign == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+			return
+		}
+		if !isCampaignMember(ctx, a.DB, campaign) {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCampaign(campaign))
+	})
+}
+
+// UpdateCampaign godoc
+//
+// @Summary Updates Campaign by ID
+// @Description Permissions for Game Master
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param campaign body model.CreateCampaign true "Campaign data"
+// @Success 200 {object} model.CampaignExternal "Campaign details"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id} [patch]
+func (a *CampaignApi) UpdateCampaign(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		update := &model.CreateCampaign{}
+		if err := ctx.ShouldBindJSON(update); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		campaign, err := a.DB.GetCampaignByID(id)
+		if err != nil || campaign == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+			return
+		}
+		campaign.Name = update.Name
+		campaign.Description = update.Description
+		campaign.Milestone = update.Milestone
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCampaign(campaign)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCampaign(campaign))
+	})
+}
+
+// DeleteCampaign godoc
+//
+// @Summary Deletes Campaign by ID
+// @Description Permissions for Game Master, characters stay with their players
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Success 204
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id} [delete]
+func (a *CampaignApi) DeleteCampaign(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCampaign(id)); !success {
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+}
+
+// InvitePlayer godoc
+//
+// @Summary Invites player to Campaign by email
+// @Description Permissions for Game Master, the invite is sent by email
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param invite body model.CreateCampaignInvite true "Invite data"
+// @Success 201 {object} model.CampaignInviteExternal "Invite details"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/invite [post]
+func (a *CampaignApi) InvitePlayer(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		invite := &model.CreateCampaignInvite{}
+		if err := ctx.ShouldBindJSON(invite); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		campaign, err := a.DB.GetCampaignByID(id)
+		if err != nil || campaign == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+			return
+		}
+		internal := &model.CampaignInvite{CampaignID: campaign.ID, Email: invite.Email}
+		if success := SuccessOrAbort(ctx, 500, a.DB.CreateCampaignInvite(internal)); !success {
+			return
+		}
+		ctx.JSON(http.StatusCreated, ToExternalCampaignInvite(internal, campaign))
+		a.Consumer.PublishInvite(internal.Email, campaign.Name)
+	})
+}
+
+// GetInvites godoc
+//
+// @Summary Returns pending Campaign invites of current user
+// @Description Invites sent to the email of current user
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.CampaignInviteExternal "Invite details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /campaign/invite [get]
+func (a *CampaignApi) GetInvites(ctx *gin.Context) {
+	user, err := a.DB.GetUserByID(auth.GetUserID(ctx))
+	if err != nil || user == nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
+	invites, err := a.DB.GetCampaignInvitesByEmail(user.Email)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.CampaignInviteExternal{}
+	for _, invite := range invites {
+		campaign, err := a.DB.GetCampaignByID(invite.CampaignID)
+		if err != nil || campaign == nil {
+			continue
+		}
+		resp = append(resp, ToExternalCampaignInvite(invite, campaign))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// JoinCampaign godoc
+//
+// @Description Permissions for Character's User invited to the Campaign, an invite lets in one character once
+// @Description Permissions for Character's User invited to the Campaign
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param join body model.JoinCampaign true "Character data"
+// @Success 200 {object} model.CampaignExternal "Campaign details"
+// @Failure 400 {string} string "Character already plays in a campaign"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/join [post]
+func (a *CampaignApi) JoinCampaign(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		join := &model.JoinCampaign{}
+		if err := ctx.ShouldBindJSON(join); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		user, err := a.DB.GetUserByID(auth.GetUserID(ctx))
+		if err != nil || user == nil {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			return
+		}
+		character, err := a.DB.GetCharacterByID(join.CharacterID)
+		if err != nil || character.UserID != user.ID {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+			return
+		}
+		if character.CampaignID != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character already plays in a campaign"})
+			return
+		}
+		invite, err := a.DB.GetCampaignInvite(id, user.Email)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if invite == nil {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not invited to this campaign"})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.JoinCampaign(invite, character.ID)); !success {
+			return
+		}
+		campaign, err := a.DB.GetCampaignByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCampaign(campaign))
+	})
+}
+
+// LeaveCampaign godoc
+//
+// @Summary Detaches Character from Campaign
+// @Description Permissions for Game Master and Character's User
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param character_id path int true "Character id"
+// @Success 204
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/character/{character_id} [delete]
+func (a *CampaignApi) LeaveCampaign(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "character_id", func(characterID uint) {
+			campaign, err := a.DB.GetCampaignByID(id)
+			if err != nil || campaign == nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+				return
+			}
+			character := findCampaignCharacter(campaign, characterID)
+			if character == nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Character doesn't play in this campaign"})
+				return
+			}
+			userID := auth.GetUserID(ctx)
+			if character.UserID != userID && campaign.GameMasterID != userID {
+				ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+				return
+			}
+			if success := SuccessOrAbort(ctx, 500, a.DB.LeaveCampaign(id, characterID)); !success {
+				return
+			}
+			ctx.Status(http.StatusNoContent)
+		})
+	})
+}
+
+// GetParty godoc
+//
+// @Summary Returns sheets of all Characters in Campaign
+// @Description Permissions for Game Master
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Success 200 {object} []model.CharacterExternal "Character details"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/party [get]
+func (a *CampaignApi) GetParty(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		campaign, err := a.DB.GetCampaignByID(id)
+		if err != nil || campaign == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+			return
+		}
+		resp := []*model.CharacterExternal{}
+		for _, member := range campaign.Characters {
+			character, err := a.DB.GetCharacterByID(member.ID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			resp = append(resp, ToExternalCharacter(character))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// AdjustCharacter godoc
+//
+// @Summary Adjusts hit points and experience of Character in Campaign
+// @Description Permissions for Game Master, values are added to the current ones. A negative hit point change
+// @Description is damage and a positive one is healing, both follow the health rules and are logged
+// @Tags Campaign
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param character_id path int true "Character id"
+// @Param adjust body model.AdjustCampaignCharacter true "Adjustments"
+// @Success 200 {object} model.CharacterExternal "Character details"
+// @Failure 400 {string} string "Character is dead"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character doesn't play in this campaign"
+// @Router /campaign/{id}/character/{character_id} [patch]
+func (a *CampaignApi) AdjustCharacter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "character_id", func(characterID uint) {
+			adjust := &model.AdjustCampaignCharacter{}
+			if err := ctx.ShouldBindJSON(adjust); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			character := a.campaignCharacter(ctx, id, characterID)
+			if character == nil {
+				return
+			}
+			if adjust.HitPoint < 0 {
+				amount := uint16(-int(adjust.HitPoint))
+				healthLog := &model.HealthLog{Kind: model.HealthChangeDamage, Amount: amount}
+				if !applyHealthChange(ctx, a.DB, character, healthLog, func(defence *model.CharacterDefence) {
+					rules.ApplyDamage(defence, amount, false)
+				}) {
+					return
+				}
+			} else if adjust.HitPoint > 0 {
+				amount := uint16(adjust.HitPoint)
+				healthLog := &model.HealthLog{Kind: model.HealthChangeHeal, Amount: amount}
+				if !applyHealthChange(ctx, a.DB, character, healthLog, func(defence *model.CharacterDefence) {
+					rules.Heal(defence, amount)
+				}) {
+					return
+				}
+			}
+			if adjust.Experience != 0 {
+				rules.GainExperience(character, int(adjust.Experience))
+				experienceLog := newExperienceLog(ctx, character, model.ExperienceAdjustment, adjust.Experience, "")
+				err := a.DB.AwardExperience([]*model.Character{character}, []*model.ExperienceLog{experienceLog})
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+			}
+			ctx.JSON(http.StatusOK, ToExternalCharacter(character))
+		})
+	})
+}
+
+// campaignCharacter returns the character playing in the campaign or responds with 404
+func (a *CampaignApi) campaignCharacter(ctx *gin.Context, campaignID uint, characterID uint) *model.Character {
+	character, err := a.DB.GetCharacterByID(characterID)
+	if err != nil || character.CampaignID == nil || *character.CampaignID != campaignID {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Character doesn't play in this campaign"})
+		return nil
+	}
+	return character
+}
+
+// MemberDatabase resolves users for campaign membership checks
+type MemberDatabase interface {
+	GetUserByID(id uint) (*model.User, error)
+}
+
+// isCampaignMember reports whether the user is Game Master, plays in the campaign or is admin
+func isCampaignMember(ctx *gin.Context, db MemberDatabase, campaign *model.Campaign) bool {
+	userID := auth.GetUserID(ctx)
+	if campaign.GameMasterID == userID {
+		return true
+	}
+	for _, character := range campaign.Characters {
+		if character.UserID == userID {
+			return true
+		}
+	}
+	user, err := db.GetUserByID(userID)
+	return err == nil && user != nil && user.Admin
+}
+
+func findCampaignCharacter(campaign *model.Campaign, characterID uint) *model.Character {
+	for i := range campaign.Characters {
+		if campaign.Characters[i].ID == characterID {
+			return &campaign.Characters[i]
+		}
+	}
+	return nil
+}
+
+func ToExternalCampaign(campaign *model.Campaign) *model.CampaignExternal {
+	characters := []model.CampaignCharacterExternal{}
+	for _, character := range campaign.Characters {
+		characters = append(characters, model.CampaignCharacterExternal{
+			ID:             character.ID,
+			UserID:         character.UserID,
+			Name:           character.Name,
+			Level:          character.Level,
+			Experience:     character.Experience,
+			ReadyToLevelUp: rules.ReadyToLevelUp(&character),
+		})
+	}
+	return &model.CampaignExternal{
+		ID:           campaign.ID,
+		Name:         campaign.Name,
+		Description:  campaign.Description,
+		GameMasterID: campaign.GameMasterID,
+		Milestone:    campaign.Milestone,
+		Characters:   characters,
+	}
+}
+
+func ToExternalCampaignInvite(invite *model.CampaignInvite, campaign *model.Campaign) *model.CampaignInviteExternal {
+	return &model.CampaignInviteExternal{
+		ID:           invite.ID,
+		CampaignID:   invite.CampaignID,
+		CampaignName: campaign.Name,
+		Email:        invite.Email,
+		Accepted:     invite.Accepted,
+	}
+}
@@ -2,8 +2,9 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
-	"kingdom/auth"
+	"kingdom/dice"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
@@ -21,19 +22,66 @@ type CharacterDatabase interface {
 	GetRaceByID(id uint) (*model.Race, error)
 	GetCharacterClassByID(id uint) (*model.CharacterClass, error)
 	GetCharacterDefenceByID(id uint) (*model.CharacterDefence, error)
-	UpdateHitPoint(defence *model.CharacterDefence) error
+	GetClassFeaturesByLevel(classID uint, level uint8) ([]model.ClassFeature, error)
+	GetCharacterLevel(characterID uint, level int8) (*model.CharacterLevel, error)
+	GetCharacterLevels(characterID uint) ([]*model.CharacterLevel, error)
+	LevelUpCharacter(
+		character *model.Character,
+		level *model.CharacterLevel,
+		skills []*model.CharacterSkill,
+		feats []*model.CharacterFeat,
+		boosts []*model.AttributeBoost,
+	) error
+	GetAttributeBoosts(characterID uint) ([]*model.AttributeBoost, error)
+	LevelDownCharacter(character *model.Character, level *model.CharacterLevel, skills []*model.CharacterSkill) error
 	GetSkills() ([]*model.Skill, error)
 	CharacterSkillCreate(characterSkill *model.CharacterSkill) error
 	GetBackgroundByID(id uint) (*model.Background, error)
 	CreateCharacterFeat(characterFeat *model.CharacterFeat) error
 	GetFeatByID(id uint) (*model.Feat, error)
-	GetCharacterSkillByCharacterID(characterId uint, skillID uint) (*model.CharacterSkill, error)
+	FilterFeats(filter *model.FeatFilter) ([]*model.Feat, error)
+	FindFeats(IDs []uint) ([]model.Feat, error)
+	GetArchetypes() ([]*model.Archetype, error)
+	GetCharacterArchetypes(characterID uint) ([]*model.Archetype, error)
+	GetRaces() ([]*model.Race, error)
+	GetCharacterClasses() ([]*model.CharacterClass, error)
+	GetClassFeatureByClassID(classID uint) ([]model.ClassFeature, error)
 	CreateCharacterInfo(*model.CharacterInfo) error
 	GetAncestryByID(id uint) (*model.Ancestry, error)
+	GetSlotByCharacterID(characterID uint) (*model.Slot, error)
+	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	GetArmorByID(id uint) (*model.Armor, error)
+	GetShieldByID(id uint) (*model.Shield, error)
+	GetWeaponByID(id uint) (*model.Weapon, error)
+	GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error)
+	IsCharacterGameMaster(characterID uint, userID uint) (bool, error)
+	ApplyHealthChange(defence *model.CharacterDefence, healthLog *model.HealthLog) error
+	GetHealthLogs(characterID uint) ([]*model.HealthLog, error)
+	GetExperienceLogs(characterID uint) ([]*model.ExperienceLog, error)
+	GetSpellByID(id uint) (*model.Spell, error)
+	GetSpellSlotTable(classID uint) ([]*model.SpellCharacterClass, error)
+	GetCharacterSpells(characterID uint) ([]model.CharacterSpell, error)
+	CreateCharacterSpell(characterSpell *model.CharacterSpell) error
+	DeleteCharacterSpell(characterSpell *model.CharacterSpell) error
+	GetPreparedSpells(characterID uint) ([]model.CharacterPreparedSpell, error)
+	ReplacePreparedSpells(characterID uint, spells []*model.CharacterPreparedSpell) error
+	ExpendPreparedSpell(spell *model.CharacterPreparedSpell) error
+	GetSpellSlotUsage(characterID uint) ([]model.CharacterSpellSlot, error)
+	UseSpellSlot(characterID uint, rank uint8) error
+	UpdateFocusPoint(character *model.Character) error
+	RestCharacter(character *model.Character) error
+	CreateCharacterRolls(rolls ...*model.CharacterRoll) error
+	GetCharacterRolls(characterID uint, limit int, offset int) ([]*model.CharacterRoll, error)
+	GetItemByID(id uint) (*model.Item, error)
+	UpdatePurse(character *model.Character) error
+	BuyCharacterItem(character *model.Character, item *model.CharacterItem) error
+	SellCharacterItem(character *model.Character, item *model.CharacterItem) error
+	EncumbranceDatabase
 }
 
 type CharacterApi struct {
-	DB CharacterDatabase
+	DB     CharacterDatabase
+	Roller *dice.Roller
 }
 
 // GetCharacterByID godoc
@@ -154,8 +202,6 @@ func (a *CharacterApi) CreateCharacter(ctx *gin.Context) {
 // @Failure 404 {string} string "Character doesn't exist"
 // @Router /character/{id} [patch]
 func (a *CharacterApi) UpdateCharacter(ctx *gin.Context) {
-	user, _ := a.DB.GetUserByID(auth.GetUserID(ctx))
-
 	withID(ctx, "id", func(id uint) {
 		var character *model.CharacterUpdate
 		if err := ctx.Bind(&character); err == nil {
@@ -164,27 +210,24 @@ func (a *CharacterApi) UpdateCharacter(ctx *gin.Context) {
 				return
 			}
 			if oldCharacter != nil {
-				if oldCharacter.UserID != user.ID && !user.Admin {
-					ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
-					return
-				}
 				internal := &model.Character{
 					ID:               oldCharacter.ID,
-					CharacterClassID: oldCharacter.CharacterClassID,
 					Name:             character.Name,
 					Alias:            character.Alias,
 					LastName:         character.LastName,
+					Level:            oldCharacter.Level,
+					Experience:       oldCharacter.Experience,
+					CampaignID:       oldCharacter.CampaignID,
 					UserID:           oldCharacter.UserID,
+					RaceID:           oldCharacter.RaceID,
+					AncestryID:       oldCharacter.AncestryID,
+					BackgroundID:     oldCharacter.BackgroundID,
+					CharacterClassID: oldCharacter.CharacterClassID,
 				}
-				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacter(internal)); success {
+				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacter(internal)); !success {
 					return
 				}
 				ctx.JSON(http.StatusOK, ToExternalCharacter(internal))
-				if character.Level != oldCharacter.Level {
-					go func() {
-						a.ChangeHitPoint(internal, character.Level-oldCharacter.Level)
-					}()
-				}
 			}
 		} else {
 			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character doesn't exist"})
@@ -192,26 +235,6 @@ func (a *CharacterApi) UpdateCharacter(ctx *gin.Context) {
 	})
 }
 
-func (a *CharacterApi) ChangeHitPoint(character *model.Character, levelCount int8) {
-	characterClass, _ := a.DB.GetCharacterClassByID(character.CharacterClassID)
-	characterDefence, _ := a.DB.GetCharacterDefenceByID(character.ID)
-	maxHtPoint := characterDefence.MaxHitPoint + (characterClass.HitPoint)*uint16(levelCount)
-	hitPoint := characterDefence.HitPoint
-	if maxHtPoint < characterDefence.HitPoint {
-		hitPoint = maxHtPoint
-	}
-	internal := &model.CharacterDefence{
-		ID:          character.ID,
-		MaxHitPoint: maxHtPoint,
-		HitPoint:    hitPoint,
-	}
-	err := a.DB.UpdateHitPoint(internal)
-	if err != nil {
-		return
-	}
-
-}
-
 // DeleteCharacter Deletes Character by ID
 //
 // @Summary Deletes Character by ID or returns nil
@@ -225,18 +248,12 @@ func (a *CharacterApi) ChangeHitPoint(character *model.Character, levelCount int
 // @Failure 403 {string} string "You can't access for this API"
 // @Router /character/{id} [delete]
 func (a *CharacterApi) DeleteCharacter(ctx *gin.Context) {
-	user, _ := a.DB.GetUserByID(auth.GetUserID(ctx))
-
 	withID(ctx, "id", func(id uint) {
 		character, err := a.DB.GetCharacterByID(id)
 		if success := SuccessOrAbort(ctx, 500, err); !success {
 			return
 		}
 		if character != nil {
-			if character.UserID != user.ID && !user.Admin {
-				ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
-				return
-			}
 			if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCharacterByID(id)); !success {
 				return
 			}
@@ -255,6 +272,9 @@ func ToExternalCharacter(character *model.Character) *model.CharacterExternal {
 		LastName:           character.LastName,
 		UserID:             character.UserID,
 		Level:              character.Level,
+		Experience:         character.Experience,
+		ReadyToLevelUp:     rules.ReadyToLevelUp(character),
+		CampaignID:         character.CampaignID,
 		CharacterItem:      character.CharacterItem,
 		CharacterBoost:     character.Boost,
 		Attribute:          character.Attribute,
@@ -292,4 +312,12 @@ func (a *CharacterApi) CreateSkills(character *model.Character) {
 			return
 		}
 	}
+	if name, err := rules.LoreName(backgroundCharacter.LoreSkill); err == nil {
+		_ = a.DB.CharacterSkillCreate(&model.CharacterSkill{
+			CharacterID: character.ID,
+			Name:        name,
+			Mastery:     model.Train,
+			Lore:        true,
+		})
+	}
 }
@@ -3,12 +3,21 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
 type CharacterBoostDatabase interface {
 	GetCharacterBoostByID(id uint) (*model.CharacterBoost, error)
-	UpdateCharacterBoost(CharacterBoosts *model.CharacterBoost) error
+	GetCharacterByID(id uint) (*model.Character, error)
+	GetAttributeBoosts(characterID uint) ([]*model.AttributeBoost, error)
+	ReplaceAttributeBoosts(
+		characterID uint,
+		source model.BoostSource,
+		boosts []*model.AttributeBoost,
+		attribute *model.Attribute,
+	) error
+	EncumbranceDatabase
 }
 
 type CharacterBoostApi struct {
@@ -31,7 +40,7 @@ func (a *CharacterApi) CreateCharacterBoost(ctx *gin.Context,
 // GetCharacterBoostByID godoc
 //
 // @Summary Returns Boost by id
-// @Description Permissions for auth user or admin
+// @Description Available boosts and the boosts already spent by Character
 // @Tags Boost
 // @Accept json
 // @Produce json
@@ -41,55 +50,126 @@ func (a *CharacterApi) CreateCharacterBoost(ctx *gin.Context,
 // @Router /character_boost/{id} [get]
 func (a *CharacterBoostApi) GetCharacterBoostByID(ctx *gin.Context) {
 	withID(ctx, "id", func(id uint) {
-		CharacterBoost, err := a.DB.GetCharacterBoostByID(id)
-		if success := SuccessOrAbort(ctx, 500, err); !success {
+		characterBoost, err := a.DB.GetCharacterBoostByID(id)
+		if err != nil || characterBoost == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Boost doesn't exist"})
 			return
 		}
-		if CharacterBoost != nil {
-			ctx.JSON(http.StatusOK, ToExternalCharacterBoost(CharacterBoost))
-		} else {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "Boost doesn't exist"})
+		boosts, err := a.DB.GetAttributeBoosts(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
 		}
+		ctx.JSON(http.StatusOK, ToExternalCharacterBoost(characterBoost, boosts, nil))
 	})
 }
 
-// UpdateCharacterBoost Updates Boost by ID
+// UpdateCharacterBoost Spends boosts of one source
 //
-// @Summary Updates Boost by ID or nil
-// @Description Permissions for Character's User or Admin
+// @Summary Spends ancestry, background, class or free boosts of Character
+// @Description Replaces boosts of the source and recomputes attributes from the boost history,
+// @Description attributes set before boosts were tracked don't match the history and aren't recomputed
 // @Tags Boost
 // @Accept json
 // @Produce json
-// @Param id path int true "Boost id"
+// @Param id path int true "character_id"
 // @Param Boost body model.UpdateCharacterBoost true "Boost data"
 // @Success 200 {object} model.CharacterBoostExternal "Boost details"
+// @Failure 400 {string} string "Wrong boosts"
 // @Failure 404 {string} string "Boost doesn't exist"
+// @Failure 409 {string} string "Attributes don't match the boost history"
 // @Router /character_boost/{id} [patch]
 func (a *CharacterBoostApi) UpdateCharacterBoost(ctx *gin.Context) {
 	withID(ctx, "id", func(id uint) {
-		var CharacterBoost *model.UpdateCharacterBoost
-		if err := ctx.ShouldBindJSON(&CharacterBoost); err == nil {
-			oldCharacterBoost, err := a.DB.GetCharacterBoostByID(id)
-			if success := SuccessOrAbort(ctx, 404, err); !success {
-				return
-			}
-			if oldCharacterBoost != nil {
-				internal := &model.CharacterBoost{
-					ID:          oldCharacterBoost.ID,
-					CharacterID: id,
-				}
-				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterBoost(internal)); !success {
-					return
-				}
-				ctx.JSON(http.StatusOK, ToExternalCharacterBoost(internal))
+		update := &model.UpdateCharacterBoost{}
+		if err := ctx.ShouldBindJSON(update); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		characterBoost, err := a.DB.GetCharacterBoostByID(id)
+		if err != nil || characterBoost == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Boost doesn't exist"})
+			return
+		}
+
+		err = rules.ValidateBoosts(characterBoost, character.Race.AttributeFlaw,
+			character.CharacterClass.KeyAbility, update.Source, update.Abilities)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		history, err := a.DB.GetAttributeBoosts(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if !rules.MatchesBoostHistory(character.Attribute, character.Race.AttributeFlaw, history) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Attributes don't match the boost history, they can't be recomputed"})
+			return
+		}
+		var spent, boosts, later []*model.AttributeBoost
+		for _, boost := range history {
+			switch {
+			case boost.Source == update.Source:
+				continue
+			case boost.Level > 1:
+				later = append(later, boost)
+			default:
+				boosts = append(boosts, boost)
 			}
 		}
+		for _, ability := range update.Abilities {
+			spent = append(spent, &model.AttributeBoost{
+				CharacterID: id,
+				Source:      update.Source,
+				Level:       1,
+				Ability:     ability,
+			})
+		}
+		boosts = append(append(boosts, spent...), later...)
+
+		attribute := rules.ComputeAttributes(character.Race.AttributeFlaw, boosts)
+		attribute.ID = character.Attribute.ID
+		attribute.CharacterID = id
+		if success := SuccessOrAbort(ctx, 500,
+			a.DB.ReplaceAttributeBoosts(id, update.Source, spent, &attribute)); !success {
+			return
+		}
+		if _, err := syncEncumbrance(a.DB, id); err != nil {
+			SuccessOrAbort(ctx, 500, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCharacterBoost(characterBoost, boosts, &attribute))
 	})
 }
 
-func ToExternalCharacterBoost(CharacterBoost *model.CharacterBoost) *model.CharacterBoostExternal {
-	return &model.CharacterBoostExternal{
-		ID:          CharacterBoost.ID,
-		CharacterID: CharacterBoost.CharacterID,
+func ToExternalCharacterBoost(
+	characterBoost *model.CharacterBoost,
+	boosts []*model.AttributeBoost,
+	attribute *model.Attribute,
+) *model.CharacterBoostExternal {
+	external := &model.CharacterBoostExternal{
+		ID:              characterBoost.ID,
+		CharacterID:     characterBoost.CharacterID,
+		AncestryBoost:   characterBoost.AncestryBoost,
+		BackgroundBoost: characterBoost.BackgroundBoost,
+		ClassBoost:      characterBoost.ClassBoost,
+		FreeBoost:       characterBoost.FreeBoost,
+		Boosts:          []model.AttributeBoostExternal{},
+	}
+	for _, boost := range boosts {
+		external.Boosts = append(external.Boosts, model.AttributeBoostExternal{
+			Source:  boost.Source,
+			Level:   boost.Level,
+			Ability: boost.Ability,
+		})
+	}
+	if attribute != nil {
+		external.Attribute = ToExternalAttribute(attribute)
 	}
+	return external
 }
@@ -13,6 +13,8 @@ type CharacterClassDatabase interface {
 	DeleteCharacterClass(id uint) error
 	UpdateCharacterClass(class *model.CharacterClass) error
 	GetTraditionByName(name string) (*model.Tradition, error)
+	GetSpellSlotTable(classID uint) ([]*model.SpellCharacterClass, error)
+	ReplaceSpellSlotTable(classID uint, table []*model.SpellCharacterClass) error
 }
 
 type CharacterClassApi struct {
@@ -34,8 +36,11 @@ func (a *CharacterClassApi) CreateCharacterClass(ctx *gin.Context) {
 	characterClass := &model.CharacterClassCreate{}
 	if err := ctx.Bind(characterClass); err == nil {
 		internal := &model.CharacterClass{
-			Name:     characterClass.Name,
-			HitPoint: characterClass.HitPoint,
+			Name:          characterClass.Name,
+			HitPoint:      characterClass.HitPoint,
+			TrainedSkills: characterClass.TrainedSkills,
+			KeyAbility:    characterClass.KeyAbility,
+			Spellcasting:  characterClass.Spellcasting,
 		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateCharacterClass(internal)); !success {
 			return
@@ -116,6 +121,7 @@ func (a *CharacterClassApi) UpdateCharacterClass(ctx *gin.Context) {
 					ID:            oldCharacter.ID,
 					Name:          character.Name,
 					HitPoint:      character.HitPoint,
+					TrainedSkills: character.TrainedSkills,
 					Perception:    character.Perception,
 					Fortitude:     character.Fortitude,
 					Reflex:        character.Reflex,
@@ -127,7 +133,9 @@ func (a *CharacterClassApi) UpdateCharacterClass(ctx *gin.Context) {
 					UnArmedWeapon: character.UnArmedWeapon,
 					CommonWeapon:  character.CommonWeapon,
 					MartialWeapon: character.MartialWeapon,
+					KeyAbility:    character.KeyAbility,
 					TraditionID:   &character.TraditionID,
+					Spellcasting:  character.Spellcasting,
 				}
 				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterClass(internal)); success {
 					return
@@ -169,11 +177,87 @@ func (a *CharacterClassApi) DeleteCharacterClass(ctx *gin.Context) {
 	})
 }
 
+// GetSpellSlotTable godoc
+//
+// @Summary Returns spell slot table of Character Class
+// @Description Slots per rank from the character level on, rank 0 is the number of cantrips
+// @Tags Character Class
+// @Accept json
+// @Produce json
+// @Param id path int true "class id"
+// @Success 200 {object} []model.SpellSlotTableExternal "spell slot table"
+// @Failure 404 {string} string "Character Class doesn't exist"
+// @Router /class/{id}/spell-slots [get]
+func (a *CharacterClassApi) GetSpellSlotTable(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		table, err := a.DB.GetSpellSlotTable(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalSpellSlotTable(table))
+	})
+}
+
+// UpdateSpellSlotTable godoc
+//
+// @Summary Replaces spell slot table of Character Class
+// @Description Permissions for Admin
+// @Tags Character Class
+// @Accept json
+// @Produce json
+// @Param id path int true "class id"
+// @Param slots body model.UpdateSpellSlots true "Spell slot table"
+// @Success 200 {object} []model.SpellSlotTableExternal "spell slot table"
+// @Failure 400 {string} string "Wrong spell slot table"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character Class doesn't exist"
+// @Router /class/{id}/spell-slots [put]
+func (a *CharacterClassApi) UpdateSpellSlotTable(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		slots := &model.UpdateSpellSlots{}
+		if err := ctx.ShouldBindJSON(slots); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		characterClass, err := a.DB.GetCharacterClassByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if characterClass == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character Class doesn't exist"})
+			return
+		}
+
+		var table []*model.SpellCharacterClass
+		for _, slot := range slots.Slots {
+			table = append(table, &model.SpellCharacterClass{
+				CharacterClassID: id,
+				Level:            slot.Level,
+				Rank:             slot.Rank,
+				SpellCount:       slot.SpellCount,
+			})
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.ReplaceSpellSlotTable(id, table)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalSpellSlotTable(table))
+	})
+}
+
+func ToExternalSpellSlotTable(table []*model.SpellCharacterClass) []model.SpellSlotTableExternal {
+	resp := []model.SpellSlotTableExternal{}
+	for _, row := range table {
+		resp = append(resp, model.SpellSlotTableExternal{Level: row.Level, Rank: row.Rank, SpellCount: row.SpellCount})
+	}
+	return resp
+}
+
 func ToExternalCharacterClass(character *model.CharacterClass) *model.CharacterClassExternal {
 	return &model.CharacterClassExternal{
 		ID:            character.ID,
 		Name:          character.Name,
 		HitPoint:      character.HitPoint,
+		TrainedSkills: character.TrainedSkills,
 		Perception:    character.Perception,
 		Fortitude:     character.Fortitude,
 		Reflex:        character.Reflex,
@@ -185,6 +269,8 @@ func ToExternalCharacterClass(character *model.CharacterClass) *model.CharacterC
 		UnArmedWeapon: character.UnArmedWeapon,
 		CommonWeapon:  character.CommonWeapon,
 		MartialWeapon: character.MartialWeapon,
+		KeyAbility:    character.KeyAbility,
 		TraditionID:   character.TraditionID,
+		Spellcasting:  character.Spellcasting,
 	}
 }
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// GetCharacterConditions godoc
+//
+// @Summary Returns conditions of Character
+// @Description Conditions with their counts, dying and wounded included
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} []model.CharacterConditionExternal "character conditions"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/conditions [get]
+func (a *CharacterApi) GetCharacterConditions(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCharacterConditions(character))
+	})
+}
+
+// SetCharacterCondition godoc
+//
+// @Summary Applies condition to Character in Campaign
+// @Description Permissions for Game Master, applying a present condition changes its count
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param character_id path int true "Character id"
+// @Param condition body model.CreateCharacterCondition true "Condition data"
+// @Success 200 {object} []model.CharacterConditionExternal "character conditions"
+// @Failure 400 {string} string "Wrong condition count"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Condition not found"
+// @Router /campaign/{id}/character/{character_id}/condition [post]
+func (a *CampaignApi) SetCharacterCondition(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "character_id", func(characterID uint) {
+			characterCondition := &model.CreateCharacterCondition{}
+			if err := ctx.ShouldBindJSON(characterCondition); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			character := a.campaignCharacter(ctx, id, characterID)
+			if character == nil {
+				return
+			}
+			condition, err := a.DB.GetConditionByID(characterCondition.ConditionID)
+			if err != nil || condition == nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition not found"})
+				return
+			}
+			count := characterCondition.Count
+			if !condition.Valued {
+				count = 1
+			}
+			if count < 1 {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "Condition count must be positive"})
+				return
+			}
+
+			if isDefenceCondition(condition) {
+				err = a.setDefenceCondition(character, condition.Name, uint8(count))
+			} else {
+				err = a.DB.SetCharacterCondition(&model.CharacterCondition{
+					CharacterID: character.ID,
+					ConditionID: condition.ID,
+					Count:       count,
+				})
+			}
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			a.respondCharacterConditions(ctx, characterID)
+		})
+	})
+}
+
+// DeleteCharacterCondition godoc
+//
+// @Summary Removes condition from Character in Campaign
+// @Description Permissions for Game Master
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param character_id path int true "Character id"
+// @Param condition_id path int true "Condition id"
+// @Success 200 {object} []model.CharacterConditionExternal "character conditions"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Condition not found"
+// @Router /campaign/{id}/character/{character_id}/condition/{condition_id} [delete]
+func (a *CampaignApi) DeleteCharacterCondition(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "character_id", func(characterID uint) {
+			withID(ctx, "condition_id", func(conditionID uint) {
+				character := a.campaignCharacter(ctx, id, characterID)
+				if character == nil {
+					return
+				}
+				condition, err := a.DB.GetConditionByID(conditionID)
+				if err != nil || condition == nil {
+					ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition not found"})
+					return
+				}
+				if isDefenceCondition(condition) {
+					err = a.setDefenceCondition(character, condition.Name, 0)
+				} else {
+					err = a.DB.DeleteCharacterCondition(characterID, conditionID)
+				}
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+				a.respondCharacterConditions(ctx, characterID)
+			})
+		})
+	})
+}
+
+// EndTurn godoc
+//
+// @Summary Ends turn of Character in Campaign
+// @Description Permissions for Game Master, decrements conditions that fade at the end of turn
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param character_id path int true "Character id"
+// @Success 200 {object} []model.CharacterConditionExternal "character conditions"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character doesn't play in this campaign"
+// @Router /campaign/{id}/character/{character_id}/end-turn [post]
+func (a *CampaignApi) EndTurn(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "character_id", func(characterID uint) {
+			character := a.campaignCharacter(ctx, id, characterID)
+			if character == nil {
+				return
+			}
+			changed := rules.EndTurn(character.CharacterCondition)
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterConditions(changed)); !success {
+				return
+			}
+			a.respondCharacterConditions(ctx, characterID)
+		})
+	})
+}
+
+// isDefenceCondition reports whether the condition is stored on the character defence
+func isDefenceCondition(condition *model.Condition) bool {
+	return condition.Name == rules.ConditionDying || condition.Name == rules.ConditionWounded
+}
+
+func (a *CampaignApi) setDefenceCondition(character *model.Character, name string, count uint8) error {
+	defence := &character.CharacterDefence
+	if name == rules.ConditionDying {
+		defence.Dying = count
+	} else {
+		defence.Wounded = count
+	}
+	return a.DB.UpdateDyingWounded(defence)
+}
+
+func (a *CampaignApi) respondCharacterConditions(ctx *gin.Context, characterID uint) {
+	character, err := a.DB.GetCharacterByID(characterID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	ctx.JSON(http.StatusOK, ToExternalCharacterConditions(character))
+}
+
+func ToExternalCharacterConditions(character *model.Character) []model.CharacterConditionExternal {
+	conditions := []model.CharacterConditionExternal{}
+	if character.CharacterDefence.Dying > 0 {
+		conditions = append(conditions, model.CharacterConditionExternal{
+			Name:  rules.ConditionDying,
+			Count: int8(character.CharacterDefence.Dying),
+		})
+	}
+	if character.CharacterDefence.Wounded > 0 {
+		conditions = append(conditions, model.CharacterConditionExternal{
+			Name:  rules.ConditionWounded,
+			Count: int8(character.CharacterDefence.Wounded),
+		})
+	}
+	for _, characterCondition := range character.CharacterCondition {
+		conditions = append(conditions, model.CharacterConditionExternal{
+			ConditionID: characterCondition.ConditionID,
+			Name:        characterCondition.Condition.Name,
+			Count:       characterCondition.Count,
+		})
+	}
+	return conditions
+}
@@ -20,17 +20,21 @@ func (a *CharacterApi) CreateCharacterDefence(ctx *gin.Context,
 	race *model.Race,
 	characterClass *model.CharacterClass) {
 	internal := &model.CharacterDefence{
-		CharacterID: characterId,
-		HitPoint:    race.HitPoint + characterClass.HitPoint,
-		MaxHitPoint: race.HitPoint + characterClass.HitPoint,
-		Perception:  characterClass.Perception,
-		Fortitude:   characterClass.Fortitude,
-		Reflex:      characterClass.Reflex,
-		Will:        characterClass.Will,
-		Unarmed:     characterClass.UnarmedArmor,
-		LightArmor:  characterClass.LightArmor,
-		MediumArmor: characterClass.MediumArmor,
-		HeavyArmor:  characterClass.HeavyArmor,
+		CharacterID:   characterId,
+		HitPoint:      race.HitPoint + characterClass.HitPoint,
+		MaxHitPoint:   race.HitPoint + characterClass.HitPoint,
+		Perception:    characterClass.Perception,
+		Fortitude:     characterClass.Fortitude,
+		Reflex:        characterClass.Reflex,
+		Will:          characterClass.Will,
+		Unarmed:       characterClass.UnarmedArmor,
+		LightArmor:    characterClass.LightArmor,
+		MediumArmor:   characterClass.MediumArmor,
+		HeavyArmor:    characterClass.HeavyArmor,
+		UnArmedWeapon: characterClass.UnArmedWeapon,
+		CommonWeapon:  characterClass.CommonWeapon,
+		MartialWeapon: characterClass.MartialWeapon,
+		Speed:         race.Speed,
 	}
 	a.DB.CreateCharacterDefence(internal)
 }
@@ -67,5 +71,8 @@ func ToExternalCharacterDefence(characterDefence *model.CharacterDefence) *model
 		LightArmor:        characterDefence.LightArmor,
 		MediumArmor:       characterDefence.MediumArmor,
 		HeavyArmor:        characterDefence.HeavyArmor,
+		UnArmedWeapon:     characterDefence.UnArmedWeapon,
+		CommonWeapon:      characterDefence.CommonWeapon,
+		MartialWeapon:     characterDefence.MartialWeapon,
 	}
 }
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"kingdom/auth"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
@@ -20,13 +23,15 @@ func (a *CharacterApi) CreateCharacterFeat(characterID uint, background *model.B
 // AddCharacterFeat godoc
 //
 // @Summary Create and returns character feat or nil
-// @Description Create new character
+// @Description Checks level, skill mastery, prerequisite feats, ancestry and class traits, dedication rules and free feat slots
 // @Tags Character Feat
 // @Accept json
 // @Produce json
 // @Param characterFeat body model.CreateCharacterFeat true "Character feat data"
 // @Success 201 {object} model.CharacterFeatExternal "Character feat details"
+// @Failure 400 {string} string "Unmet feat prerequisites"
 // @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Character or feat not found"
 // @Router /character_feat [post]
 func (a *CharacterApi) AddCharacterFeat(ctx *gin.Context) {
 	characterFeat := &model.CreateCharacterFeat{}
@@ -34,24 +39,40 @@ func (a *CharacterApi) AddCharacterFeat(ctx *gin.Context) {
 		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Character Feat not found"})
 		return
 	}
+	if !auth.CheckCharacterAccess(ctx, a.DB, model.CharacterResource, characterFeat.CharacterID) {
+		return
+	}
 
-	feat, err := a.DB.GetFeatByID(characterFeat.FeatID)
+	character, err := a.DB.GetCharacterByID(characterFeat.CharacterID)
 	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Feat not found"})
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return
+	}
+	feat, err := a.DB.GetFeatByID(characterFeat.FeatID)
+	if err != nil || feat == nil {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Feat not found"})
+		return
+	}
+	catalog, err := a.featCatalog()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	taken, err := a.takenFeats(character)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	free, err := a.freeFeatSlots(character, taken)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
 		return
 	}
 
-	if feat.PrerequisiteSkillID != nil {
-		characterSkill, err := a.DB.GetCharacterSkillByCharacterID(characterFeat.CharacterID, *feat.PrerequisiteSkillID)
-		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			return
-		}
-
-		if !a.checkSkillMastery(characterSkill.Mastery, feat.PrerequisiteMastery) {
-			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Wrong Skill Mastery"})
-			return
-		}
+	unmet := rules.UnmetFeatRules(character, feat, catalog, taken)
+	if _, ok := rules.SlotFor(free, feat.Category); !ok {
+		unmet = append(unmet, fmt.Sprintf("no free slot for %s feat", feat.Category))
+	}
+	if len(unmet) > 0 {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Unmet feat prerequisites", "unmet": unmet})
+		return
 	}
 
 	internal := model.CharacterFeat{
@@ -66,14 +87,101 @@ func (a *CharacterApi) AddCharacterFeat(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, internal)
 }
 
-func (a *CharacterApi) checkSkillMastery(actual, required model.MasteryLevel) bool {
-	masteryOrder := map[model.MasteryLevel]int{
-		model.None:      0,
-		model.Train:     1,
-		model.Expert:    2,
-		model.Master:    3,
-		model.Legendary: 4,
+// GetEligibleFeats godoc
+//
+// @Summary Returns feats the Character can currently take
+// @Description Feats whose prerequisites are met and that fit a free feat slot of their category
+// @Tags Character Feat
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.EligibleFeatExternal "eligible feats"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/eligible-feats [get]
+func (a *CharacterApi) GetEligibleFeats(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		catalog, err := a.featCatalog()
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		taken, err := a.takenFeats(character)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		free, err := a.freeFeatSlots(character, taken)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		maxLevel := uint8(character.Level)
+		feats, err := a.DB.FilterFeats(&model.FeatFilter{MaxLevel: &maxLevel})
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := &model.EligibleFeatExternal{Feats: []*model.FeatExternal{}, FreeSlots: free}
+		for _, feat := range feats {
+			if _, ok := rules.SlotFor(free, feat.Category); !ok {
+				continue
+			}
+			if len(rules.UnmetFeatRules(character, feat, catalog, taken)) == 0 {
+				resp.Feats = append(resp.Feats, ToExternalFeat(feat))
+			}
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+func (a *CharacterApi) featCatalog() (*rules.FeatCatalog, error) {
+	skills, err := a.DB.GetSkills()
+	if err != nil {
+		return nil, err
 	}
+	races, err := a.DB.GetRaces()
+	if err != nil {
+		return nil, err
+	}
+	classes, err := a.DB.GetCharacterClasses()
+	if err != nil {
+		return nil, err
+	}
+	var ancestryNames, classNames []string
+	for _, race := range races {
+		ancestryNames = append(ancestryNames, race.Name)
+	}
+	for _, class := range classes {
+		classNames = append(classNames, class.Name)
+	}
+	archetypes, err := a.DB.GetArchetypes()
+	if err != nil {
+		return nil, err
+	}
+	catalog := rules.NewFeatCatalog(skills, ancestryNames, classNames)
+	for _, archetype := range archetypes {
+		catalog.Archetypes[archetype.ID] = archetype
+	}
+	return catalog, nil
+}
 
-	return masteryOrder[actual] >= masteryOrder[required]
+func (a *CharacterApi) takenFeats(character *model.Character) ([]model.Feat, error) {
+	if len(character.CharacterFeat) == 0 {
+		return nil, nil
+	}
+	var IDs []uint
+	for _, characterFeat := range character.CharacterFeat {
+		IDs = append(IDs, characterFeat.FeatID)
+	}
+	return a.DB.FindFeats(IDs)
+}
+
+func (a *CharacterApi) freeFeatSlots(character *model.Character, taken []model.Feat) (map[model.FeatCategory]int, error) {
+	features, err := a.DB.GetClassFeatureByClassID(character.CharacterClassID)
+	if err != nil {
+		return nil, err
+	}
+	return rules.FreeFeatSlots(character, features, taken), nil
 }
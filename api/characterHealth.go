@@ -0,0 +1,242 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// DamageCharacter godoc
+//
+// @Summary Deals damage to Character
+// @Description Temporary hit points are spent first, at 0 hit points the character is dying.
+// @Description With shield_block the raised shield prevents damage up to its Hardness, the character and
+// @Description the shield both take the rest
+// @Tags Character Health
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param damage body model.DamageCharacter true "Damage data"
+// @Success 200 {object} model.HealthLogExternal "applied change"
+// @Failure 400 {string} string "Character is dead"
+// @Failure 400 {string} string "Shield Block needs a raised shield"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/damage [post]
+func (a *CharacterApi) DamageCharacter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		damage := &model.DamageCharacter{}
+		if err := ctx.ShouldBindJSON(damage); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		healthLog := &model.HealthLog{
+			Kind:     model.HealthChangeDamage,
+			Amount:   damage.Amount,
+			Critical: damage.Critical,
+		}
+		amount := damage.Amount
+		if damage.ShieldBlock {
+			block, ok := a.shieldBlock(ctx, id, healthLog)
+			if !ok {
+				return
+			}
+			amount = block.CharacterDamage
+		}
+		a.changeHealth(ctx, id, healthLog, func(defence *model.CharacterDefence) {
+			rules.ApplyDamage(defence, amount, damage.Critical)
+		})
+	})
+}
+
+// HealCharacter godoc
+//
+// @Summary Heals Character
+// @Description Hit points are restored up to the maximum, a dying character recovers and gains wounded 1
+// @Tags Character Health
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param heal body model.HealCharacter true "Heal data"
+// @Success 200 {object} model.HealthLogExternal "applied change"
+// @Failure 400 {string} string "Character is dead"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/heal [post]
+func (a *CharacterApi) HealCharacter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		heal := &model.HealCharacter{}
+		if err := ctx.ShouldBindJSON(heal); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		a.changeHealth(ctx, id, &model.HealthLog{
+			Kind:   model.HealthChangeHeal,
+			Amount: heal.Amount,
+		}, func(defence *model.CharacterDefence) {
+			rules.Heal(defence, heal.Amount)
+		})
+	})
+}
+
+// RecoveryCheck godoc
+//
+// @Summary Rolls recovery check of dying Character
+// @Description Flat check against DC 10 + dying rolled by the server, recovering clears dying and increases wounded
+// @Tags Character Health
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.HealthLogExternal "applied change with the roll"
+// @Failure 400 {string} string "Character is not dying"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/recovery-check [post]
+func (a *CharacterApi) RecoveryCheck(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		roll := uint8(a.Roller.Die(20))
+		a.changeHealth(ctx, id, &model.HealthLog{
+			Kind: model.HealthChangeRecovery,
+			Roll: &roll,
+		}, func(defence *model.CharacterDefence) {
+			rules.RecoveryCheck(defence, int(roll))
+		})
+	})
+}
+
+// GetHealthLogs godoc
+//
+// @Summary Returns health changes of Character
+// @Description Every damage, heal and recovery check with the resulting state, the latest first
+// @Tags Character Health
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} []model.HealthLogExternal "health log"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /character/{id}/health-log [get]
+func (a *CharacterApi) GetHealthLogs(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		healthLogs, err := a.DB.GetHealthLogs(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := []*model.HealthLogExternal{}
+		for _, healthLog := range healthLogs {
+			resp = append(resp, ToExternalHealthLog(healthLog))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// shieldBlock blocks the damage of the log with the raised shield of the character and records the shield state
+func (a *CharacterApi) shieldBlock(
+	ctx *gin.Context,
+	characterID uint,
+	healthLog *model.HealthLog,
+) (*rules.ShieldBlockResult, bool) {
+	slot, err := a.DB.GetSlotByCharacterID(characterID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil, false
+	}
+	if slot == nil || slot.ShieldID == nil || !slot.ShieldRaised {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Shield Block needs a raised shield"})
+		return nil, false
+	}
+	shield, characterItem, err := equippedShield(a.DB, *slot.ShieldID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil, false
+	}
+	if shield == nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Shield Block needs a raised shield"})
+		return nil, false
+	}
+	hitPoint := rules.ShieldHitPoint(shield, characterItem)
+	if err := rules.CanRaiseShield(shield, hitPoint); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	block := rules.ShieldBlock(shield, hitPoint, healthLog.Amount)
+	healthLog.Blocked = block.Prevented
+	healthLog.ShieldItemID = &characterItem.ID
+	healthLog.ShieldHitPoint = &block.HitPoint
+	healthLog.ShieldBroken = block.Broken
+	return block, true
+}
+
+// HealthDatabase saves a health change of a character with its log entry
+type HealthDatabase interface {
+	ApplyHealthChange(defence *model.CharacterDefence, healthLog *model.HealthLog) error
+}
+
+// changeHealth applies the change to the character defence and saves it with the log entry
+func (a *CharacterApi) changeHealth(
+	ctx *gin.Context,
+	id uint,
+	healthLog *model.HealthLog,
+	change func(defence *model.CharacterDefence),
+) {
+	character, err := a.DB.GetCharacterByID(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return
+	}
+	if !applyHealthChange(ctx, a.DB, character, healthLog, change) {
+		return
+	}
+	ctx.JSON(http.StatusOK, ToExternalHealthLog(healthLog))
+}
+
+// applyHealthChange changes the defence of the character and logs it or responds with an error
+func applyHealthChange(
+	ctx *gin.Context,
+	db HealthDatabase,
+	character *model.Character,
+	healthLog *model.HealthLog,
+	change func(defence *model.CharacterDefence),
+) bool {
+	defence := &character.CharacterDefence
+	if rules.IsDead(defence) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character is dead"})
+		return false
+	}
+	if healthLog.Kind == model.HealthChangeRecovery && defence.Dying == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character is not dying"})
+		return false
+	}
+
+	change(defence)
+	healthLog.CharacterID = character.ID
+	healthLog.UserID = auth.GetUserID(ctx)
+	healthLog.HitPoint = defence.HitPoint
+	healthLog.TemporaryHitPoint = defence.TemporaryHitPoint
+	healthLog.Dying = defence.Dying
+	healthLog.Wounded = defence.Wounded
+	healthLog.Dead = rules.IsDead(defence)
+	return SuccessOrAbort(ctx, 500, db.ApplyHealthChange(defence, healthLog))
+}
+
+func ToExternalHealthLog(healthLog *model.HealthLog) *model.HealthLogExternal {
+	return &model.HealthLogExternal{
+		ID:                healthLog.ID,
+		CharacterID:       healthLog.CharacterID,
+		UserID:            healthLog.UserID,
+		Kind:              healthLog.Kind,
+		Amount:            healthLog.Amount,
+		Critical:          healthLog.Critical,
+		Roll:              healthLog.Roll,
+		HitPoint:          healthLog.HitPoint,
+		TemporaryHitPoint: healthLog.TemporaryHitPoint,
+		Dying:             healthLog.Dying,
+		Wounded:           healthLog.Wounded,
+		Dead:              healthLog.Dead,
+		Blocked:           healthLog.Blocked,
+		ShieldItemID:      healthLog.ShieldItemID,
+		ShieldHitPoint:    healthLog.ShieldHitPoint,
+		ShieldBroken:      healthLog.ShieldBroken,
+		ShieldDestroyed:   healthLog.ShieldHitPoint != nil && *healthLog.ShieldHitPoint == 0,
+		CreatedAt:         healthLog.CreatedAt,
+	}
+}
@@ -2,12 +2,29 @@ package api
 
 import (
 	"kingdom/model"
+	"kingdom/rules"
 )
 
+// EncumbranceDatabase loads the inventory and Strength the Bulk and Speed of a character are computed from
+type EncumbranceDatabase interface {
+	GetCharacterByID(id uint) (*model.Character, error)
+	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	GetArmorByID(id uint) (*model.Armor, error)
+	UpdateCharacterSpeed(characterID uint, speed uint8) error
+	GetCharacterItems(characterId uint) ([]*model.CharacterItem, error)
+	GetSlotByCharacterID(characterID uint) (*model.Slot, error)
+	GetAttributeByID(characterID uint) (*model.Attribute, error)
+	GetCharacterInfoByID(characterID uint) (*model.CharacterInfo, error)
+	UpdateCharacterInfo(characterInfo *model.CharacterInfo) error
+	GetConditionByName(name string) (*model.Condition, error)
+	SetCharacterCondition(characterCondition *model.CharacterCondition) error
+	DeleteCharacterCondition(characterID uint, conditionID uint) error
+}
+
 func (a *CharacterApi) CreateCharacterInfo(characterID uint, strength uint8) {
 	characterInfo := &model.CharacterInfo{
 		CharacterID: characterID,
-		MaxBulk:     float64(10 + uint8(strength/2)),
+		MaxBulk:     float64(rules.MaxBulk(strength)),
 	}
 	err := a.DB.CreateCharacterInfo(characterInfo)
 	if err != nil {
@@ -15,15 +32,84 @@ func (a *CharacterApi) CreateCharacterInfo(characterID uint, strength uint8) {
 	}
 }
 
-func (a *CharacterItemApi) UpdateCharacterBulk(characterId uint, bulk float64, isRemove bool) {
-	characterInfo, _ := a.DB.GetCharacterInfoByID(characterId)
-	if isRemove {
-		characterInfo.Bulk -= bulk
-	} else {
-		characterInfo.Bulk += bulk
-	}
-	err := a.DB.UpdateCharacterInfo(characterInfo)
+// computeEncumbrance returns the Bulk of the character computed from its items
+func computeEncumbrance(db EncumbranceDatabase, characterID uint) (*rules.Encumbrance, error) {
+	items, err := db.GetCharacterItems(characterID)
 	if err != nil {
-		return
+		return nil, err
+	}
+	slot, err := db.GetSlotByCharacterID(characterID)
+	if err != nil {
+		return nil, err
+	}
+	var wornArmorID *uint
+	if slot != nil {
+		wornArmorID = slot.ArmorID
+	}
+	strength := uint8(10)
+	if attribute, err := db.GetAttributeByID(characterID); err == nil && attribute != nil {
+		strength = attribute.Strength
+	}
+	return rules.ComputeEncumbrance(items, wornArmorID, strength), nil
+}
+
+// syncEncumbrance stores the Bulk computed from the items, applies or removes Encumbered and updates the Speed
+func syncEncumbrance(db EncumbranceDatabase, characterID uint) (*rules.Encumbrance, error) {
+	encumbrance, err := computeEncumbrance(db, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if characterInfo, err := db.GetCharacterInfoByID(characterID); err == nil {
+		characterInfo.Bulk = encumbrance.Total()
+		characterInfo.MaxBulk = float64(encumbrance.MaxBulk)
+		if err := db.UpdateCharacterInfo(characterInfo); err != nil {
+			return nil, err
+		}
+	}
+	condition, err := db.GetConditionByName(rules.ConditionEncumbered)
+	if err != nil {
+		return nil, err
+	}
+	if condition != nil {
+		if encumbrance.Encumbered {
+			err = db.SetCharacterCondition(&model.CharacterCondition{CharacterID: characterID, ConditionID: condition.ID, Count: 1})
+		} else {
+			err = db.DeleteCharacterCondition(characterID, condition.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return encumbrance, syncSpeed(db, characterID, encumbrance.Encumbered)
+}
+
+// syncSpeed stores the ancestry Speed reduced by the worn armor and by Encumbered
+func syncSpeed(db EncumbranceDatabase, characterID uint, encumbered bool) error {
+	character, err := db.GetCharacterByID(characterID)
+	if err != nil || character == nil {
+		return err
+	}
+	var armor *model.Armor
+	if slot, err := db.GetSlotByCharacterID(characterID); err != nil {
+		return err
+	} else if slot != nil && slot.ArmorID != nil {
+		if armor, _, err = equippedArmor(db, *slot.ArmorID); err != nil {
+			return err
+		}
+	}
+	speed := rules.Speed(character.Race.Speed, armor, character.Attribute.Strength, encumbered)
+	return db.UpdateCharacterSpeed(characterID, speed)
+}
+
+func ToExternalCharacterBulk(characterID uint, encumbrance *rules.Encumbrance) *model.CharacterBulkExternal {
+	return &model.CharacterBulkExternal{
+		CharacterID:  characterID,
+		Bulk:         encumbrance.Bulk,
+		Light:        encumbrance.Light,
+		EncumberedAt: encumbrance.EncumberedAt,
+		MaxBulk:      encumbrance.MaxBulk,
+		Encumbered:   encumbrance.Encumbered,
+		Overloaded:   encumbrance.Overloaded,
+		Total:        encumbrance.Total(),
 	}
 }
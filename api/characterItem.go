@@ -2,7 +2,9 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"kingdom/auth"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
@@ -12,8 +14,17 @@ type CharacterItemDatabase interface {
 	GetCharacterItems(characterId uint) ([]*model.CharacterItem, error)
 	UpdateCharacterItem(item *model.CharacterItem) error
 	DeleteCharacterItem(id uint) error
-	GetCharacterInfoByID(characterID uint) (*model.CharacterInfo, error)
-	UpdateCharacterInfo(characterInfo *model.CharacterInfo) error
+	GetItemByID(id uint) (*model.Item, error)
+	GetShieldByID(id uint) (*model.Shield, error)
+	FindRunes(IDs []uint) ([]model.Rune, error)
+	UpdateCharacterItemRunes(items ...*model.CharacterItem) error
+	SplitCharacterItem(stack *model.CharacterItem) (*model.CharacterItem, error)
+	GetConsumableByID(id uint) (*model.Consumable, error)
+	UpdateCharacterItemCharges(item *model.CharacterItem) error
+	GetUserByID(id uint) (*model.User, error)
+	GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error)
+	IsCharacterGameMaster(characterID uint, userID uint) (bool, error)
+	EncumbranceDatabase
 }
 
 type CharacterItemApi struct {
@@ -23,37 +34,62 @@ type CharacterItemApi struct {
 // CreateCharacterItem godoc
 //
 // @Summary Create and returns CharacterItem or nil
-// @Description Permissions for Admin
+// @Description Permissions for Character's User or Admin, a stowed item goes in a container of the character,
+// @Description bulk and the Encumbered condition are recomputed from the inventory
 // @Tags Character Item
 // @Accept json
 // @Produce json
 // @Param characterItem body model.CreateCharacterItem true "CharacterItem data"
 // @Success 201 {object} model.CharacterItemExternal "CharacterItem details"
+// @Failure 400 {string} string "Wrong container"
 // @Failure 401 {string} string "Unauthorized"
 // @Failure 403 {string} string "You can't access for this API"
 // @Router /character-item [post]
 func (a *CharacterItemApi) CreateCharacterItem(ctx *gin.Context) {
 	characterItem := &model.CreateCharacterItem{}
 	if err := ctx.ShouldBindJSON(characterItem); err == nil {
+		if !auth.CheckCharacterAccess(ctx, a.DB, model.CharacterResource, characterItem.CharacterID) {
+			return
+		}
 		internal := &model.CharacterItem{
 			CharacterID: characterItem.CharacterID,
 			ItemID:      characterItem.ItemID,
 			Quantity:    characterItem.Quantity,
+			Placement:   characterItem.Placement,
+			ContainerID: characterItem.ContainerID,
+		}
+		item, err := a.DB.GetItemByID(characterItem.ItemID)
+		if err != nil || item == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item not found"})
+			return
+		}
+		if !placeItem(ctx, a.DB, internal, item) {
+			return
+		}
+		// a new shield starts undamaged
+		if item.OwnerType == "shields" {
+			shield, err := a.DB.GetShieldByID(item.OwnerID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			if shield != nil {
+				internal.HitPoint = &shield.HitPoint
+			}
 		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateCharacterItem(internal)); !success {
 			return
 		}
-		newCharacterItem, err := a.DB.GetCharacterItemByID(internal.CharacterID)
-		if err != nil {
+		newCharacterItem, err := a.DB.GetCharacterItemByID(internal.ID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if _, err := syncEncumbrance(a.DB, newCharacterItem.CharacterID); err != nil {
+			SuccessOrAbort(ctx, 500, err)
 			return
 		}
 		ctx.JSON(http.StatusCreated, ToExternalCharacterItem(newCharacterItem, &newCharacterItem.Character, &newCharacterItem.Item))
-		go func() {
-			a.UpdateCharacterBulk(
-				newCharacterItem.CharacterID,
-				newCharacterItem.Item.Bulk*float64(newCharacterItem.Quantity),
-				false)
-		}()
+	} else {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 	}
 }
 
@@ -84,7 +120,7 @@ func (a *CharacterItemApi) GetCharacterItemByID(ctx *gin.Context) {
 // GetCharacterItems godoc
 //
 // @Summary Returns all CharacterItems
-// @Description Return all CharacterItems
+// @Description Return all CharacterItems with the bulk computed from them
 // @Tags Character Item
 // @Accept json
 // @Produce json
@@ -99,16 +135,18 @@ func (a *CharacterItemApi) GetCharacterItems(ctx *gin.Context) {
 			ctx.JSON(http.StatusNotFound, err)
 		}
 		var resp []*model.CharacterItemExternal
-		var bulk float64
 		for _, characterItem := range CharacterItems {
-			characterItemExternal := ToExternalCharacterItem(characterItem, &characterItem.Character, &characterItem.Item)
-			resp = append(resp, characterItemExternal)
-			bulk += characterItemExternal.Bulk
+			resp = append(resp, ToExternalCharacterItem(characterItem, &characterItem.Character, &characterItem.Item))
+		}
+		encumbrance, err := computeEncumbrance(a.DB, id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
 		}
 
 		ctx.JSON(http.StatusOK, gin.H{
-			"resp": resp,
-			"bulk": bulk,
+			"resp":        resp,
+			"bulk":        encumbrance.Total(),
+			"encumbrance": ToExternalCharacterBulk(id, encumbrance),
 		})
 	})
 }
@@ -116,20 +154,22 @@ func (a *CharacterItemApi) GetCharacterItems(ctx *gin.Context) {
 // UpdateCharacterItem Updates CharacterItem by ID
 //
 // @Summary Updates CharacterItem by ID or nil
-// @Description Permissions for Admin
+// @Description Permissions for Character's User or Admin, without placement the item stays where it is,
+// @Description bulk and the Encumbered condition are recomputed from the inventory
 // @Tags Character Item
 // @Accept json
 // @Produce json
 // @Param id path int true "CharacterItem id"
 // @Param characterItem body model.UpdateCharacterItem true "CharacterItem data"
 // @Success 200 {object} model.CharacterItemExternal "CharacterItem details"
+// @Failure 400 {string} string "Wrong container"
 // @Failure 403 {string} string "You can't access for this API"
 // @Failure 404 {string} string "CharacterItem doesn't exist"
 // @Router /character-item/{id} [patch]
 func (a *CharacterItemApi) UpdateCharacterItem(ctx *gin.Context) {
 	withID(ctx, "id", func(id uint) {
 		var characterItem *model.UpdateCharacterItem
-		if err := ctx.Bind(&characterItem); err == nil {
+		if err := ctx.ShouldBindJSON(&characterItem); err == nil {
 			oldCharacterItem, err := a.DB.GetCharacterItemByID(id)
 			if success := SuccessOrAbort(ctx, 500, err); !success {
 				return
@@ -137,33 +177,35 @@ func (a *CharacterItemApi) UpdateCharacterItem(ctx *gin.Context) {
 			if oldCharacterItem != nil {
 				internal := &model.CharacterItem{
 					ID:          oldCharacterItem.ID,
-					CharacterID: id,
+					CharacterID: oldCharacterItem.CharacterID,
+					ItemID:      oldCharacterItem.ItemID,
 					Quantity:    characterItem.Quantity,
+					Placement:   oldCharacterItem.Placement,
+					ContainerID: oldCharacterItem.ContainerID,
+				}
+				if characterItem.Placement != "" {
+					internal.Placement = characterItem.Placement
+					internal.ContainerID = characterItem.ContainerID
+				}
+				if !placeItem(ctx, a.DB, internal, &oldCharacterItem.Item) {
+					return
 				}
 				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterItem(internal)); !success {
 					return
 				}
+				if _, err := syncEncumbrance(a.DB, internal.CharacterID); err != nil {
+					SuccessOrAbort(ctx, 500, err)
+					return
+				}
 				newCharacterItem, _ := a.DB.GetCharacterItemByID(oldCharacterItem.ID)
 				ctx.JSON(http.StatusOK, ToExternalCharacterItem(newCharacterItem,
 					&newCharacterItem.Character,
 					&newCharacterItem.Item))
-				go func() {
-					var different uint = 0
-					isRemove := true
-					if newCharacterItem.Quantity > oldCharacterItem.Quantity {
-						different = newCharacterItem.Quantity - oldCharacterItem.Quantity
-						isRemove = false
-					} else if newCharacterItem.Quantity < oldCharacterItem.Quantity {
-						different = oldCharacterItem.Quantity - newCharacterItem.Quantity
-					}
-					a.UpdateCharacterBulk(
-						newCharacterItem.CharacterID,
-						newCharacterItem.Item.Bulk*float64(different),
-						isRemove)
-				}()
+			} else {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "CharacterItem doesn't exist"})
 			}
 		} else {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "CharacterItem doesn't exist"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		}
 
 	})
@@ -191,16 +233,14 @@ func (a *CharacterItemApi) DeleteCharacterItem(ctx *gin.Context) {
 			if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCharacterItem(id)); !success {
 				return
 			}
+			if _, err := syncEncumbrance(a.DB, characterItem.CharacterID); err != nil {
+				SuccessOrAbort(ctx, 500, err)
+				return
+			}
 			ctx.JSON(http.StatusNoContent, gin.H{"error": "Character Item was deleted"})
 		} else {
 			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character Item doesn't exist"})
 		}
-		go func() {
-			a.UpdateCharacterBulk(
-				characterItem.CharacterID,
-				characterItem.Item.Bulk*float64(characterItem.Quantity),
-				true)
-		}()
 	})
 }
 
@@ -208,6 +248,10 @@ func ToExternalCharacterItem(
 	characterItem *model.CharacterItem,
 	character *model.Character,
 	item *model.Item) *model.CharacterItemExternal {
+	runes := []string{}
+	for _, propertyRune := range characterItem.Runes {
+		runes = append(runes, propertyRune.Name)
+	}
 	return &model.CharacterItemExternal{
 		ID:            characterItem.ID,
 		CharacterID:   character.ID,
@@ -217,5 +261,39 @@ func ToExternalCharacterItem(
 		ItemName:      item.Name,
 		ItemType:      item.OwnerType,
 		Bulk:          item.Bulk * float64(characterItem.Quantity),
+		Placement:     characterItem.Placement,
+		ContainerID:   characterItem.ContainerID,
+		HitPoint:      characterItem.HitPoint,
+		Charges:       characterItem.Charges,
+		Potency:       characterItem.Potency,
+		Striking:      characterItem.Striking,
+		Resilient:     characterItem.Resilient,
+		Runes:         runes,
+	}
+}
+
+// placeItem defaults the placement from the container and checks the container holds the item
+func placeItem(ctx *gin.Context, db EncumbranceDatabase, characterItem *model.CharacterItem, item *model.Item) bool {
+	if characterItem.ContainerID != nil {
+		characterItem.Placement = model.StowedItem
+	} else if characterItem.Placement == model.StowedItem {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Stowed item needs a container"})
+		return false
+	} else if characterItem.Placement == "" {
+		characterItem.Placement = model.CarriedItem
+	}
+	if characterItem.ContainerID == nil {
+		return true
+	}
+	items, err := db.GetCharacterItems(characterItem.CharacterID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return false
+	}
+	stowed := *characterItem
+	stowed.Item = *item
+	if err := rules.ValidateContainer(items, &stowed); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
 	}
+	return true
 }
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// ActivateCharacterItem godoc
+//
+// @Summary Activates a consumable, wand or staff of Character
+// @Description Permissions for Character's User or Admin, a consumable spends a use and is used up with the last one,
+// @Description a wand or staff spends a charge until the next rest,
+// @Description one wand or staff of a stack is taken off it to keep its own charges,
+// @Description scrolls, wands and staves return the spell they cast
+// @Tags Character Item
+// @Accept json
+// @Produce json
+// @Param id path int true "CharacterItem id"
+// @Success 200 {object} model.ActivationExternal "Activation result"
+// @Failure 400 {string} string "Item can't be activated"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "CharacterItem doesn't exist"
+// @Router /character-item/{id}/activate [post]
+func (a *CharacterItemApi) ActivateCharacterItem(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		characterItem, err := a.DB.GetCharacterItemByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if characterItem == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "CharacterItem doesn't exist"})
+			return
+		}
+		if characterItem.Item.OwnerType != "consumables" {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item can't be activated"})
+			return
+		}
+		consumable, err := a.DB.GetConsumableByID(characterItem.Item.OwnerID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if consumable == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item can't be activated"})
+			return
+		}
+		stack := *characterItem
+		result, err := rules.Activate(consumable, characterItem)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if result.Split && !a.splitCharacterItem(ctx, &stack, characterItem) {
+			return
+		}
+
+		resp := &model.ActivationExternal{Consumed: result.Consumed}
+		if consumable.Spell != nil {
+			resp.SpellID = consumable.SpellID
+			resp.SpellName = consumable.Spell.Name
+			resp.SpellRank = consumable.SpellRank
+		}
+		if result.Consumed {
+			err = a.DB.DeleteCharacterItem(id)
+		} else {
+			characterItem.Quantity = result.Quantity
+			characterItem.Charges = result.Charges
+			err = a.DB.UpdateCharacterItemCharges(characterItem)
+		}
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if _, err := syncEncumbrance(a.DB, characterItem.CharacterID); err != nil {
+			SuccessOrAbort(ctx, 500, err)
+			return
+		}
+		if !result.Consumed {
+			resp.CharacterItem = ToExternalCharacterItem(characterItem, &characterItem.Character, &characterItem.Item)
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// UpdateCharacterItemRunes godoc
+//
+// @Summary Sets the runes of CharacterItem
+// @Description Permissions for Character's User or Admin, potency and striking runes go on weapons,
+// @Description potency and resilient runes on armor and the potency rune grade limits the property runes
+// @Tags Character Item
+// @Accept json
+// @Produce json
+// @Param id path int true "CharacterItem id"
+// @Param runes body model.UpdateCharacterItemRunes true "Runes data"
+// @Success 200 {object} model.CharacterItemExternal "CharacterItem details"
+// @Failure 400 {string} string "Wrong runes"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "CharacterItem doesn't exist"
+// @Router /character-item/{id}/runes [put]
+func (a *CharacterItemApi) UpdateCharacterItemRunes(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		runes := &model.UpdateCharacterItemRunes{}
+		if err := ctx.ShouldBindJSON(runes); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		characterItem, err := a.DB.GetCharacterItemByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if characterItem == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "CharacterItem doesn't exist"})
+			return
+		}
+		characterItem.Potency = runes.Potency
+		characterItem.Striking = runes.Striking
+		characterItem.Resilient = runes.Resilient
+		characterItem.Runes = []model.Rune{}
+		if len(runes.RunesID) > 0 {
+			propertyRunes, err := a.DB.FindRunes(runes.RunesID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			if len(propertyRunes) != len(runes.RunesID) {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "Rune not found"})
+				return
+			}
+			characterItem.Runes = propertyRunes
+		}
+		// runes go on one item of a stack, it becomes its own character item
+		stack := *characterItem
+		split := characterItem.Quantity > 1 &&
+			(runes.Potency > 0 || runes.Striking > 0 || runes.Resilient > 0 || len(runes.RunesID) > 0)
+		if split {
+			characterItem.Quantity = 1
+		}
+		if err := rules.ValidateRunes(characterItem); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if split && !a.splitCharacterItem(ctx, &stack, characterItem) {
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterItemRunes(characterItem)); !success {
+			return
+		}
+		newCharacterItem, _ := a.DB.GetCharacterItemByID(characterItem.ID)
+		ctx.JSON(http.StatusOK, ToExternalCharacterItem(newCharacterItem,
+			&newCharacterItem.Character,
+			&newCharacterItem.Item))
+	})
+}
+
+// TransferRunes godoc
+//
+// @Summary Moves runes of CharacterItem to another item of the Character
+// @Description Permissions for Character's User or Admin, runes move between items of the same type,
+// @Description a fundamental rune only moves to an item without one
+// @Tags Character Item
+// @Accept json
+// @Produce json
+// @Param id path int true "CharacterItem id"
+// @Param transfer body model.TransferRunes true "Transfer data"
+// @Success 200 {object} []model.CharacterItemExternal "Both CharacterItems"
+// @Failure 400 {string} string "Wrong transfer"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "CharacterItem doesn't exist"
+// @Router /character-item/{id}/runes/transfer [post]
+func (a *CharacterItemApi) TransferRunes(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		transfer := &model.TransferRunes{}
+		if err := ctx.ShouldBindJSON(transfer); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		from, err := a.DB.GetCharacterItemByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if from == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "CharacterItem doesn't exist"})
+			return
+		}
+		to, err := a.DB.GetCharacterItemByID(transfer.TargetID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if to == nil || to.CharacterID != from.CharacterID {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Target item doesn't belong to the character"})
+			return
+		}
+		// the runes move to one item of a stack
+		stack := *to
+		split := to.Quantity > 1
+		if split {
+			to.Quantity = 1
+		}
+		if err := rules.TransferRunes(from, to, transfer); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if split && !a.splitCharacterItem(ctx, &stack, to) {
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterItemRunes(from, to)); !success {
+			return
+		}
+		resp := []*model.CharacterItemExternal{}
+		for _, characterItemID := range []uint{from.ID, to.ID} {
+			characterItem, err := a.DB.GetCharacterItemByID(characterItemID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			resp = append(resp, ToExternalCharacterItem(characterItem, &characterItem.Character, &characterItem.Item))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// splitCharacterItem takes one item off the stack and points the character item at it
+func (a *CharacterItemApi) splitCharacterItem(ctx *gin.Context, stack *model.CharacterItem,
+	characterItem *model.CharacterItem) bool {
+	single, err := a.DB.SplitCharacterItem(stack)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return false
+	}
+	characterItem.ID = single.ID
+	return true
+}
@@ -0,0 +1,449 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GetLevelUpChoices godoc
+//
+// @Summary Returns choices for the next level of Character
+// @Description Feat slots with the feats each slot accepts, skill increase, attribute boosts and mastery upgrades granted by class features
+// @Tags Character Level
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.LevelUpChoices "level up choices"
+// @Failure 400 {string} string "Character has reached max level"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/level-up [get]
+func (a *CharacterApi) GetLevelUpChoices(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		choices, ok := a.levelUpChoices(ctx, character)
+		if !ok {
+			return
+		}
+		options, err := a.levelFeatOptions(character, choices)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		choices.FeatOptions = options
+		ctx.JSON(http.StatusOK, choices)
+	})
+}
+
+// GetCharacterLevels godoc
+//
+// @Summary Returns recorded level ups of Character
+// @Description Every level up with the changes it applied
+// @Tags Character Level
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} []model.CharacterLevelExternal "level ups"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/levels [get]
+func (a *CharacterApi) GetCharacterLevels(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		levels, err := a.DB.GetCharacterLevels(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := []*model.CharacterLevelExternal{}
+		for _, level := range levels {
+			resp = append(resp, ToExternalCharacterLevel(level))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// LevelUp godoc
+//
+// @Summary Raises Character level
+// @Description Applies hit points, mastery upgrades and the picks required by class features atomically
+// @Tags Character Level
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param levelUp body model.LevelUpCreate true "Level up picks"
+// @Success 201 {object} model.CharacterLevelExternal "applied level up"
+// @Failure 400 {string} string "Wrong level up picks"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/level-up [post]
+func (a *CharacterApi) LevelUp(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		choices, ok := a.levelUpChoices(ctx, character)
+		if !ok {
+			return
+		}
+		picks := &model.LevelUpCreate{}
+		if err := ctx.ShouldBindJSON(picks); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		level := &model.CharacterLevel{
+			CharacterID: character.ID,
+			Level:       choices.Level,
+			HitPoint:    choices.HitPoint,
+		}
+		character.Level = choices.Level
+		rules.SpendLevelExperience(character, level)
+		character.CharacterDefence.MaxHitPoint += choices.HitPoint
+		character.CharacterDefence.HitPoint += choices.HitPoint
+
+		masteries := rules.DefenceMasteries(&character.CharacterDefence)
+		for _, upgrade := range choices.MasteryUpgrades {
+			*masteries[upgrade.Target] = upgrade.To
+			level.Changes = append(level.Changes, model.CharacterLevelChange{
+				Kind:     model.LevelChangeDefence,
+				Target:   upgrade.Target,
+				OldValue: string(upgrade.From),
+				NewValue: string(upgrade.To),
+			})
+		}
+
+		changes, boosts, err := applyAttributeBoosts(character, picks.AttributeBoosts, choices.AttributeBoosts)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		level.Changes = append(level.Changes, changes...)
+
+		skills, changes, err := applySkillIncrease(character, picks.SkillIncrease, choices.SkillIncrease)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		level.Changes = append(level.Changes, changes...)
+
+		feats, changes, err := a.pickLevelFeats(character, picks, choices)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		level.Changes = append(level.Changes, changes...)
+
+		if success := SuccessOrAbort(ctx, 500, a.DB.LevelUpCharacter(character, level, skills, feats, boosts)); !success {
+			return
+		}
+		if _, err := syncEncumbrance(a.DB, character.ID); err != nil {
+			SuccessOrAbort(ctx, 500, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, ToExternalCharacterLevel(level))
+	})
+}
+
+// LevelDown godoc
+//
+// @Summary Rolls back the last level up of Character
+// @Description Undoes exactly the changes recorded by the last level up
+// @Tags Character Level
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.CharacterExternal "character details"
+// @Failure 400 {string} string "No recorded level up to roll back"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/level-down [post]
+func (a *CharacterApi) LevelDown(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		level, err := a.DB.GetCharacterLevel(character.ID, character.Level)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if level == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "No recorded level up to roll back"})
+			return
+		}
+
+		var skills []*model.CharacterSkill
+		masteries := rules.DefenceMasteries(&character.CharacterDefence)
+		for _, change := range level.Changes {
+			switch change.Kind {
+			case model.LevelChangeDefence:
+				if mastery, ok := masteries[change.Target]; ok {
+					*mastery = model.MasteryLevel(change.OldValue)
+				}
+			case model.LevelChangeSkill:
+				if skill := findCharacterSkill(character, change.Target); skill != nil {
+					skill.Mastery = model.MasteryLevel(change.OldValue)
+					skills = append(skills, skill)
+				}
+			case model.LevelChangeAttribute:
+				// the score before the level, kept also for characters without boost history
+				if ref := rules.AttributeRef(&character.Attribute, model.Ability(change.Target)); ref != nil {
+					if old, err := strconv.Atoi(change.OldValue); err == nil {
+						*ref = uint8(old)
+					}
+				}
+			}
+		}
+
+		defence := &character.CharacterDefence
+		defence.MaxHitPoint -= min(defence.MaxHitPoint, level.HitPoint)
+		defence.HitPoint -= min(defence.HitPoint, level.HitPoint)
+		if defence.HitPoint > defence.MaxHitPoint {
+			defence.HitPoint = defence.MaxHitPoint
+		}
+		character.Level--
+		rules.RefundLevelExperience(character, level)
+
+		if success := SuccessOrAbort(ctx, 500, a.DB.LevelDownCharacter(character, level, skills)); !success {
+			return
+		}
+		if _, err := syncEncumbrance(a.DB, character.ID); err != nil {
+			SuccessOrAbort(ctx, 500, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCharacter(character))
+	})
+}
+
+func (a *CharacterApi) levelUpChoices(ctx *gin.Context, character *model.Character) (*model.LevelUpChoices, bool) {
+	if character.Level >= rules.MaxLevel {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character has reached max level"})
+		return nil, false
+	}
+	features, err := a.DB.GetClassFeaturesByLevel(character.CharacterClassID, uint8(character.Level+1))
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil, false
+	}
+	return rules.LevelUpChoices(character, &character.CharacterClass, features), true
+}
+
+// levelFeatOptions lists the feats the character can take in each slot granted on the next level
+func (a *CharacterApi) levelFeatOptions(
+	character *model.Character,
+	choices *model.LevelUpChoices,
+) (map[model.FeatCategory][]*model.FeatExternal, error) {
+	options := map[model.FeatCategory][]*model.FeatExternal{}
+	granted := map[model.FeatCategory]bool{
+		model.ClassFeat:    choices.ClassFeat,
+		model.SkillFeat:    choices.SkillFeat,
+		model.GeneralFeat:  choices.GeneralFeat,
+		model.AncestryFeat: choices.AncestryFeat,
+	}
+	var slots []model.FeatCategory
+	for slot, ok := range granted {
+		if ok {
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return options, nil
+	}
+	catalog, err := a.featCatalog()
+	if err != nil {
+		return nil, err
+	}
+	taken, err := a.takenFeats(character)
+	if err != nil {
+		return nil, err
+	}
+	candidate := *character
+	candidate.Level++
+	maxLevel := uint8(candidate.Level)
+	feats, err := a.DB.FilterFeats(&model.FeatFilter{MaxLevel: &maxLevel})
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range slots {
+		options[slot] = []*model.FeatExternal{}
+		for _, feat := range feats {
+			if rules.SlotAccepts(slot, feat.Category) && len(rules.UnmetFeatRules(&candidate, feat, catalog, taken)) == 0 {
+				options[slot] = append(options[slot], ToExternalFeat(feat))
+			}
+		}
+	}
+	return options, nil
+}
+
+func applyAttributeBoosts(
+	character *model.Character,
+	abilities []model.Ability,
+	allowed uint8,
+) ([]model.CharacterLevelChange, []*model.AttributeBoost, error) {
+	if len(abilities) != int(allowed) {
+		return nil, nil, fmt.Errorf("expected %d attribute boosts, got %d", allowed, len(abilities))
+	}
+	var changes []model.CharacterLevelChange
+	var boosts []*model.AttributeBoost
+	picked := map[model.Ability]bool{}
+	for _, ability := range abilities {
+		ref := rules.AttributeRef(&character.Attribute, ability)
+		if ref == nil {
+			return nil, nil, fmt.Errorf("unknown attribute %s", ability)
+		}
+		if picked[ability] {
+			return nil, nil, fmt.Errorf("attribute %s boosted twice", ability)
+		}
+		picked[ability] = true
+		old := *ref
+		*ref = rules.BoostAttribute(old)
+		changes = append(changes, model.CharacterLevelChange{
+			Kind:     model.LevelChangeAttribute,
+			Target:   string(ability),
+			OldValue: strconv.Itoa(int(old)),
+			NewValue: strconv.Itoa(int(*ref)),
+		})
+		boosts = append(boosts, &model.AttributeBoost{
+			CharacterID: character.ID,
+			Source:      model.LevelBoostSource,
+			Level:       character.Level,
+			Ability:     ability,
+		})
+	}
+	return changes, boosts, nil
+}
+
+func applySkillIncrease(
+	character *model.Character,
+	name *string,
+	allowed bool,
+) ([]*model.CharacterSkill, []model.CharacterLevelChange, error) {
+	if name == nil {
+		if allowed {
+			return nil, nil, fmt.Errorf("skill increase is required on level %d", character.Level)
+		}
+		return nil, nil, nil
+	}
+	if !allowed {
+		return nil, nil, fmt.Errorf("no skill increase on level %d", character.Level)
+	}
+	skill := findCharacterSkill(character, *name)
+	if skill == nil {
+		return nil, nil, fmt.Errorf("character has no skill %s", *name)
+	}
+	if skill.Mastery == model.Legendary {
+		return nil, nil, fmt.Errorf("skill %s is already legendary", *name)
+	}
+	if err := rules.CheckSkillMasteryLevel(rules.NextMastery(skill.Mastery), character.Level); err != nil {
+		return nil, nil, fmt.Errorf("skill %s: %s", *name, err.Error())
+	}
+	old := skill.Mastery
+	skill.Mastery = rules.NextMastery(old)
+	change := model.CharacterLevelChange{
+		Kind:     model.LevelChangeSkill,
+		Target:   skill.Name,
+		OldValue: string(old),
+		NewValue: string(skill.Mastery),
+	}
+	return []*model.CharacterSkill{skill}, []model.CharacterLevelChange{change}, nil
+}
+
+func (a *CharacterApi) pickLevelFeats(
+	character *model.Character,
+	picks *model.LevelUpCreate,
+	choices *model.LevelUpChoices,
+) ([]*model.CharacterFeat, []model.CharacterLevelChange, error) {
+	slots := []struct {
+		name     string
+		category model.FeatCategory
+		featID   *uint
+		allowed  bool
+	}{
+		{"class", model.ClassFeat, picks.ClassFeatID, choices.ClassFeat},
+		{"skill", model.SkillFeat, picks.SkillFeatID, choices.SkillFeat},
+		{"general", model.GeneralFeat, picks.GeneralFeatID, choices.GeneralFeat},
+		{"ancestry", model.AncestryFeat, picks.AncestryFeatID, choices.AncestryFeat},
+	}
+
+	catalog, err := a.featCatalog()
+	if err != nil {
+		return nil, nil, err
+	}
+	taken, err := a.takenFeats(character)
+	if err != nil {
+		return nil, nil, err
+	}
+	// picks of this level count as taken for the prerequisites of the next pick
+	candidate := *character
+	candidate.CharacterFeat = append([]model.CharacterFeat{}, character.CharacterFeat...)
+
+	var feats []*model.CharacterFeat
+	var changes []model.CharacterLevelChange
+	for _, slot := range slots {
+		if slot.featID == nil {
+			if slot.allowed {
+				return nil, nil, fmt.Errorf("%s feat is required on level %d", slot.name, character.Level)
+			}
+			continue
+		}
+		if !slot.allowed {
+			return nil, nil, fmt.Errorf("no %s feat on level %d", slot.name, character.Level)
+		}
+		feat, err := a.DB.GetFeatByID(*slot.featID)
+		if err != nil || feat == nil {
+			return nil, nil, fmt.Errorf("%s feat %d not found", slot.name, *slot.featID)
+		}
+		if !rules.SlotAccepts(slot.category, feat.Category) {
+			return nil, nil, fmt.Errorf("%s feat %s is a %s feat", slot.name, feat.Name, feat.Category)
+		}
+		if unmet := rules.UnmetFeatRules(&candidate, feat, catalog, taken); len(unmet) > 0 {
+			return nil, nil, fmt.Errorf("%s feat %s: %s", slot.name, feat.Name, strings.Join(unmet, ", "))
+		}
+		candidate.CharacterFeat = append(candidate.CharacterFeat, model.CharacterFeat{FeatID: feat.ID})
+		taken = append(taken, *feat)
+		feats = append(feats, &model.CharacterFeat{CharacterID: character.ID, FeatID: feat.ID})
+		changes = append(changes, model.CharacterLevelChange{
+			Kind:     model.LevelChangeFeat,
+			Target:   strconv.Itoa(int(feat.ID)),
+			NewValue: feat.Name,
+		})
+	}
+	return feats, changes, nil
+}
+
+func findCharacterSkill(character *model.Character, name string) *model.CharacterSkill {
+	for i := range character.CharacterSkill {
+		if character.CharacterSkill[i].Name == name {
+			return &character.CharacterSkill[i]
+		}
+	}
+	return nil
+}
+
+func ToExternalCharacterLevel(level *model.CharacterLevel) *model.CharacterLevelExternal {
+	changes := []model.CharacterLevelChangeExternal{}
+	for _, change := range level.Changes {
+		changes = append(changes, model.CharacterLevelChangeExternal{
+			Kind:     change.Kind,
+			Target:   change.Target,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return &model.CharacterLevelExternal{
+		ID:          level.ID,
+		CharacterID: level.CharacterID,
+		Level:       level.Level,
+		HitPoint:    level.HitPoint,
+		Experience:  level.Experience,
+		Milestone:   level.Milestone,
+		Changes:     changes,
+	}
+}
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"kingdom/auth"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
+	"strings"
 )
 
 type CharacterSkillDatabase interface {
@@ -11,6 +15,12 @@ type CharacterSkillDatabase interface {
 	GetCharacterSkillByID(id uint) (*model.CharacterSkill, error)
 	GetCharacterSkills(id uint) ([]*model.CharacterSkill, error)
 	UpdateCharacterSkill(skill *model.CharacterSkill) error
+	GetCharacterByID(id uint) (*model.Character, error)
+	GetClassFeatureByClassID(classID uint) ([]model.ClassFeature, error)
+	GetSkillByName(name string) (*model.Skill, error)
+	GetUserByID(id uint) (*model.User, error)
+	GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error)
+	IsCharacterGameMaster(characterID uint, userID uint) (bool, error)
 }
 
 type CharacterSkillApi struct {
@@ -20,28 +30,71 @@ type CharacterSkillApi struct {
 // CharacterSkillCreate godoc
 //
 // @Summary Create and returns Character Skill or nil
-// @Description Permissions for Admin
+// @Description Adds a skill of the skill list or a Lore skill, a Lore name like "Sailing" or "Sailing Lore" is
+// @Description normalized to "Sailing Lore", a starting mastery follows the skill progression rules unless
+// @Description an admin overrides them
 // @Tags Character Skill
 // @Accept json
 // @Produce json
-// @Param characterSkill body model.CharacterSkillCreate true "Action data"
-// @Success 201 {object} model.CharacterSkillExternal "Action details"
+// @Param characterSkill body model.CharacterSkillCreate true "Character Skill data"
+// @Success 201 {object} model.CharacterSkillExternal "Character Skill details"
+// @Failure 400 {string} string "Wrong skill name or mastery"
 // @Failure 401 {string} string "Unauthorized"
 // @Failure 403 {string} string "You can't access for this API"
 // @Router /character-skill [post]
 func (a *CharacterSkillApi) CharacterSkillCreate(ctx *gin.Context) {
 	characterSkill := &model.CharacterSkillCreate{}
-	if err := ctx.ShouldBindJSON(characterSkill); err == nil {
-		internal := &model.CharacterSkill{
-			CharacterID: characterSkill.CharacterID,
-			Name:        characterSkill.Name,
-			Mastery:     characterSkill.Mastery,
+	if err := ctx.ShouldBindJSON(characterSkill); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !auth.CheckCharacterAccess(ctx, a.DB, model.CharacterResource, characterSkill.CharacterID) {
+		return
+	}
+	character, err := a.DB.GetCharacterByID(characterSkill.CharacterID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return
+	}
+	internal := &model.CharacterSkill{CharacterID: character.ID, Mastery: model.None}
+	if rules.IsLore(characterSkill.Name) {
+		name, err := rules.LoreName(characterSkill.Name)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		internal.Name, internal.Lore = name, true
+	} else {
+		skill, err := a.DB.GetSkillByName(characterSkill.Name)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if skill == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Unknown skill %s, custom skills must be Lore skills", characterSkill.Name)})
+			return
 		}
-		if success := SuccessOrAbort(ctx, 500, a.DB.CharacterSkillCreate(internal)); !success {
+		internal.Name = skill.Name
+	}
+	for _, skill := range character.CharacterSkill {
+		if strings.EqualFold(skill.Name, internal.Name) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Character already has skill %s", skill.Name)})
+			return
+		}
+	}
+	if characterSkill.Mastery != "" && characterSkill.Mastery != model.None {
+		if characterSkill.Override {
+			if !a.canOverride(ctx) {
+				return
+			}
+		} else if !a.validateSkillChange(ctx, character, internal, characterSkill.Mastery) {
 			return
 		}
-		ctx.JSON(http.StatusCreated, ToExternalCharacterSkill(internal))
+		internal.Mastery = characterSkill.Mastery
+	}
+	if success := SuccessOrAbort(ctx, 500, a.DB.CharacterSkillCreate(internal)); !success {
+		return
 	}
+	ctx.JSON(http.StatusCreated, ToExternalCharacterSkill(internal))
 }
 
 // GetCharacterSkills godoc
@@ -49,7 +102,7 @@ func (a *CharacterSkillApi) CharacterSkillCreate(ctx *gin.Context) {
 // @Summary Returns all Character Skills
 // @Description Return all Character Skills
 // @Tags Character Skill
-// @Param id path int true "Character Skill id"
+// @Param id path int true "Character id"
 // @Accept json
 // @Produce json
 // @Success 200 {object} model.CharacterSkill "Character Skill details"
@@ -73,45 +126,93 @@ func (a *CharacterSkillApi) GetCharacterSkills(ctx *gin.Context) {
 // UpdateCharacterSkill Updates Character Skill by ID
 //
 // @Summary Updates Character Skill by ID or nil
-// @Description Permissions for Admin
+// @Description A skill goes up one rank at a time, Expert from level 3, Master from 7 and Legendary from 15,
+// @Description every rank is paid by a background skill, a class or Intelligence training or a skill increase,
+// @Description admins can skip the rules with override
 // @Tags Character Skill
 // @Accept json
 // @Produce json
 // @Param id path int true "Character Skill id"
 // @Param characterSkill body model.CharacterSkillUpdate true "Character Skill data"
 // @Success 200 {object} model.CharacterSkillExternal "Action details"
+// @Failure 400 {string} string "Skill change breaks the progression rules"
 // @Failure 403 {string} string "You can't access for this API"
 // @Failure 404 {string} string "Character Skill doesn't exist"
 // @Router /character-skill/{id} [patch]
 func (a *CharacterSkillApi) UpdateCharacterSkill(ctx *gin.Context) {
 	withID(ctx, "id", func(id uint) {
-		var characterSkill *model.CharacterSkillUpdate
-		if err := ctx.ShouldBindJSON(&characterSkill); err == nil {
-			oldCharacterSkill, err := a.DB.GetCharacterSkillByID(id)
-			if success := SuccessOrAbort(ctx, 500, err); success {
+		characterSkill := &model.CharacterSkillUpdate{}
+		if err := ctx.ShouldBindJSON(characterSkill); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldCharacterSkill, err := a.DB.GetCharacterSkillByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldCharacterSkill == nil || oldCharacterSkill.ID == 0 {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character Skill doesn't exist"})
+			return
+		}
+		if characterSkill.Override {
+			if !a.canOverride(ctx) {
 				return
 			}
-			if oldCharacterSkill != nil {
-				internal := &model.CharacterSkill{
-					ID:          oldCharacterSkill.ID,
-					CharacterID: oldCharacterSkill.CharacterID,
-					Name:        oldCharacterSkill.Name,
-					Mastery:     characterSkill.Mastery,
-				}
-				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterSkill(internal)); !success {
-					return
-				}
-				ctx.JSON(http.StatusOK, ToExternalCharacterSkill(internal))
+		} else {
+			character, err := a.DB.GetCharacterByID(oldCharacterSkill.CharacterID)
+			if err != nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+				return
+			}
+			if !a.validateSkillChange(ctx, character, oldCharacterSkill, characterSkill.Mastery) {
+				return
 			}
 		}
+		internal := &model.CharacterSkill{
+			ID:          oldCharacterSkill.ID,
+			CharacterID: oldCharacterSkill.CharacterID,
+			Name:        oldCharacterSkill.Name,
+			Mastery:     characterSkill.Mastery,
+			Lore:        oldCharacterSkill.Lore,
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCharacterSkill(internal)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCharacterSkill(internal))
 	})
 }
 
+func (a *CharacterSkillApi) canOverride(ctx *gin.Context) bool {
+	if user, err := a.DB.GetUserByID(auth.GetUserID(ctx)); err != nil || user == nil || !user.Admin {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Only admins can override skill rules"})
+		return false
+	}
+	return true
+}
+
+func (a *CharacterSkillApi) validateSkillChange(
+	ctx *gin.Context,
+	character *model.Character,
+	skill *model.CharacterSkill,
+	mastery model.MasteryLevel,
+) bool {
+	features, err := a.DB.GetClassFeatureByClassID(character.CharacterClassID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return false
+	}
+	if err := rules.ValidateSkillChange(character, skill, mastery, features); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
 func ToExternalCharacterSkill(characterSKill *model.CharacterSkill) *model.CharacterSkillExternal {
 	return &model.CharacterSkillExternal{
 		ID:          characterSKill.ID,
 		CharacterID: characterSKill.CharacterID,
 		Name:        characterSKill.Name,
 		Mastery:     characterSKill.Mastery,
+		Lore:        characterSKill.Lore,
 	}
 }
@@ -3,13 +3,16 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
 type SlotDatabase interface {
 	GetSlotByID(id uint) (*model.Slot, error)
 	UpdateSlot(slot *model.Slot) error
-	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	UpdateShieldRaised(characterID uint, raised bool) error
+	GetShieldByID(id uint) (*model.Shield, error)
+	EncumbranceDatabase
 }
 
 type SlotApi struct {
@@ -68,53 +71,124 @@ func (a *SlotApi) UpdateSlot(ctx *gin.Context) {
 	withID(ctx, "id", func(id uint) {
 		var slot *model.SlotUpdate
 		if err := ctx.ShouldBindJSON(&slot); err == nil {
+			oldSlot, err := a.DB.GetSlotByID(id)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			if oldSlot == nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Slot doesn't exist"})
+				return
+			}
 
-			if slot.ArmorID != nil {
-				if armor, err := a.DB.GetCharacterItemByID(*slot.ArmorID); err == nil && armor != nil &&
-					armor.Item.OwnerType != "armors" || armor.CharacterID != slot.CharacterID {
-					ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong Armor slot"})
-					return
-				}
+			if !a.isSlotItem(slot.ArmorID, "armors", oldSlot.CharacterID) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong Armor slot"})
+				return
+			}
+			if !a.isSlotItem(slot.FirstWeaponID, "weapons", oldSlot.CharacterID) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong First Weapon slot"})
+				return
+			}
+			if !a.isSlotItem(slot.SecondWeaponID, "weapons", oldSlot.CharacterID) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong Second Weapon slot"})
+				return
+			}
+			if !a.isSlotItem(slot.ShieldID, "shields", oldSlot.CharacterID) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong Shield slot"})
+				return
 			}
 
-			if slot.FirstWeaponID != nil {
-				if fWeapon, err := a.DB.GetCharacterItemByID(*slot.FirstWeaponID); err == nil && fWeapon != nil &&
-					fWeapon.Item.OwnerType != "weapons" || fWeapon.CharacterID != slot.CharacterID {
-					ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong First Weapon slot"})
-					return
-				}
+			internal := &model.Slot{
+				ID:             oldSlot.ID,
+				CharacterID:    oldSlot.CharacterID,
+				ArmorID:        slot.ArmorID,
+				FirstWeaponID:  slot.FirstWeaponID,
+				SecondWeaponID: slot.SecondWeaponID,
+				ShieldID:       slot.ShieldID,
+			}
+			// a shield stays raised only while it stays in the slot
+			internal.ShieldRaised = oldSlot.ShieldRaised && sameID(oldSlot.ShieldID, slot.ShieldID)
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateSlot(internal)); !success {
+				return
 			}
-			if slot.SecondWeaponID != nil {
-				if sWeapon, err := a.DB.GetCharacterItemByID(*slot.SecondWeaponID); err == nil && sWeapon != nil &&
-					sWeapon.Item.OwnerType != "weapons" || sWeapon.CharacterID != slot.CharacterID {
-					ctx.JSON(http.StatusNotFound, gin.H{"error": "Wrong Second Weapon slot"})
-					return
-				}
+			// worn armor changes the Bulk, the Speed and the armor feeding the stats
+			if _, err := syncEncumbrance(a.DB, internal.CharacterID); err != nil {
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
 			}
+			ctx.JSON(http.StatusOK, ToExternalSlot(internal))
+		}
+	})
+}
 
-			oldSlot, err := a.DB.GetSlotByID(id)
+// RaiseShield godoc
+//
+// @Summary Raises or lowers the shield in the slot
+// @Description Permissions for Character's User or Admin, a raised shield adds its circumstance bonus to AC
+// @Description and can Shield Block, a broken or destroyed shield can't be raised
+// @Tags Slot
+// @Accept json
+// @Produce json
+// @Param id path int true "Slot id"
+// @Param shield body model.RaiseShield true "Raise or lower"
+// @Success 200 {object} model.SlotExternal "Slot details"
+// @Failure 400 {string} string "Shield is broken"
+// @Failure 404 {string} string "Slot doesn't exist"
+// @Router /slot/{id}/raise-shield [post]
+func (a *SlotApi) RaiseShield(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		var raise model.RaiseShield
+		if err := ctx.ShouldBindJSON(&raise); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		slot, err := a.DB.GetSlotByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if slot == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Slot doesn't exist"})
+			return
+		}
+		if *raise.Raised {
+			if slot.ShieldID == nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "No shield in the slot"})
+				return
+			}
+			shield, characterItem, err := equippedShield(a.DB, *slot.ShieldID)
 			if success := SuccessOrAbort(ctx, 500, err); !success {
 				return
 			}
-			if oldSlot != nil {
-				internal := &model.Slot{
-					ID:             oldSlot.ID,
-					CharacterID:    oldSlot.CharacterID,
-					ArmorID:        slot.ArmorID,
-					FirstWeaponID:  slot.FirstWeaponID,
-					SecondWeaponID: slot.SecondWeaponID,
-				}
-				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateSlot(internal)); !success {
-					return
-				}
-				ctx.JSON(http.StatusOK, ToExternalSlot(internal))
-			} else {
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "Slot doesn't exist"})
+			if shield == nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "No shield in the slot"})
+				return
 			}
+			if err := rules.CanRaiseShield(shield, rules.ShieldHitPoint(shield, characterItem)); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		slot.ShieldRaised = *raise.Raised
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateShieldRaised(slot.CharacterID, slot.ShieldRaised)); !success {
+			return
 		}
+		ctx.JSON(http.StatusOK, ToExternalSlot(slot))
 	})
 }
 
+func sameID(first *uint, second *uint) bool {
+	return first != nil && second != nil && *first == *second
+}
+
+// isSlotItem checks that the character item has the type and belongs to the slot's character
+func (a *SlotApi) isSlotItem(characterItemID *uint, ownerType string, characterID uint) bool {
+	if characterItemID == nil {
+		return true
+	}
+	characterItem, err := a.DB.GetCharacterItemByID(*characterItemID)
+	return err == nil && characterItem != nil &&
+		characterItem.Item.OwnerType == ownerType && characterItem.CharacterID == characterID
+}
+
 func ToExternalSlot(slot *model.Slot) *model.SlotExternal {
 	return &model.SlotExternal{
 		ID:             slot.ID,
@@ -122,5 +196,7 @@ func ToExternalSlot(slot *model.Slot) *model.SlotExternal {
 		ArmorID:        slot.ArmorID,
 		FirstWeaponID:  slot.FirstWeaponID,
 		SecondWeaponID: slot.SecondWeaponID,
+		ShieldID:       slot.ShieldID,
+		ShieldRaised:   slot.ShieldRaised,
 	}
 }
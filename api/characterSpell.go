@@ -1 +1,419 @@
 package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// spellcasting is everything known about the spells of a character
+type spellcasting struct {
+	character *model.Character
+	class     *model.CharacterClass
+	slots     map[uint8]uint8
+	spells    []model.CharacterSpell
+	prepared  []model.CharacterPreparedSpell
+	used      []model.CharacterSpellSlot
+}
+
+// GetSpellcasting godoc
+//
+// @Summary Returns spellcasting of Character
+// @Description Spell slots per rank with their usage, known and prepared spells and focus points
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/spells [get]
+func (a *CharacterApi) GetSpellcasting(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		casting := a.loadSpellcasting(ctx, id)
+		if casting == nil {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalSpellcasting(casting))
+	})
+}
+
+// LearnSpell godoc
+//
+// @Summary Adds spell to repertoire or spellbook of Character
+// @Description Spell must be of the class tradition, repertoire of a spontaneous caster is limited by its slots,
+// @Description focus spells are learned at rank 0 and raise the focus pool
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param spell body model.CharacterSpellCreate true "Spell data"
+// @Success 201 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 400 {string} string "Wrong spell"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Spell not found"
+// @Router /character/{id}/spells [post]
+func (a *CharacterApi) LearnSpell(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		characterSpell := &model.CharacterSpellCreate{}
+		if err := ctx.ShouldBindJSON(characterSpell); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		casting := a.loadSpellcasting(ctx, id)
+		if casting == nil {
+			return
+		}
+		spell, err := a.DB.GetSpellByID(characterSpell.SpellID)
+		if err != nil || spell == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Spell not found"})
+			return
+		}
+
+		internal := &model.CharacterSpell{
+			CharacterID: id,
+			SpellID:     spell.ID,
+			Rank:        characterSpell.Rank,
+			Focus:       characterSpell.Focus,
+		}
+		if internal.Focus {
+			internal.Rank = 0
+		} else {
+			err = rules.ValidateLearnSpell(casting.class, casting.slots, casting.spells, spell,
+				internal.Rank)
+		}
+		for _, known := range casting.spells {
+			if known.SpellID == internal.SpellID && known.Rank == internal.Rank {
+				err = fmt.Errorf("%s is already known", spell.Name)
+			}
+		}
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.CreateCharacterSpell(internal)); !success {
+			return
+		}
+		if internal.Focus {
+			casting.spells = append(casting.spells, *internal)
+			casting.character.FocusPoint = min(casting.character.FocusPoint+1, rules.FocusPool(casting.spells))
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateFocusPoint(casting.character)); !success {
+				return
+			}
+		}
+		a.respondSpellcasting(ctx, id, http.StatusCreated)
+	})
+}
+
+// ForgetSpell godoc
+//
+// @Summary Removes spell from repertoire or spellbook of Character
+// @Description Preparations of the spell are removed too
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param spell_id path int true "character spell id"
+// @Success 200 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Spell not found"
+// @Router /character/{id}/spells/{spell_id} [delete]
+func (a *CharacterApi) ForgetSpell(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "spell_id", func(spellID uint) {
+			casting := a.loadSpellcasting(ctx, id)
+			if casting == nil {
+				return
+			}
+			var characterSpell *model.CharacterSpell
+			for i := range casting.spells {
+				if casting.spells[i].ID == spellID {
+					characterSpell = &casting.spells[i]
+				}
+			}
+			if characterSpell == nil {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "Spell not found"})
+				return
+			}
+			if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCharacterSpell(characterSpell)); !success {
+				return
+			}
+			if characterSpell.Focus {
+				var spells []model.CharacterSpell
+				for _, known := range casting.spells {
+					if known.ID != characterSpell.ID {
+						spells = append(spells, known)
+					}
+				}
+				casting.character.FocusPoint = min(casting.character.FocusPoint, rules.FocusPool(spells))
+				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateFocusPoint(casting.character)); !success {
+					return
+				}
+			}
+			a.respondSpellcasting(ctx, id, http.StatusOK)
+		})
+	})
+}
+
+// PrepareSpells godoc
+//
+// @Summary Prepares daily spells of Character
+// @Description Replaces the preparation of a prepared caster, spells come from the spellbook and fill slots per rank
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param spells body model.PrepareSpells true "Prepared spells"
+// @Success 200 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 400 {string} string "Wrong preparation"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/spells/prepare [post]
+func (a *CharacterApi) PrepareSpells(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		preparation := &model.PrepareSpells{}
+		if err := ctx.ShouldBindJSON(preparation); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		casting := a.loadSpellcasting(ctx, id)
+		if casting == nil {
+			return
+		}
+		spellcasting := casting.class.Spellcasting
+		if spellcasting == nil || *spellcasting != model.Prepared {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character isn't a prepared caster"})
+			return
+		}
+		if err := rules.ValidatePreparation(casting.slots, casting.spells, preparation.Spells); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var prepared []*model.CharacterPreparedSpell
+		for _, spell := range preparation.Spells {
+			prepared = append(prepared, &model.CharacterPreparedSpell{
+				CharacterID: id,
+				SpellID:     spell.SpellID,
+				Rank:        spell.Rank,
+			})
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.ReplacePreparedSpells(id, prepared)); !success {
+			return
+		}
+		a.respondSpellcasting(ctx, id, http.StatusOK)
+	})
+}
+
+// CastSpell godoc
+//
+// @Summary Casts spell of Character
+// @Description Expends a prepared spell or a slot of a spontaneous caster, cantrips are free,
+// @Description focus spells spend a focus point
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param spell body model.CastSpell true "Spell to cast"
+// @Success 200 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 400 {string} string "Spell can't be cast"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/spells/cast [post]
+func (a *CharacterApi) CastSpell(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		cast := &model.CastSpell{}
+		if err := ctx.ShouldBindJSON(cast); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		casting := a.loadSpellcasting(ctx, id)
+		if casting == nil {
+			return
+		}
+		if err := a.castSpell(casting, cast); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		a.respondSpellcasting(ctx, id, http.StatusOK)
+	})
+}
+
+// Rest godoc
+//
+// @Summary Refreshes spellcasting of Character after a rest
+// @Description Restores spell slots, prepared spells, the focus pool and the charges of wands and staves
+// @Tags Character Spell
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.SpellcastingExternal "spellcasting"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/rest [post]
+func (a *CharacterApi) Rest(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		casting := a.loadSpellcasting(ctx, id)
+		if casting == nil {
+			return
+		}
+		casting.character.FocusPoint = rules.FocusPool(casting.spells)
+		if success := SuccessOrAbort(ctx, 500, a.DB.RestCharacter(casting.character)); !success {
+			return
+		}
+		a.respondSpellcasting(ctx, id, http.StatusOK)
+	})
+}
+
+// castSpell spends what casting the spell costs
+func (a *CharacterApi) castSpell(casting *spellcasting, cast *model.CastSpell) error {
+	for _, known := range casting.spells {
+		if known.SpellID != cast.SpellID || !known.Focus {
+			continue
+		}
+		if casting.character.FocusPoint == 0 {
+			return fmt.Errorf("no focus points left")
+		}
+		casting.character.FocusPoint--
+		return a.DB.UpdateFocusPoint(casting.character)
+	}
+
+	spellcasting := casting.class.Spellcasting
+	if spellcasting == nil {
+		return fmt.Errorf("%s doesn't cast spells", casting.class.Name)
+	}
+	if *spellcasting == model.Prepared {
+		for i := range casting.prepared {
+			prepared := &casting.prepared[i]
+			if prepared.SpellID != cast.SpellID || prepared.Rank != cast.Rank || prepared.Expended {
+				continue
+			}
+			if cast.Rank == 0 {
+				return nil
+			}
+			return a.DB.ExpendPreparedSpell(prepared)
+		}
+		return fmt.Errorf("spell isn't prepared at rank %d", cast.Rank)
+	}
+
+	var known *model.CharacterSpell
+	for i := range casting.spells {
+		if casting.spells[i].SpellID == cast.SpellID && casting.spells[i].Rank == cast.Rank {
+			known = &casting.spells[i]
+		}
+	}
+	if known == nil {
+		return fmt.Errorf("spell isn't in the repertoire at rank %d", cast.Rank)
+	}
+	if cast.Rank == 0 {
+		return nil
+	}
+	for _, used := range casting.used {
+		if used.Rank == cast.Rank && used.Used >= casting.slots[cast.Rank] {
+			return fmt.Errorf("no spell slots of rank %d left", cast.Rank)
+		}
+	}
+	return a.DB.UseSpellSlot(casting.character.ID, cast.Rank)
+}
+
+// loadSpellcasting collects spells and slots of the character or responds with an error
+func (a *CharacterApi) loadSpellcasting(ctx *gin.Context, id uint) *spellcasting {
+	character, err := a.DB.GetCharacterByID(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return nil
+	}
+	casting := &spellcasting{character: character, class: &character.CharacterClass}
+	archetypes, err := a.DB.GetCharacterArchetypes(id)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	// a multiclass dedication grants the cantrips of the archetype class
+	archetypeClass := rules.ArchetypeSpellcasting(casting.class, archetypes)
+	if archetypeClass != nil {
+		casting.class = archetypeClass
+	}
+	table, err := a.DB.GetSpellSlotTable(casting.class.ID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	casting.slots = rules.SpellSlots(table, character.Level)
+	if cantrips, ok := casting.slots[0]; archetypeClass != nil {
+		casting.slots = map[uint8]uint8{}
+		if ok {
+			casting.slots[0] = cantrips
+		}
+	}
+	casting.spells, err = a.DB.GetCharacterSpells(id)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	casting.prepared, err = a.DB.GetPreparedSpells(id)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	casting.used, err = a.DB.GetSpellSlotUsage(id)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	return casting
+}
+
+func (a *CharacterApi) respondSpellcasting(ctx *gin.Context, id uint, status int) {
+	casting := a.loadSpellcasting(ctx, id)
+	if casting == nil {
+		return
+	}
+	ctx.JSON(status, ToExternalSpellcasting(casting))
+}
+
+func ToExternalSpellcasting(casting *spellcasting) *model.SpellcastingExternal {
+	resp := &model.SpellcastingExternal{
+		CharacterID:   casting.character.ID,
+		Spellcasting:  casting.class.Spellcasting,
+		TraditionID:   casting.class.TraditionID,
+		FocusPoint:    casting.character.FocusPoint,
+		MaxFocusPoint: rules.FocusPool(casting.spells),
+		Slots:         []model.SpellSlotExternal{},
+		Spells:        []model.CharacterSpellExternal{},
+		Prepared:      []model.PreparedSpellExternal{},
+	}
+	for rank := uint8(0); rank <= 10; rank++ {
+		total, ok := casting.slots[rank]
+		if !ok {
+			continue
+		}
+		slot := model.SpellSlotExternal{Rank: rank, Total: total}
+		for _, used := range casting.used {
+			if used.Rank == rank {
+				slot.Used = used.Used
+			}
+		}
+		for _, prepared := range casting.prepared {
+			if prepared.Rank == rank && prepared.Expended {
+				slot.Used++
+			}
+		}
+		resp.Slots = append(resp.Slots, slot)
+	}
+	for _, spell := range casting.spells {
+		resp.Spells = append(resp.Spells, model.CharacterSpellExternal{
+			ID:      spell.ID,
+			SpellID: spell.SpellID,
+			Name:    spell.Spell.Name,
+			Rank:    spell.Rank,
+			Focus:   spell.Focus,
+		})
+	}
+	for _, prepared := range casting.prepared {
+		resp.Prepared = append(resp.Prepared, model.PreparedSpellExternal{
+			ID:       prepared.ID,
+			SpellID:  prepared.SpellID,
+			Name:     prepared.Spell.Name,
+			Rank:     prepared.Rank,
+			Expended: prepared.Expended,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// GetCharacterStats godoc
+//
+// @Summary Returns derived statistics of Character
+// @Description Armor class, saves, perception, class DC and skill modifiers with their breakdown
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.CharacterStatsExternal "character statistics"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/stats [get]
+func (a *CharacterApi) GetCharacterStats(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		sheet, err := loadSheet(a.DB, character)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, rules.Compute(sheet))
+	})
+}
+
+// GetCharacterStrikes godoc
+//
+// @Summary Returns strikes of Character
+// @Description Attack bonuses for the first, second and third attack and damage of the fist and equipped weapons
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.CharacterStrikesExternal "character strikes"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/strikes [get]
+func (a *CharacterApi) GetCharacterStrikes(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		sheet, err := loadSheet(a.DB, character)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		weapons, err := equippedWeapons(a.DB, character.ID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+
+		resp := &model.CharacterStrikesExternal{CharacterID: character.ID, Strikes: []model.StrikeExternal{}}
+		for _, weapon := range weapons {
+			resp.Strikes = append(resp.Strikes, rules.Strikes(sheet, weapon)...)
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// SheetDatabase loads the skills and equipment the rules engine needs beside the character
+type SheetDatabase interface {
+	GetSkills() ([]*model.Skill, error)
+	GetSlotByCharacterID(characterID uint) (*model.Slot, error)
+	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	GetArmorByID(id uint) (*model.Armor, error)
+	GetShieldByID(id uint) (*model.Shield, error)
+	GetWeaponByID(id uint) (*model.Weapon, error)
+	GetCharacterArchetypes(characterID uint) ([]*model.Archetype, error)
+}
+
+// ArmorDatabase loads the armor behind a character item
+type ArmorDatabase interface {
+	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	GetArmorByID(id uint) (*model.Armor, error)
+}
+
+// ShieldDatabase loads the shield behind a character item
+type ShieldDatabase interface {
+	GetCharacterItemByID(id uint) (*model.CharacterItem, error)
+	GetShieldByID(id uint) (*model.Shield, error)
+}
+
+// loadSheet collects everything the rules engine needs for the character
+func loadSheet(db SheetDatabase, character *model.Character) (*rules.Sheet, error) {
+	sheet := &rules.Sheet{
+		CharacterID:  character.ID,
+		Level:        character.Level,
+		Attribute:    character.Attribute,
+		Defence:      character.CharacterDefence,
+		KeyAbility:   character.CharacterClass.KeyAbility,
+		Skills:       character.CharacterSkill,
+		SkillAbility: map[string]model.Ability{},
+		Conditions:   character.CharacterCondition,
+	}
+
+	skills, err := db.GetSkills()
+	if err != nil {
+		return nil, err
+	}
+	for _, skill := range skills {
+		sheet.SkillAbility[skill.Name] = skill.Ability
+	}
+
+	slot, err := db.GetSlotByCharacterID(character.ID)
+	if err != nil {
+		return nil, err
+	}
+	if slot != nil && slot.ArmorID != nil {
+		armor, characterItem, err := equippedArmor(db, *slot.ArmorID)
+		if err != nil {
+			return nil, err
+		}
+		if armor != nil {
+			sheet.Armor = armor
+			sheet.ArmorPotency = int(characterItem.Potency)
+			sheet.Resilient = int(characterItem.Resilient)
+		}
+	}
+	if slot != nil && slot.ShieldID != nil {
+		shield, characterItem, err := equippedShield(db, *slot.ShieldID)
+		if err != nil {
+			return nil, err
+		}
+		if shield != nil {
+			sheet.Shield = shield
+			sheet.ShieldRaised = slot.ShieldRaised &&
+				rules.CanRaiseShield(shield, rules.ShieldHitPoint(shield, characterItem)) == nil
+		}
+	}
+
+	archetypes, err := db.GetCharacterArchetypes(character.ID)
+	if err != nil {
+		return nil, err
+	}
+	rules.ApplyArchetypeProficiencies(sheet, archetypes)
+	return sheet, nil
+}
+
+// equippedArmor returns the armor and the character item holding its runes
+func equippedArmor(db ArmorDatabase, characterItemID uint) (*model.Armor, *model.CharacterItem, error) {
+	characterItem, err := db.GetCharacterItemByID(characterItemID)
+	if err != nil || characterItem == nil || characterItem.Item.OwnerType != "armors" {
+		return nil, nil, err
+	}
+	armor, err := db.GetArmorByID(characterItem.Item.OwnerID)
+	if err != nil || armor == nil {
+		return nil, nil, err
+	}
+	return armor, characterItem, nil
+}
+
+// equippedShield returns the shield and the character item holding its hit points
+func equippedShield(db ShieldDatabase, characterItemID uint) (*model.Shield, *model.CharacterItem, error) {
+	characterItem, err := db.GetCharacterItemByID(characterItemID)
+	if err != nil || characterItem == nil || characterItem.Item.OwnerType != "shields" {
+		return nil, nil, err
+	}
+	shield, err := db.GetShieldByID(characterItem.Item.OwnerID)
+	if err != nil || shield == nil {
+		return nil, nil, err
+	}
+	return shield, characterItem, nil
+}
+
+// equippedWeapons returns the fist followed by the weapons in the slots of the character
+func equippedWeapons(db SheetDatabase, characterID uint) ([]*rules.Weapon, error) {
+	weapons := []*rules.Weapon{{Name: "Fist", Weapon: rules.Fist}}
+	slot, err := db.GetSlotByCharacterID(characterID)
+	if err != nil || slot == nil {
+		return weapons, err
+	}
+	for _, characterItemID := range []*uint{slot.FirstWeaponID, slot.SecondWeaponID} {
+		if characterItemID == nil {
+			continue
+		}
+		characterItem, err := db.GetCharacterItemByID(*characterItemID)
+		if err != nil {
+			return nil, err
+		}
+		if characterItem == nil || characterItem.Item.OwnerType != "weapons" {
+			continue
+		}
+		weapon, err := db.GetWeaponByID(characterItem.Item.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if weapon == nil {
+			continue
+		}
+		weapons = append(weapons, &rules.Weapon{
+			CharacterItemID: characterItemID,
+			Name:            characterItem.Item.Name,
+			Weapon:          *weapon,
+			ItemBonus:       int(characterItem.Potency),
+			Striking:        int(characterItem.Striking),
+			Runes:           characterItem.Runes,
+		})
+	}
+	return weapons, nil
+}
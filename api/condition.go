@@ -0,0 +1,196 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+type ConditionDatabase interface {
+	CreateCondition(condition *model.Condition) error
+	GetConditionByID(id uint) (*model.Condition, error)
+	GetConditions() ([]*model.Condition, error)
+	UpdateCondition(condition *model.Condition) error
+	DeleteCondition(id uint) error
+}
+
+type ConditionApi struct {
+	DB ConditionDatabase
+}
+
+// CreateCondition godoc
+//
+// @Summary Create and returns Condition or nil
+// @Description Permissions for Admin
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param condition body model.CreateCondition true "Condition data"
+// @Success 201 {object} model.ConditionExternal "Condition details"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /condition [post]
+func (a *ConditionApi) CreateCondition(ctx *gin.Context) {
+	condition := &model.CreateCondition{}
+	if err := ctx.ShouldBindJSON(condition); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal := toInternalCondition(condition)
+	if success := SuccessOrAbort(ctx, 500, a.DB.CreateCondition(internal)); !success {
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToExternalCondition(internal))
+}
+
+// GetConditionByID godoc
+//
+// @Summary Returns Condition by id
+// @Description Retrieve Condition details using its ID
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Condition id"
+// @Success 200 {object} model.ConditionExternal "Condition details"
+// @Failure 404 {string} string "Condition not found"
+// @Router /condition/{id} [get]
+func (a *ConditionApi) GetConditionByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		condition, err := a.DB.GetConditionByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if condition == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition not found"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCondition(condition))
+	})
+}
+
+// GetConditions godoc
+//
+// @Summary Returns all Conditions
+// @Description Return all Conditions
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.ConditionExternal "Condition details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /condition [get]
+func (a *ConditionApi) GetConditions(ctx *gin.Context) {
+	conditions, err := a.DB.GetConditions()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.ConditionExternal{}
+	for _, condition := range conditions {
+		resp = append(resp, ToExternalCondition(condition))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateCondition Updates Condition by ID
+//
+// @Summary Updates Condition by ID or nil
+// @Description Permissions for Admin
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Condition id"
+// @Param condition body model.CreateCondition true "Condition data"
+// @Success 200 {object} model.ConditionExternal "Condition details"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Condition doesn't exist"
+// @Router /condition/{id} [patch]
+func (a *ConditionApi) UpdateCondition(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		condition := &model.CreateCondition{}
+		if err := ctx.ShouldBindJSON(condition); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldCondition, err := a.DB.GetConditionByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldCondition == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition doesn't exist"})
+			return
+		}
+		internal := toInternalCondition(condition)
+		internal.ID = oldCondition.ID
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCondition(internal)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCondition(internal))
+	})
+}
+
+// DeleteCondition Deletes Condition by ID
+//
+// @Summary Deletes Condition by ID or returns nil
+// @Description Permissions for Admin
+// @Tags Condition
+// @Accept json
+// @Produce json
+// @Param id path int true "Condition id"
+// @Success 204
+// @Failure 404 {string} string "Condition doesn't exist"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /condition/{id} [delete]
+func (a *ConditionApi) DeleteCondition(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		condition, err := a.DB.GetConditionByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if condition == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition doesn't exist"})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCondition(id)); !success {
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+}
+
+func toInternalCondition(condition *model.CreateCondition) *model.Condition {
+	return &model.Condition{
+		Name:        condition.Name,
+		Description: condition.Description,
+		Valued:      condition.Valued,
+		Decrement:   condition.Decrement,
+		PenaltyType: condition.PenaltyType,
+		Abilities:   toInternalConditionAbilities(condition.Abilities),
+		AllChecks:   condition.AllChecks,
+		ArmorClass:  condition.ArmorClass,
+	}
+}
+
+func toInternalConditionAbilities(abilities []model.Ability) []model.ConditionAbility {
+	var internal []model.ConditionAbility
+	for _, ability := range abilities {
+		internal = append(internal, model.ConditionAbility{Ability: ability})
+	}
+	return internal
+}
+
+func ToExternalCondition(condition *model.Condition) *model.ConditionExternal {
+	external := &model.ConditionExternal{
+		ID:          condition.ID,
+		Name:        condition.Name,
+		Description: condition.Description,
+		Valued:      condition.Valued,
+		Decrement:   condition.Decrement,
+		PenaltyType: condition.PenaltyType,
+		Abilities:   []model.Ability{},
+		AllChecks:   condition.AllChecks,
+		ArmorClass:  condition.ArmorClass,
+	}
+	for _, ability := range condition.Abilities {
+		external.Abilities = append(external.Abilities, ability.Ability)
+	}
+	return external
+}
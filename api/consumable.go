@@ -0,0 +1,231 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+// CreateConsumable godoc
+//
+// @Summary Create and returns Consumable or nil
+// @Description Permissions for Admin, a consumable is used up after its uses, a wand or staff has charges per day,
+// @Description scrolls, wands and staves cast the linked spell at the spell rank
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param consumable body model.CreateConsumable true "Consumable data"
+// @Success 201 {object} model.ConsumableExternal "Consumable details"
+// @Failure 400 {string} string "Wrong consumable data"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /item/consumable [post]
+func (a *ItemApi) CreateConsumable(ctx *gin.Context) {
+	consumable := &model.CreateConsumable{}
+	if err := ctx.ShouldBindJSON(consumable); err == nil {
+		internalConsumable := &model.Consumable{
+			Kind:      consumable.Kind,
+			Uses:      1,
+			Charges:   consumable.Charges,
+			SpellID:   consumable.SpellID,
+			SpellRank: consumable.SpellRank,
+		}
+		if internalConsumable.Kind == "" {
+			internalConsumable.Kind = model.ConsumableItem
+		}
+		if consumable.Uses != nil {
+			internalConsumable.Uses = *consumable.Uses
+		}
+		if !a.validateConsumable(ctx, internalConsumable) {
+			return
+		}
+		internalItem := &model.Item{
+			Name:        consumable.Name,
+			Description: consumable.Description,
+			Bulk:        consumable.Bulk,
+			Price:       consumable.Price,
+			OwnerType:   "consumables",
+		}
+		if consumable.Level != nil {
+			internalItem.Level = *consumable.Level
+		}
+		if !priceItem(ctx, internalItem) {
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.CreateConsumable(internalConsumable, internalItem)); !success {
+			return
+		}
+		newConsumable, err := a.DB.GetConsumableByID(internalConsumable.ID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusCreated, ToExternalConsumable(newConsumable, &newConsumable.Item))
+	} else {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
+
+// GetConsumables godoc
+//
+// @Summary Returns all consumables
+// @Description Return all consumables, wands and staves
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.ConsumableExternal "Consumable details"
+// @Failure 401 {string} string ""Unauthorized"
+// @Router /item/consumable [get]
+func (a *ItemApi) GetConsumables(ctx *gin.Context) {
+	consumables, err := a.DB.GetConsumables()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.ConsumableExternal{}
+	for _, consumable := range consumables {
+		resp = append(resp, ToExternalConsumable(consumable, &consumable.Item))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetConsumableByID godoc
+//
+// @Summary Returns Consumable by ID
+// @Description Permissions for auth users
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param id path int true "Consumable id"
+// @Success 200 {object} model.ConsumableExternal "Consumable details"
+// @Failure 401 {string} string ""Unauthorized"
+// @Failure 404 {string} string "Consumable doesn't exist"
+// @Router /item/consumable/{id} [get]
+func (a *ItemApi) GetConsumableByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		consumable, err := a.DB.GetConsumableByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if consumable == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Consumable doesn't exist"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalConsumable(consumable, &consumable.Item))
+	})
+}
+
+// UpdateConsumable Updates Consumable by ID
+//
+// @Summary Updates Consumable by ID or nil
+// @Description Permissions for Admin, omitted values are kept
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param id path int true "Consumable id"
+// @Param consumable body model.UpdateConsumable true "Consumable data"
+// @Success 200 {object} model.ConsumableExternal "Consumable details"
+// @Failure 400 {string} string "Wrong consumable data"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Consumable doesn't exist"
+// @Router /item/consumable/{id} [patch]
+func (a *ItemApi) UpdateConsumable(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		var consumable model.UpdateConsumable
+		if err := ctx.ShouldBindJSON(&consumable); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldConsumable, err := a.DB.GetConsumableByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldConsumable == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Consumable doesn't exist"})
+			return
+		}
+		internalConsumable := &model.Consumable{
+			ID:        oldConsumable.ID,
+			Kind:      oldConsumable.Kind,
+			Uses:      oldConsumable.Uses,
+			Charges:   oldConsumable.Charges,
+			SpellID:   oldConsumable.SpellID,
+			SpellRank: oldConsumable.SpellRank,
+		}
+		if consumable.Kind != "" {
+			internalConsumable.Kind = consumable.Kind
+		}
+		if consumable.Uses != nil {
+			internalConsumable.Uses = *consumable.Uses
+		}
+		if consumable.Charges != nil {
+			internalConsumable.Charges = *consumable.Charges
+		}
+		if consumable.SpellID != nil {
+			internalConsumable.SpellID = consumable.SpellID
+		}
+		if consumable.SpellRank != nil {
+			internalConsumable.SpellRank = *consumable.SpellRank
+		}
+		if !a.validateConsumable(ctx, internalConsumable) {
+			return
+		}
+		internalItem := &model.Item{
+			ID:    oldConsumable.Item.ID,
+			Level: oldConsumable.Item.Level,
+		}
+		if consumable.Level != nil {
+			internalItem.Level = *consumable.Level
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateConsumable(internalConsumable, internalItem)); !success {
+			return
+		}
+		newConsumable, _ := a.DB.GetConsumableByID(id)
+		ctx.JSON(http.StatusOK, ToExternalConsumable(newConsumable, &newConsumable.Item))
+	})
+}
+
+// validateConsumable checks the charges of wands and staves and the linked spell, the spell rank defaults to
+// the rank of the spell
+func (a *ItemApi) validateConsumable(ctx *gin.Context, consumable *model.Consumable) bool {
+	if consumable.Kind != model.ConsumableItem && consumable.Charges == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Wand and staff need charges"})
+		return false
+	}
+	if consumable.SpellID == nil {
+		consumable.SpellRank = 0
+		return true
+	}
+	spell, err := a.DB.GetSpellByID(*consumable.SpellID)
+	if err != nil || spell == nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Spell not found"})
+		return false
+	}
+	if consumable.SpellRank == 0 {
+		consumable.SpellRank = spell.Rank
+	}
+	if consumable.SpellRank < spell.Rank {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "spell_rank is below the rank of the spell"})
+		return false
+	}
+	return true
+}
+
+func ToExternalConsumable(consumable *model.Consumable, item *model.Item) *model.ConsumableExternal {
+	external := &model.ConsumableExternal{
+		ID:          consumable.ID,
+		Name:        item.Name,
+		Description: item.Description,
+		Bulk:        item.Bulk,
+		Level:       item.Level,
+		Price:       item.Price,
+		Kind:        consumable.Kind,
+		Uses:        consumable.Uses,
+		Charges:     consumable.Charges,
+		SpellID:     consumable.SpellID,
+		SpellRank:   consumable.SpellRank,
+		ItemID:      item.ID,
+	}
+	if consumable.Spell != nil {
+		external.SpellName = consumable.Spell.Name
+	}
+	return external
+}
@@ -0,0 +1,293 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+type CreatureDatabase interface {
+	CreateCreature(creature *model.Creature) error
+	GetCreatureByID(id uint) (*model.Creature, error)
+	GetCreatures(filter *model.CreatureFilter) ([]*model.Creature, error)
+	UpdateCreature(creature *model.Creature) error
+	DeleteCreature(id uint) error
+	GetImmunityResistanceWeakness(name string) (*model.ImmunityResistanceWeakness, error)
+	FindTraits(traitIDs []uint) ([]model.Trait, error)
+	FindSpells(IDs []uint) ([]model.Spell, error)
+}
+
+type CreatureApi struct {
+	DB CreatureDatabase
+}
+
+// CreateCreature godoc
+//
+// @Summary Create and returns Creature
+// @Description Permissions for Admin, defences are immunities, resistances and weaknesses by damage type
+// @Tags Creature
+// @Accept json
+// @Produce json
+// @Param creature body model.CreatureCreate true "Creature data"
+// @Success 201 {object} model.CreatureExternal "Creature details"
+// @Failure 400 {string} string "Wrong creature data"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /creature [post]
+func (a *CreatureApi) CreateCreature(ctx *gin.Context) {
+	creature := &model.CreatureCreate{}
+	if err := ctx.ShouldBindJSON(creature); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal, err := a.toInternalCreature(creature)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	if success := SuccessOrAbort(ctx, 500, a.DB.CreateCreature(internal)); !success {
+		return
+	}
+	newCreature, err := a.DB.GetCreatureByID(internal.ID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToExternalCreature(newCreature))
+}
+
+// GetCreatures godoc
+//
+// @Summary Returns Creatures
+// @Description Permissions for auth users, filters by level, level range, trait name and rarity
+// @Tags Creature
+// @Accept json
+// @Produce json
+// @Param level query int false "Level"
+// @Param min_level query int false "Lowest level"
+// @Param max_level query int false "Highest level"
+// @Param trait query string false "Trait name"
+// @Param rarity query string false "Rarity"
+// @Param limit query int false "Limit for pagination"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} []model.CreatureExternal "Creature details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /creature [get]
+func (a *CreatureApi) GetCreatures(ctx *gin.Context) {
+	filter := &model.CreatureFilter{}
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	creatures, err := a.DB.GetCreatures(filter)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.CreatureExternal{}
+	for _, creature := range creatures {
+		resp = append(resp, ToExternalCreature(creature))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCreatureByID godoc
+//
+// @Summary Returns Creature by ID
+// @Description Permissions for auth users
+// @Tags Creature
+// @Accept json
+// @Produce json
+// @Param id path int true "Creature id"
+// @Success 200 {object} model.CreatureExternal "Creature details"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Creature doesn't exist"
+// @Router /creature/{id} [get]
+func (a *CreatureApi) GetCreatureByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		creature, err := a.DB.GetCreatureByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if creature == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Creature doesn't exist"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCreature(creature))
+	})
+}
+
+// UpdateCreature godoc
+//
+// @Summary Updates Creature by ID
+// @Description Permissions for Admin, traits, spells, strikes and defences are replaced
+// @Tags Creature
+// @Accept json
+// @Produce json
+// @Param id path int true "Creature id"
+// @Param creature body model.CreatureCreate true "Creature data"
+// @Success 200 {object} model.CreatureExternal "Creature details"
+// @Failure 400 {string} string "Wrong creature data"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Creature doesn't exist"
+// @Router /creature/{id} [patch]
+func (a *CreatureApi) UpdateCreature(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		creature := &model.CreatureCreate{}
+		if err := ctx.ShouldBindJSON(creature); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldCreature, err := a.DB.GetCreatureByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldCreature == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Creature doesn't exist"})
+			return
+		}
+		internal, err := a.toInternalCreature(creature)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		internal.ID = oldCreature.ID
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCreature(internal)); !success {
+			return
+		}
+		newCreature, err := a.DB.GetCreatureByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalCreature(newCreature))
+	})
+}
+
+// DeleteCreature godoc
+//
+// @Summary Deletes Creature by ID
+// @Description Permissions for Admin
+// @Tags Creature
+// @Accept json
+// @Produce json
+// @Param id path int true "Creature id"
+// @Success 204
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Creature doesn't exist"
+// @Router /creature/{id} [delete]
+func (a *CreatureApi) DeleteCreature(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		creature, err := a.DB.GetCreatureByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if creature == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Creature doesn't exist"})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCreature(id)); !success {
+			return
+		}
+		ctx.JSON(http.StatusNoContent, gin.H{"error": "Creature was deleted"})
+	})
+}
+
+func (a *CreatureApi) toInternalCreature(creature *model.CreatureCreate) (*model.Creature, error) {
+	internal := &model.Creature{
+		Name:        creature.Name,
+		Description: creature.Description,
+		Level:       creature.Level,
+		Rarity:      creature.Rarity,
+		Size:        creature.Size,
+		Perception:  creature.Perception,
+		ArmorClass:  creature.ArmorClass,
+		Fortitude:   creature.Fortitude,
+		Reflex:      creature.Reflex,
+		Will:        creature.Will,
+		HitPoint:    creature.HitPoint,
+		Speed:       creature.Speed,
+		FlySpeed:    creature.FlySpeed,
+		SwimSpeed:   creature.SwimSpeed,
+		ClimbSpeed:  creature.ClimbSpeed,
+		BurrowSpeed: creature.BurrowSpeed,
+		Language:    creature.Language,
+	}
+	var err error
+	if len(creature.TraitsID) > 0 {
+		if internal.Traits, err = a.DB.FindTraits(creature.TraitsID); err != nil {
+			return nil, err
+		}
+	}
+	if len(creature.SpellsID) > 0 {
+		if internal.Spells, err = a.DB.FindSpells(creature.SpellsID); err != nil {
+			return nil, err
+		}
+	}
+	for _, strike := range creature.Strikes {
+		internal.Strikes = append(internal.Strikes, model.CreatureStrike{
+			Name:        strike.Name,
+			Ranged:      strike.Ranged,
+			AttackBonus: strike.AttackBonus,
+			Damage:      strike.Damage,
+			Traits:      strike.Traits,
+		})
+	}
+	for _, defence := range creature.Defences {
+		irw, err := a.DB.GetImmunityResistanceWeakness(defence.Name)
+		if err != nil {
+			return nil, err
+		}
+		internal.Defences = append(internal.Defences, model.CreatureDefence{
+			ImmunityResistanceWeaknessID: irw.ID,
+			Kind:                         defence.Kind,
+			Value:                        defence.Value,
+		})
+	}
+	return internal, nil
+}
+
+func ToExternalCreature(creature *model.Creature) *model.CreatureExternal {
+	external := &model.CreatureExternal{
+		ID:          creature.ID,
+		Name:        creature.Name,
+		Description: creature.Description,
+		Level:       creature.Level,
+		Rarity:      creature.Rarity,
+		Size:        creature.Size,
+		Perception:  creature.Perception,
+		ArmorClass:  creature.ArmorClass,
+		Fortitude:   creature.Fortitude,
+		Reflex:      creature.Reflex,
+		Will:        creature.Will,
+		HitPoint:    creature.HitPoint,
+		Speed:       creature.Speed,
+		FlySpeed:    creature.FlySpeed,
+		SwimSpeed:   creature.SwimSpeed,
+		ClimbSpeed:  creature.ClimbSpeed,
+		BurrowSpeed: creature.BurrowSpeed,
+		Language:    creature.Language,
+		Traits:      []string{},
+		Spells:      []string{},
+		Strikes:     []model.CreatureStrikeExternal{},
+		Defences:    []model.CreatureDefenceExternal{},
+	}
+	for _, trait := range creature.Traits {
+		external.Traits = append(external.Traits, trait.Name)
+	}
+	for _, spell := range creature.Spells {
+		external.Spells = append(external.Spells, spell.Name)
+	}
+	for _, strike := range creature.Strikes {
+		external.Strikes = append(external.Strikes, model.CreatureStrikeExternal{
+			Name:        strike.Name,
+			Ranged:      strike.Ranged,
+			AttackBonus: strike.AttackBonus,
+			Damage:      strike.Damage,
+			Traits:      strike.Traits,
+		})
+	}
+	for _, defence := range creature.Defences {
+		external.Defences = append(external.Defences, model.CreatureDefenceExternal{
+			Name:  defence.ImmunityResistanceWeakness.Name,
+			Kind:  defence.Kind,
+			Value: defence.Value,
+		})
+	}
+	return external
+}
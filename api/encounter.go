@@ -0,0 +1,874 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/dice"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+	"strings"
+)
+
+type EncounterDatabase interface {
+	SheetDatabase
+	auth.AccessDatabase
+	CreateEncounter(encounter *model.Encounter) error
+	GetEncounterByID(id uint) (*model.Encounter, error)
+	GetEncounters(campaignID uint) ([]*model.Encounter, error)
+	UpdateEncounterTurn(encounter *model.Encounter) error
+	DeleteEncounter(id uint) error
+	CreateCombatant(combatant *model.Combatant) error
+	UpdateCombatant(combatant *model.Combatant) error
+	DeleteCombatant(id uint) error
+	SetCombatantCondition(combatantCondition *model.CombatantCondition) error
+	DeleteCombatantCondition(combatantID uint, conditionID uint) error
+	UpdateCombatantConditions(conditions []model.CombatantCondition) error
+	CreatePersistentDamage(persistentDamage *model.PersistentDamage) error
+	DeletePersistentDamage(combatantID uint, id uint) error
+	GetCampaignByID(id uint) (*model.Campaign, error)
+	GetCharacterByID(id uint) (*model.Character, error)
+	GetCreatureByID(id uint) (*model.Creature, error)
+	GetCreatures(filter *model.CreatureFilter) ([]*model.Creature, error)
+	GetConditionByID(id uint) (*model.Condition, error)
+	SetCharacterCondition(characterCondition *model.CharacterCondition) error
+	DeleteCharacterCondition(characterID uint, conditionID uint) error
+	UpdateCharacterConditions(conditions []model.CharacterCondition) error
+	ApplyHealthChange(defence *model.CharacterDefence, healthLog *model.HealthLog) error
+	UpdateShieldRaised(characterID uint, raised bool) error
+}
+
+type EncounterApi struct {
+	DB     EncounterDatabase
+	Roller *dice.Roller
+}
+
+// CreateEncounter godoc
+//
+// @Summary Creates Encounter in Campaign
+// @Description Permissions for Game Master
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter body model.CreateEncounter true "Encounter data"
+// @Success 201 {object} model.EncounterExternal "encounter state"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/encounter [post]
+func (a *EncounterApi) CreateEncounter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		encounter := &model.CreateEncounter{}
+		if err := ctx.ShouldBindJSON(encounter); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		internal := &model.Encounter{CampaignID: id, Name: encounter.Name}
+		if success := SuccessOrAbort(ctx, 500, a.DB.CreateEncounter(internal)); !success {
+			return
+		}
+		a.respondEncounter(ctx, http.StatusCreated, internal.ID, nil)
+	})
+}
+
+// GetEncounters godoc
+//
+// @Summary Returns Encounters of Campaign
+// @Description Permissions for Game Master and players of the Campaign, combatants are not included
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Success 200 {object} []model.EncounterExternal "encounters"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Campaign not found"
+// @Router /campaign/{id}/encounter [get]
+func (a *EncounterApi) GetEncounters(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		if !a.campaignMember(ctx, id) {
+			return
+		}
+		encounters, err := a.DB.GetEncounters(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := []*model.EncounterExternal{}
+		for _, encounter := range encounters {
+			resp = append(resp, &model.EncounterExternal{
+				ID:                 encounter.ID,
+				CampaignID:         encounter.CampaignID,
+				Name:               encounter.Name,
+				Round:              encounter.Round,
+				CurrentCombatantID: encounter.CurrentCombatantID,
+				Combatants:         []model.CombatantExternal{},
+			})
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// GetEncounter godoc
+//
+// @Summary Returns state of Encounter
+// @Description Permissions for Game Master and players of the Campaign, combatants in initiative order
+// @Description with hit points, conditions, reactions and persistent damage
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Encounter not found"
+// @Router /campaign/{id}/encounter/{encounter_id} [get]
+func (a *EncounterApi) GetEncounter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			if !a.campaignMember(ctx, id) || a.campaignEncounter(ctx, id, encounterID) == nil {
+				return
+			}
+			a.respondEncounter(ctx, http.StatusOK, encounterID, nil)
+		})
+	})
+}
+
+// DeleteEncounter godoc
+//
+// @Summary Deletes Encounter
+// @Description Permissions for Game Master
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Success 200 {string} string "ok"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Encounter not found"
+// @Router /campaign/{id}/encounter/{encounter_id} [delete]
+func (a *EncounterApi) DeleteEncounter(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			if a.campaignEncounter(ctx, id, encounterID) == nil {
+				return
+			}
+			if success := SuccessOrAbort(ctx, 500, a.DB.DeleteEncounter(encounterID)); !success {
+				return
+			}
+			ctx.JSON(http.StatusOK, "ok")
+		})
+	})
+}
+
+// AddCombatant godoc
+//
+// @Summary Adds Combatant to Encounter
+// @Description Permissions for Game Master, a character must play in the Campaign, a creature of the bestiary
+// @Description takes its statistics from there and an ad-hoc creature needs name, hit points and armor class
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant body model.CreateCombatant true "Combatant data"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 400 {string} string "Creature needs a name"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Encounter not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant [post]
+func (a *EncounterApi) AddCombatant(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			combatant := &model.CreateCombatant{}
+			if err := ctx.ShouldBindJSON(combatant); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			encounter := a.campaignEncounter(ctx, id, encounterID)
+			if encounter == nil {
+				return
+			}
+			internal := &model.Combatant{
+				EncounterID:        encounter.ID,
+				CharacterID:        combatant.CharacterID,
+				CreatureID:         combatant.CreatureID,
+				Name:               combatant.Name,
+				InitiativeModifier: combatant.InitiativeModifier,
+				ArmorClass:         combatant.ArmorClass,
+				MaxHitPoint:        combatant.HitPoint,
+				HitPoint:           combatant.HitPoint,
+			}
+			if combatant.CharacterID != nil {
+				character, err := a.DB.GetCharacterByID(*combatant.CharacterID)
+				if err != nil || character.CampaignID == nil || *character.CampaignID != id {
+					ctx.JSON(http.StatusNotFound, gin.H{"error": "Character doesn't play in this campaign"})
+					return
+				}
+				for _, present := range encounter.Combatants {
+					if present.CharacterID != nil && *present.CharacterID == character.ID {
+						ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character is already in the encounter"})
+						return
+					}
+				}
+				internal.Name = character.Name
+				internal.CreatureID = nil
+				internal.InitiativeModifier = 0
+				internal.ArmorClass = 0
+				internal.MaxHitPoint = 0
+				internal.HitPoint = 0
+			} else if combatant.CreatureID != nil {
+				creature, err := a.DB.GetCreatureByID(*combatant.CreatureID)
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+				if creature == nil {
+					ctx.JSON(http.StatusNotFound, gin.H{"error": "Creature doesn't exist"})
+					return
+				}
+				if strings.TrimSpace(internal.Name) == "" {
+					internal.Name = creature.Name
+				}
+				internal.InitiativeModifier = int(creature.Perception)
+				internal.ArmorClass = creature.ArmorClass
+				internal.MaxHitPoint = creature.HitPoint
+				internal.HitPoint = creature.HitPoint
+			} else if strings.TrimSpace(combatant.Name) == "" {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "Creature needs a name"})
+				return
+			}
+			if success := SuccessOrAbort(ctx, 500, a.DB.CreateCombatant(internal)); !success {
+				return
+			}
+			a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+		})
+	})
+}
+
+// UpdateCombatant godoc
+//
+// @Summary Updates Combatant of Encounter
+// @Description Permissions for Game Master. Negative hit point deals damage and positive heals,
+// @Description on a character it goes through its health log. Temporary hit points are set on creatures only
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Param combatant body model.UpdateCombatant true "Combatant data"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 400 {string} string "Character is dead"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Combatant not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id} [patch]
+func (a *EncounterApi) UpdateCombatant(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		update := &model.UpdateCombatant{}
+		if err := ctx.ShouldBindJSON(update); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if update.Initiative != nil {
+			combatant.Initiative = update.Initiative
+		}
+		if update.ReactionUsed != nil {
+			combatant.ReactionUsed = *update.ReactionUsed
+		}
+		if combatant.CharacterID == nil {
+			if update.TemporaryHitPoint != nil {
+				combatant.TemporaryHitPoint = *update.TemporaryHitPoint
+			}
+			if update.HitPoint < 0 {
+				rules.DamageCombatant(combatant, uint16(-update.HitPoint))
+			} else {
+				rules.HealCombatant(combatant, uint16(update.HitPoint))
+			}
+		} else if update.HitPoint != 0 {
+			character, err := a.DB.GetCharacterByID(*combatant.CharacterID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			if rules.IsDead(&character.CharacterDefence) {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "Character is dead"})
+				return
+			}
+			if success := SuccessOrAbort(ctx, 500, a.changeCharacterHealth(ctx, character, update.HitPoint)); !success {
+				return
+			}
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCombatant(combatant)); !success {
+			return
+		}
+		a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+	})
+}
+
+// DeleteCombatant godoc
+//
+// @Summary Removes Combatant from Encounter
+// @Description Permissions for Game Master, the turn passes to the next combatant when the current one is removed
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Combatant not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id} [delete]
+func (a *EncounterApi) DeleteCombatant(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		if encounter.CurrentCombatantID != nil && *encounter.CurrentCombatantID == combatant.ID {
+			next, newRound := rules.NextCombatant(rules.InitiativeOrder(encounter.Combatants), encounter.CurrentCombatantID)
+			encounter.CurrentCombatantID = nil
+			if next != nil && next.ID != combatant.ID {
+				encounter.CurrentCombatantID = &next.ID
+				if newRound {
+					encounter.Round++
+				}
+			}
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateEncounterTurn(encounter)); !success {
+				return
+			}
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteCombatant(combatant.ID)); !success {
+			return
+		}
+		a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+	})
+}
+
+// SetCombatantCondition godoc
+//
+// @Summary Applies condition to Combatant
+// @Description Permissions for Game Master, conditions of a character are stored on the character.
+// @Description Dying and wounded follow from hit points
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Param condition body model.CreateCharacterCondition true "Condition data"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 400 {string} string "Wrong condition count"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Condition not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/condition [post]
+func (a *EncounterApi) SetCombatantCondition(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		combatantCondition := &model.CreateCharacterCondition{}
+		if err := ctx.ShouldBindJSON(combatantCondition); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		condition, err := a.DB.GetConditionByID(combatantCondition.ConditionID)
+		if err != nil || condition == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Condition not found"})
+			return
+		}
+		if isDefenceCondition(condition) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Dying and wounded follow from hit points"})
+			return
+		}
+		count := combatantCondition.Count
+		if !condition.Valued {
+			count = 1
+		}
+		if count < 1 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Condition count must be positive"})
+			return
+		}
+
+		if combatant.CharacterID != nil {
+			err = a.DB.SetCharacterCondition(&model.CharacterCondition{
+				CharacterID: *combatant.CharacterID,
+				ConditionID: condition.ID,
+				Count:       count,
+			})
+		} else {
+			err = a.DB.SetCombatantCondition(&model.CombatantCondition{
+				CombatantID: combatant.ID,
+				ConditionID: condition.ID,
+				Count:       count,
+			})
+		}
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+	})
+}
+
+// DeleteCombatantCondition godoc
+//
+// @Summary Removes condition from Combatant
+// @Description Permissions for Game Master
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Param condition_id path int true "Condition id"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Combatant not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/condition/{condition_id} [delete]
+func (a *EncounterApi) DeleteCombatantCondition(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		withID(ctx, "condition_id", func(conditionID uint) {
+			var err error
+			if combatant.CharacterID != nil {
+				err = a.DB.DeleteCharacterCondition(*combatant.CharacterID, conditionID)
+			} else {
+				err = a.DB.DeleteCombatantCondition(combatant.ID, conditionID)
+			}
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+		})
+	})
+}
+
+// AddPersistentDamage godoc
+//
+// @Summary Adds persistent damage to Combatant
+// @Description Permissions for Game Master, the damage is rolled at the end of the combatant turn
+// @Description followed by a DC 15 flat check to end it
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Param damage body model.CreatePersistentDamage true "Persistent damage data"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 400 {string} string "Wrong dice notation"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Combatant not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/persistent-damage [post]
+func (a *EncounterApi) AddPersistentDamage(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		damage := &model.CreatePersistentDamage{}
+		if err := ctx.ShouldBindJSON(damage); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if _, err := dice.Parse(damage.Notation); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		err := a.DB.CreatePersistentDamage(&model.PersistentDamage{
+			CombatantID: combatant.ID,
+			Notation:    damage.Notation,
+			DamageType:  damage.DamageType,
+		})
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+	})
+}
+
+// DeletePersistentDamage godoc
+//
+// @Summary Ends persistent damage of Combatant
+// @Description Permissions for Game Master
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param combatant_id path int true "Combatant id"
+// @Param damage_id path int true "Persistent damage id"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Combatant not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/persistent-damage/{damage_id} [delete]
+func (a *EncounterApi) DeletePersistentDamage(ctx *gin.Context) {
+	a.withCombatant(ctx, func(encounter *model.Encounter, combatant *model.Combatant) {
+		withID(ctx, "damage_id", func(damageID uint) {
+			if success := SuccessOrAbort(ctx, 500, a.DB.DeletePersistentDamage(combatant.ID, damageID)); !success {
+				return
+			}
+			a.respondEncounter(ctx, http.StatusOK, encounter.ID, nil)
+		})
+	})
+}
+
+// RollInitiative godoc
+//
+// @Summary Rolls initiative of Combatants without one
+// @Description Permissions for Game Master. Characters roll Perception or the skill chosen by combatant id,
+// @Description creatures roll with their initiative modifier
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Param initiative body model.RollInitiative false "Skills by combatant id"
+// @Success 200 {object} model.EncounterExternal "encounter state"
+// @Failure 400 {string} string "Unknown skill"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Encounter not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/initiative [post]
+func (a *EncounterApi) RollInitiative(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			initiative := &model.RollInitiative{}
+			if ctx.Request.ContentLength > 0 {
+				if err := ctx.ShouldBindJSON(initiative); err != nil {
+					ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+			}
+			encounter := a.campaignEncounter(ctx, id, encounterID)
+			if encounter == nil {
+				return
+			}
+
+			var log []string
+			for i := range encounter.Combatants {
+				combatant := &encounter.Combatants[i]
+				if combatant.Initiative != nil {
+					continue
+				}
+				modifier, name := combatant.InitiativeModifier, "Initiative"
+				if combatant.CharacterID != nil {
+					statistic, err := a.initiativeStatistic(*combatant.CharacterID, initiative.Skills[combatant.ID])
+					if err != nil {
+						ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+						return
+					}
+					modifier, name = statistic.Value, statistic.Name
+				}
+				result, err := a.Roller.RollNotation(checkNotation(modifier), dice.Normal)
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+				combatant.Initiative = &result.Total
+				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCombatant(combatant)); !success {
+					return
+				}
+				log = append(log, fmt.Sprintf("%s rolls %s for initiative: %s", combatant.Name, name, result))
+			}
+			a.respondEncounter(ctx, http.StatusOK, encounter.ID, log)
+		})
+	})
+}
+
+// NextTurn godoc
+//
+// @Summary Ends the current turn and starts the next one
+// @Description Permissions for Game Master. At the end of turn conditions like frightened decrease and persistent
+// @Description damage is rolled with a flat check to end it. The next combatant regains its reaction and
+// @Description lowers its shield, a new round begins after the last combatant
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param encounter_id path int true "Encounter id"
+// @Success 200 {object} model.EncounterExternal "encounter state with the log of the turn change"
+// @Failure 400 {string} string "Encounter has no combatants"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Encounter not found"
+// @Router /campaign/{id}/encounter/{encounter_id}/next-turn [post]
+func (a *EncounterApi) NextTurn(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			encounter := a.campaignEncounter(ctx, id, encounterID)
+			if encounter == nil {
+				return
+			}
+			order := rules.InitiativeOrder(encounter.Combatants)
+			if len(order) == 0 {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "Encounter has no combatants"})
+				return
+			}
+
+			var log []string
+			if current := findCombatant(encounter, encounter.CurrentCombatantID); current != nil {
+				endLog, err := a.endTurn(ctx, current)
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+				log = append(log, endLog...)
+			}
+
+			next, newRound := rules.NextCombatant(order, encounter.CurrentCombatantID)
+			if newRound {
+				encounter.Round++
+				log = append(log, fmt.Sprintf("Round %d begins", encounter.Round))
+			}
+			encounter.CurrentCombatantID = &next.ID
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateEncounterTurn(encounter)); !success {
+				return
+			}
+			next = findCombatant(encounter, &next.ID)
+			next.ReactionUsed = false
+			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateCombatant(next)); !success {
+				return
+			}
+			if next.CharacterID != nil {
+				if success := SuccessOrAbort(ctx, 500, a.DB.UpdateShieldRaised(*next.CharacterID, false)); !success {
+					return
+				}
+			}
+			log = append(log, fmt.Sprintf("%s's turn", next.Name))
+			a.respondEncounter(ctx, http.StatusOK, encounter.ID, log)
+		})
+	})
+}
+
+// endTurn decrements conditions of the combatant and deals its persistent damage
+func (a *EncounterApi) endTurn(ctx *gin.Context, combatant *model.Combatant) ([]string, error) {
+	var log []string
+	var character *model.Character
+	if combatant.CharacterID != nil {
+		var err error
+		if character, err = a.DB.GetCharacterByID(*combatant.CharacterID); err != nil {
+			return nil, err
+		}
+		changed := rules.EndTurn(character.CharacterCondition)
+		if err := a.DB.UpdateCharacterConditions(changed); err != nil {
+			return nil, err
+		}
+		for _, characterCondition := range changed {
+			log = append(log, conditionLog(combatant.Name, &characterCondition.Condition, characterCondition.Count))
+		}
+	} else {
+		var changed []model.CombatantCondition
+		for _, combatantCondition := range combatant.Conditions {
+			count, ok := rules.DecrementCondition(&combatantCondition.Condition, combatantCondition.Count)
+			if !ok {
+				continue
+			}
+			combatantCondition.Count = count
+			changed = append(changed, combatantCondition)
+			log = append(log, conditionLog(combatant.Name, &combatantCondition.Condition, count))
+		}
+		if err := a.DB.UpdateCombatantConditions(changed); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, damage := range combatant.PersistentDamage {
+		result, err := a.Roller.RollNotation(damage.Notation, dice.Normal)
+		if err != nil {
+			return nil, err
+		}
+		amount := uint16(max(result.Total, 0))
+		if character != nil {
+			if err := a.applyCharacterDamage(ctx, character, amount); err != nil {
+				return nil, err
+			}
+		} else {
+			rules.DamageCombatant(combatant, amount)
+		}
+		log = append(log, fmt.Sprintf("%s takes %s persistent %s damage", combatant.Name, result, damage.DamageType))
+
+		flatCheck := a.Roller.Die(20)
+		if flatCheck >= rules.PersistentDamageDC {
+			if err := a.DB.DeletePersistentDamage(combatant.ID, damage.ID); err != nil {
+				return nil, err
+			}
+			log = append(log, fmt.Sprintf("%s recovers from persistent %s damage with a flat check of %d",
+				combatant.Name, damage.DamageType, flatCheck))
+		}
+	}
+	return log, a.DB.UpdateCombatant(combatant)
+}
+
+// changeCharacterHealth deals damage for a negative amount and heals for a positive one
+func (a *EncounterApi) changeCharacterHealth(ctx *gin.Context, character *model.Character, amount int16) error {
+	if amount < 0 {
+		return a.applyCharacterDamage(ctx, character, uint16(-amount))
+	}
+	rules.Heal(&character.CharacterDefence, uint16(amount))
+	return a.logHealth(ctx, character, model.HealthChangeHeal, uint16(amount))
+}
+
+func (a *EncounterApi) applyCharacterDamage(ctx *gin.Context, character *model.Character, amount uint16) error {
+	if rules.IsDead(&character.CharacterDefence) {
+		return nil
+	}
+	rules.ApplyDamage(&character.CharacterDefence, amount, false)
+	return a.logHealth(ctx, character, model.HealthChangeDamage, amount)
+}
+
+func (a *EncounterApi) logHealth(ctx *gin.Context, character *model.Character, kind string, amount uint16) error {
+	defence := &character.CharacterDefence
+	return a.DB.ApplyHealthChange(defence, &model.HealthLog{
+		CharacterID:       character.ID,
+		UserID:            auth.GetUserID(ctx),
+		Kind:              kind,
+		Amount:            amount,
+		HitPoint:          defence.HitPoint,
+		TemporaryHitPoint: defence.TemporaryHitPoint,
+		Dying:             defence.Dying,
+		Wounded:           defence.Wounded,
+		Dead:              rules.IsDead(defence),
+	})
+}
+
+// initiativeStatistic returns Perception or the named skill of the character
+func (a *EncounterApi) initiativeStatistic(characterID uint, skill string) (*model.Statistic, error) {
+	character, err := a.DB.GetCharacterByID(characterID)
+	if err != nil {
+		return nil, err
+	}
+	sheet, err := loadSheet(a.DB, character)
+	if err != nil {
+		return nil, err
+	}
+	stats := rules.Compute(sheet)
+	if skill == "" || strings.EqualFold(skill, stats.Perception.Name) {
+		return &stats.Perception, nil
+	}
+	for _, statistic := range stats.Skills {
+		if strings.EqualFold(statistic.Name, skill) {
+			return &statistic, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown skill %s", skill)
+}
+
+// campaignMember responds with 404 or 403 unless the user is member of the campaign
+func (a *EncounterApi) campaignMember(ctx *gin.Context, campaignID uint) bool {
+	campaign, err := a.DB.GetCampaignByID(campaignID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return false
+	}
+	if campaign == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+		return false
+	}
+	if !isCampaignMember(ctx, a.DB, campaign) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+		return false
+	}
+	return true
+}
+
+// campaignEncounter returns the encounter of the campaign or responds with 404
+func (a *EncounterApi) campaignEncounter(ctx *gin.Context, campaignID uint, encounterID uint) *model.Encounter {
+	encounter, err := a.DB.GetEncounterByID(encounterID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil
+	}
+	if encounter == nil || encounter.CampaignID != campaignID {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Encounter not found"})
+		return nil
+	}
+	return encounter
+}
+
+func (a *EncounterApi) withCombatant(ctx *gin.Context, f func(encounter *model.Encounter, combatant *model.Combatant)) {
+	withID(ctx, "id", func(id uint) {
+		withID(ctx, "encounter_id", func(encounterID uint) {
+			withID(ctx, "combatant_id", func(combatantID uint) {
+				encounter := a.campaignEncounter(ctx, id, encounterID)
+				if encounter == nil {
+					return
+				}
+				combatant := findCombatant(encounter, &combatantID)
+				if combatant == nil {
+					ctx.JSON(http.StatusNotFound, gin.H{"error": "Combatant not found"})
+					return
+				}
+				f(encounter, combatant)
+			})
+		})
+	})
+}
+
+func (a *EncounterApi) respondEncounter(ctx *gin.Context, status int, encounterID uint, log []string) {
+	encounter, err := a.DB.GetEncounterByID(encounterID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := &model.EncounterExternal{
+		ID:                 encounter.ID,
+		CampaignID:         encounter.CampaignID,
+		Name:               encounter.Name,
+		Round:              encounter.Round,
+		CurrentCombatantID: encounter.CurrentCombatantID,
+		Combatants:         []model.CombatantExternal{},
+		Log:                log,
+	}
+	for _, combatant := range rules.InitiativeOrder(encounter.Combatants) {
+		external := ToExternalCombatant(&combatant)
+		external.Current = encounter.CurrentCombatantID != nil && *encounter.CurrentCombatantID == combatant.ID
+		if combatant.CharacterID != nil {
+			character, err := a.DB.GetCharacterByID(*combatant.CharacterID)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			sheet, err := loadSheet(a.DB, character)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			external.ArmorClass = rules.Compute(sheet).ArmorClass.Value
+			external.MaxHitPoint = character.CharacterDefence.MaxHitPoint
+			external.HitPoint = character.CharacterDefence.HitPoint
+			external.TemporaryHitPoint = character.CharacterDefence.TemporaryHitPoint
+			external.Conditions = ToExternalCharacterConditions(character)
+		}
+		resp.Combatants = append(resp.Combatants, *external)
+	}
+	ctx.JSON(status, resp)
+}
+
+func findCombatant(encounter *model.Encounter, id *uint) *model.Combatant {
+	if id == nil {
+		return nil
+	}
+	for i := range encounter.Combatants {
+		if encounter.Combatants[i].ID == *id {
+			return &encounter.Combatants[i]
+		}
+	}
+	return nil
+}
+
+func conditionLog(name string, condition *model.Condition, count int8) string {
+	if count <= 0 {
+		return fmt.Sprintf("%s is no longer %s", name, strings.ToLower(condition.Name))
+	}
+	return fmt.Sprintf("%s is %s %d", name, strings.ToLower(condition.Name), count)
+}
+
+func ToExternalCombatant(combatant *model.Combatant) *model.CombatantExternal {
+	external := &model.CombatantExternal{
+		ID:                combatant.ID,
+		CharacterID:       combatant.CharacterID,
+		CreatureID:        combatant.CreatureID,
+		Name:              combatant.Name,
+		Initiative:        combatant.Initiative,
+		ArmorClass:        int(combatant.ArmorClass),
+		MaxHitPoint:       combatant.MaxHitPoint,
+		HitPoint:          combatant.HitPoint,
+		TemporaryHitPoint: combatant.TemporaryHitPoint,
+		ReactionUsed:      combatant.ReactionUsed,
+		Conditions:        []model.CharacterConditionExternal{},
+		PersistentDamage:  []model.PersistentDamageExternal{},
+	}
+	for _, combatantCondition := range combatant.Conditions {
+		external.Conditions = append(external.Conditions, model.CharacterConditionExternal{
+			ConditionID: combatantCondition.ConditionID,
+			Name:        combatantCondition.Condition.Name,
+			Count:       combatantCondition.Count,
+		})
+	}
+	for _, damage := range combatant.PersistentDamage {
+		external.PersistentDamage = append(external.PersistentDamage, model.PersistentDamageExternal{
+			ID:         damage.ID,
+			Notation:   damage.Notation,
+			DamageType: damage.DamageType,
+		})
+	}
+	return external
+}
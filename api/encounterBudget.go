@@ -0,0 +1,145 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// MaxEncounterSuggestions limits the suggestions returned at once
+const MaxEncounterSuggestions = 20
+
+// GetEncounterBudget godoc
+//
+// @Summary Returns XP budget of an encounter for the party
+// @Description The party is the campaign or the listed characters, the budget of the threat is adjusted for
+// @Description the party size. Listed creatures (repeat an id for several) are checked against the budget
+// @Description and suggestions are groups of bestiary creatures fitting it
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Param campaign_id query int false "Campaign id"
+// @Param character_ids query []int false "Character ids" collectionFormat(multi)
+// @Param threat query string true "Threat" Enums(trivial, low, moderate, severe, extreme)
+// @Param creature_ids query []int false "Creature ids" collectionFormat(multi)
+// @Param suggestions query int false "Number of suggestions"
+// @Param trait query string false "Trait of suggested creatures"
+// @Success 200 {object} model.EncounterBudgetExternal "encounter budget"
+// @Failure 400 {string} string "Party is empty"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Creature doesn't exist"
+// @Router /encounter-budget [get]
+func (a *EncounterApi) GetEncounterBudget(ctx *gin.Context) {
+	query := &model.EncounterBudgetQuery{}
+	if err := ctx.ShouldBindQuery(query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	levels, ok := a.partyLevels(ctx, query)
+	if !ok {
+		return
+	}
+	if len(levels) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Party is empty"})
+		return
+	}
+
+	resp := &model.EncounterBudgetExternal{
+		PartyLevel:  rules.PartyLevel(levels),
+		PartySize:   len(levels),
+		Threat:      query.Threat,
+		Budget:      rules.EncounterBudget(query.Threat, len(levels)),
+		Creatures:   []model.CreatureXPExternal{},
+		Errors:      []string{},
+		Suggestions: []model.EncounterSuggestionExternal{},
+	}
+	counts := map[uint]int{}
+	for _, creatureID := range query.CreatureIDs {
+		if counts[creatureID] == 0 {
+			resp.Creatures = append(resp.Creatures, model.CreatureXPExternal{CreatureID: creatureID})
+		}
+		counts[creatureID]++
+	}
+	for i := range resp.Creatures {
+		entry := &resp.Creatures[i]
+		creature, err := a.DB.GetCreatureByID(entry.CreatureID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if creature == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Creature doesn't exist"})
+			return
+		}
+		xp, allowed := rules.CreatureXP(creature.Level, resp.PartyLevel)
+		if !allowed {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("%s is too powerful for a party of level %d",
+				creature.Name, resp.PartyLevel))
+		}
+		entry.Name = creature.Name
+		entry.Level = creature.Level
+		entry.Count = counts[creature.ID]
+		entry.XP = xp * entry.Count
+		resp.XP += entry.XP
+	}
+	if len(resp.Creatures) > 0 {
+		resp.Actual = rules.ThreatOf(resp.XP, resp.PartySize)
+		if resp.XP > resp.Budget {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("%d XP exceeds the budget of %d XP", resp.XP, resp.Budget))
+		}
+		resp.Valid = len(resp.Errors) == 0
+	}
+
+	if query.Suggestions > 0 {
+		minLevel := resp.PartyLevel - rules.MaxCreatureLevelDifference
+		maxLevel := resp.PartyLevel + rules.MaxCreatureLevelDifference
+		creatures, err := a.DB.GetCreatures(&model.CreatureFilter{
+			MinLevel: &minLevel,
+			MaxLevel: &maxLevel,
+			Trait:    query.Trait,
+		})
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		limit := min(query.Suggestions, MaxEncounterSuggestions)
+		for _, group := range rules.SuggestEncounters(creatures, resp.PartyLevel, resp.PartySize, query.Threat, limit) {
+			suggestion := model.EncounterSuggestionExternal{Creatures: group}
+			for _, entry := range group {
+				suggestion.XP += entry.XP
+			}
+			suggestion.Threat = rules.ThreatOf(suggestion.XP, resp.PartySize)
+			resp.Suggestions = append(resp.Suggestions, suggestion)
+		}
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// partyLevels returns levels of the campaign characters or of the listed characters the user can access
+func (a *EncounterApi) partyLevels(ctx *gin.Context, query *model.EncounterBudgetQuery) ([]int8, bool) {
+	var levels []int8
+	if query.CampaignID != nil {
+		if !a.campaignMember(ctx, *query.CampaignID) {
+			return nil, false
+		}
+		campaign, err := a.DB.GetCampaignByID(*query.CampaignID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return nil, false
+		}
+		for _, character := range campaign.Characters {
+			levels = append(levels, character.Level)
+		}
+	}
+	for _, characterID := range query.CharacterIDs {
+		if !auth.CheckCharacterAccess(ctx, a.DB, model.CharacterResource, characterID) {
+			return nil, false
+		}
+		character, err := a.DB.GetCharacterByID(characterID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return nil, false
+		}
+		levels = append(levels, character.Level)
+	}
+	return levels, true
+}
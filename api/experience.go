@@ -0,0 +1,208 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// GetCharacterExperience godoc
+//
+// @Summary Returns experience of Character
+// @Description Current experience, whether the character is ready to level up and the awards, the latest first
+// @Tags Experience
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.CharacterExperienceExternal "character experience"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/experience [get]
+func (a *CharacterApi) GetCharacterExperience(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		experienceLogs, err := a.DB.GetExperienceLogs(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := &model.CharacterExperienceExternal{
+			CharacterID:    character.ID,
+			Level:          character.Level,
+			Experience:     character.Experience,
+			ReadyToLevelUp: rules.ReadyToLevelUp(character),
+			Log:            []model.ExperienceLogExternal{},
+		}
+		for _, experienceLog := range experienceLogs {
+			resp.Log = append(resp.Log, *ToExternalExperienceLog(experienceLog))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// AwardExperience godoc
+//
+// @Summary Awards experience to the party of Campaign
+// @Description Permissions for Game Master, every listed character or the whole party gets the full amount.
+// @Description Not available in campaigns with milestone levelling
+// @Tags Experience
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param award body model.AwardExperience true "Award data"
+// @Success 200 {object} model.CampaignExternal "Campaign details"
+// @Failure 400 {string} string "Campaign uses milestone levelling"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character doesn't play in this campaign"
+// @Router /campaign/{id}/experience [post]
+func (a *CampaignApi) AwardExperience(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		award := &model.AwardExperience{}
+		if err := ctx.ShouldBindJSON(award); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		campaign, characters := a.awardedCharacters(ctx, id, award.CharacterIDs)
+		if campaign == nil {
+			return
+		}
+		if campaign.Milestone {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Campaign uses milestone levelling"})
+			return
+		}
+		var experienceLogs []*model.ExperienceLog
+		for _, character := range characters {
+			rules.GainExperience(character, int(award.Amount))
+			experienceLogs = append(experienceLogs,
+				newExperienceLog(ctx, character, award.Kind, int16(award.Amount), award.Reason))
+		}
+		a.saveAwards(ctx, campaign, characters, experienceLogs)
+	})
+}
+
+// AwardMilestone godoc
+//
+// @Summary Grants a level up milestone to the party of Campaign
+// @Description Permissions for Game Master, only in campaigns with milestone levelling.
+// @Description The listed characters or the whole party become ready to level up
+// @Tags Experience
+// @Accept json
+// @Produce json
+// @Param id path int true "Campaign id"
+// @Param milestone body model.AwardMilestone true "Milestone data"
+// @Success 200 {object} model.CampaignExternal "Campaign details"
+// @Failure 400 {string} string "Campaign uses experience levelling"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character doesn't play in this campaign"
+// @Router /campaign/{id}/milestone [post]
+func (a *CampaignApi) AwardMilestone(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		milestone := &model.AwardMilestone{}
+		if err := ctx.ShouldBindJSON(milestone); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		campaign, characters := a.awardedCharacters(ctx, id, milestone.CharacterIDs)
+		if campaign == nil {
+			return
+		}
+		if !campaign.Milestone {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Campaign uses experience levelling"})
+			return
+		}
+		var experienceLogs []*model.ExperienceLog
+		for _, character := range characters {
+			if character.Level >= rules.MaxLevel {
+				continue
+			}
+			character.LevelReady = true
+			experienceLogs = append(experienceLogs,
+				newExperienceLog(ctx, character, model.ExperienceMilestone, 0, milestone.Reason))
+		}
+		a.saveAwards(ctx, campaign, characters, experienceLogs)
+	})
+}
+
+// awardedCharacters returns the campaign with the listed characters or the whole party
+func (a *CampaignApi) awardedCharacters(
+	ctx *gin.Context,
+	campaignID uint,
+	characterIDs []uint,
+) (*model.Campaign, []*model.Character) {
+	campaign, err := a.DB.GetCampaignByID(campaignID)
+	if err != nil || campaign == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+		return nil, nil
+	}
+	if len(characterIDs) == 0 {
+		for _, character := range campaign.Characters {
+			characterIDs = append(characterIDs, character.ID)
+		}
+	}
+	var characters []*model.Character
+	for _, characterID := range characterIDs {
+		if findCampaignCharacter(campaign, characterID) == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character doesn't play in this campaign"})
+			return nil, nil
+		}
+		character, err := a.DB.GetCharacterByID(characterID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return nil, nil
+		}
+		characters = append(characters, character)
+	}
+	return campaign, characters
+}
+
+func (a *CampaignApi) saveAwards(
+	ctx *gin.Context,
+	campaign *model.Campaign,
+	characters []*model.Character,
+	experienceLogs []*model.ExperienceLog,
+) {
+	if success := SuccessOrAbort(ctx, 500, a.DB.AwardExperience(characters, experienceLogs)); !success {
+		return
+	}
+	campaign, err := a.DB.GetCampaignByID(campaign.ID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	ctx.JSON(http.StatusOK, ToExternalCampaign(campaign))
+}
+
+func newExperienceLog(
+	ctx *gin.Context,
+	character *model.Character,
+	kind string,
+	amount int16,
+	reason string,
+) *model.ExperienceLog {
+	return &model.ExperienceLog{
+		CharacterID: character.ID,
+		CampaignID:  character.CampaignID,
+		AwardedByID: auth.GetUserID(ctx),
+		Kind:        kind,
+		Amount:      amount,
+		Reason:      reason,
+		Experience:  character.Experience,
+	}
+}
+
+func ToExternalExperienceLog(experienceLog *model.ExperienceLog) *model.ExperienceLogExternal {
+	return &model.ExperienceLogExternal{
+		ID:          experienceLog.ID,
+		CharacterID: experienceLog.CharacterID,
+		CampaignID:  experienceLog.CampaignID,
+		AwardedByID: experienceLog.AwardedByID,
+		Kind:        experienceLog.Kind,
+		Amount:      experienceLog.Amount,
+		Reason:      experienceLog.Reason,
+		Experience:  experienceLog.Experience,
+		CreatedAt:   experienceLog.CreatedAt,
+	}
+}
@@ -4,15 +4,16 @@ import (
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
 	"net/http"
-	"strconv"
 )
 
 type FeatDatabase interface {
 	CreateFeat(feat *model.Feat) error
 	GetFeatByID(id uint) (*model.Feat, error)
-	GetFeats(limit int, offset int) (*[]model.Feat, error)
+	FilterFeats(filter *model.FeatFilter) ([]*model.Feat, error)
 	DeleteFeat(id uint) error
 	UpdateFeat(feat *model.Feat) error
+	FindFeats(IDs []uint) ([]model.Feat, error)
+	SetFeatPrerequisites(feat *model.Feat, prerequisites []model.Feat) error
 }
 
 type FeatAPI struct {
@@ -28,48 +29,72 @@ type FeatAPI struct {
 // @Produce json
 // @Param feat body model.CreateFeat true "Feat data"
 // @Success 201 {object} model.FeatExternal "Feat details"
+// @Failure 400 {string} string "Wrong feat data"
 // @Failure 401 {string} string "Unauthorized"
 // @Failure 403 {string} string "You can't access for this API"
 // @Router /feat [post]
 func (a *FeatAPI) CreateFeat(ctx *gin.Context) {
-	feat := &model.Feat{}
-	if err := ctx.Bind(&feat); err == nil {
-		internal := &model.Feat{
-			Name:        feat.Name,
-			Description: feat.Description,
-		}
-		if success := SuccessOrAbort(ctx, 500, a.DB.CreateFeat(internal)); !success {
-			return
-		}
+	feat := &model.CreateFeat{}
+	if err := ctx.ShouldBindJSON(feat); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal := &model.Feat{
+		Name:                feat.Name,
+		Description:         feat.Description,
+		Level:               feat.Level,
+		PrerequisiteSkillID: feat.PrerequisiteSkillID,
+		PrerequisiteMastery: feat.PrerequisiteMastery,
+		Category:            feat.Category,
+		CharacterClassID:    feat.CharacterClassID,
+		RaceID:              feat.RaceID,
+	}
+	if success := SuccessOrAbort(ctx, 500, a.DB.CreateFeat(internal)); !success {
+		return
+	}
+	if success := SuccessOrAbort(ctx, 500, a.setPrerequisites(internal, feat.PrerequisiteFeatIDs)); !success {
+		return
+	}
+	newFeat, err := a.DB.GetFeatByID(internal.ID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
 	}
-	ctx.JSON(http.StatusCreated, feat)
+	ctx.JSON(http.StatusCreated, ToExternalFeat(newFeat))
 }
 
 // GetFeats godoc
 //
-// @Summary Returns all Feats
-// @Description Return all Feats
+// @Summary Returns Feats
+// @Description Permissions for auth users, filters by category, class, ancestry (race), archetype, highest level and trait name
 // @Tags Feat
 // @Accept json
 // @Produce json
+// @Param category query string false "Feat category"
+// @Param class_id query int false "Character class id"
+// @Param ancestry_id query int false "Race id of ancestry feats"
+// @Param archetype_id query int false "Archetype id"
+// @Param max_level query int false "Highest feat level"
+// @Param trait query string false "Trait name"
 // @Param limit query int false "Limit for pagination"
 // @Param offset query int false "Offset for pagination"
-// @Success 200 {object} model.FeatExternal "Feat details"
+// @Success 200 {object} []model.FeatExternal "Feat details"
+// @Failure 400 {string} string "Wrong filter"
 // @Failure 401 {string} string ""Unauthorized"
 // @Router /feat [get]
 func (a *FeatAPI) GetFeats(ctx *gin.Context) {
-	limit := ctx.DefaultQuery("limit", "0")
-	offset := ctx.DefaultQuery("offset", "0")
-	limitInt, err := strconv.Atoi(limit)
-	offsetInt, err := strconv.Atoi(offset)
-	feats, err := a.DB.GetFeats(limitInt, limitInt*offsetInt)
+	filter := &model.FeatFilter{}
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.Offset *= filter.Limit
+	feats, err := a.DB.FilterFeats(filter)
 	if success := SuccessOrAbort(ctx, 500, err); !success {
 		return
 	}
-	var resp []*model.FeatExternal
-	for _, feat := range *feats {
-		externalFeat := ToExternalFeat(&feat)
-		resp = append(resp, externalFeat)
+	resp := []*model.FeatExternal{}
+	for _, feat := range feats {
+		resp = append(resp, ToExternalFeat(feat))
 	}
 	ctx.JSON(http.StatusOK, resp)
 }
@@ -119,15 +144,32 @@ func (a *FeatAPI) UpdateFeat(ctx *gin.Context) {
 				return
 			}
 			internalFeat := &model.Feat{
-				ID:          oldFeat.ID,
-				Name:        Feat.Name,
-				Description: Feat.Description,
-				Level:       Feat.Level,
+				ID:               oldFeat.ID,
+				Name:             Feat.Name,
+				Description:      Feat.Description,
+				Level:            Feat.Level,
+				Category:         oldFeat.Category,
+				CharacterClassID: oldFeat.CharacterClassID,
+				RaceID:           oldFeat.RaceID,
+			}
+			if Feat.Category != "" {
+				internalFeat.Category = Feat.Category
+			}
+			if Feat.CharacterClassID != nil {
+				internalFeat.CharacterClassID = Feat.CharacterClassID
+			}
+			if Feat.RaceID != nil {
+				internalFeat.RaceID = Feat.RaceID
 			}
 			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateFeat(internalFeat)); !success {
 				ctx.JSON(http.StatusInternalServerError, success)
 				return
 			}
+			if Feat.PrerequisiteFeatIDs != nil {
+				if success := SuccessOrAbort(ctx, 500, a.setPrerequisites(internalFeat, Feat.PrerequisiteFeatIDs)); !success {
+					return
+				}
+			}
 			newFeat, _ := a.DB.GetFeatByID(id)
 			ctx.JSON(http.StatusOK, ToExternalFeat(newFeat))
 		}
@@ -163,7 +205,22 @@ func (a *FeatAPI) DeleteFeat(ctx *gin.Context) {
 	})
 }
 
+func (a *FeatAPI) setPrerequisites(feat *model.Feat, IDs []uint) error {
+	prerequisites := []model.Feat{}
+	if len(IDs) > 0 {
+		var err error
+		if prerequisites, err = a.DB.FindFeats(IDs); err != nil {
+			return err
+		}
+	}
+	return a.DB.SetFeatPrerequisites(feat, prerequisites)
+}
+
 func ToExternalFeat(Feat *model.Feat) *model.FeatExternal {
+	prerequisiteFeatIDs := []uint{}
+	for _, prerequisite := range Feat.PrerequisiteFeats {
+		prerequisiteFeatIDs = append(prerequisiteFeatIDs, prerequisite.ID)
+	}
 	return &model.FeatExternal{
 		ID:                  Feat.ID,
 		Name:                Feat.Name,
@@ -173,5 +230,11 @@ func ToExternalFeat(Feat *model.Feat) *model.FeatExternal {
 		PrerequisiteMastery: Feat.PrerequisiteMastery,
 		PrerequisiteFeat:    Feat.PrerequisiteFeat,
 		Traits:              Feat.Traits,
+		PrerequisiteFeatIDs: prerequisiteFeatIDs,
+		Category:            Feat.Category,
+		CharacterClassID:    Feat.CharacterClassID,
+		RaceID:              Feat.RaceID,
+		ArchetypeID:         Feat.ArchetypeID,
+		Dedication:          Feat.Dedication,
 	}
 }
@@ -23,12 +23,17 @@ func (a *ItemApi) CreateGear(ctx *gin.Context) {
 	if err := ctx.ShouldBindJSON(Gear); err == nil {
 		internalGear := &model.Gear{}
 		internalItem := &model.Item{
-			Name:        Gear.Name,
-			Description: Gear.Description,
-			Bulk:        Gear.Bulk,
-			Level:       *Gear.Level,
-			Price:       Gear.Price,
-			OwnerType:   "gears",
+			Name:          Gear.Name,
+			Description:   Gear.Description,
+			Bulk:          Gear.Bulk,
+			Capacity:      Gear.Capacity,
+			BulkReduction: Gear.BulkReduction,
+			Level:         *Gear.Level,
+			Price:         Gear.Price,
+			OwnerType:     "gears",
+		}
+		if !priceItem(ctx, internalItem) {
+			return
 		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateGear(internalGear, internalItem)); !success {
 			ctx.JSON(http.StatusInternalServerError, success)
@@ -111,12 +116,14 @@ func (a *ItemApi) UpdateGear(ctx *gin.Context) {
 				ID: oldGear.ID,
 			}
 			internalItem := &model.Item{
-				ID:          oldGear.Item.ID,
-				Name:        Gear.Name,
-				Description: Gear.Description,
-				Bulk:        Gear.Bulk,
-				Level:       *Gear.Level,
-				Price:       Gear.Price,
+				ID:            oldGear.Item.ID,
+				Name:          Gear.Name,
+				Description:   Gear.Description,
+				Bulk:          Gear.Bulk,
+				Capacity:      Gear.Capacity,
+				BulkReduction: Gear.BulkReduction,
+				Level:         *Gear.Level,
+				Price:         Gear.Price,
 			}
 			if success := SuccessOrAbort(ctx, 500, a.DB.UpdateGear(internalGear, internalItem)); !success {
 				ctx.JSON(http.StatusInternalServerError, success)
@@ -130,12 +137,14 @@ func (a *ItemApi) UpdateGear(ctx *gin.Context) {
 
 func ToExternalGear(Gear *model.Gear, item *model.Item) *model.GearExternal {
 	return &model.GearExternal{
-		ID:          Gear.ID,
-		Name:        item.Name,
-		Description: item.Description,
-		Level:       item.Level,
-		Bulk:        item.Bulk,
-		Price:       item.Price,
-		ItemID:      item.ID,
+		ID:            Gear.ID,
+		Name:          item.Name,
+		Description:   item.Description,
+		Level:         item.Level,
+		Bulk:          item.Bulk,
+		Capacity:      item.Capacity,
+		BulkReduction: item.BulkReduction,
+		Price:         item.Price,
+		ItemID:        item.ID,
 	}
 }
@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"kingdom/model"
+	"kingdom/rules"
 	"net/http"
 )
 
@@ -21,7 +22,18 @@ type ItemDatabase interface {
 	GetGearByID(id uint) (*model.Gear, error)
 	CreateGear(weapon *model.Gear, item *model.Item) error
 	UpdateGear(weapon *model.Gear, item *model.Item) error
+	GetShields() ([]*model.Shield, error)
+	GetShieldByID(id uint) (*model.Shield, error)
+	CreateShield(shield *model.Shield, item *model.Item) error
+	UpdateShield(shield *model.Shield, item *model.Item) error
+	GetConsumables() ([]*model.Consumable, error)
+	GetConsumableByID(id uint) (*model.Consumable, error)
+	CreateConsumable(consumable *model.Consumable, item *model.Item) error
+	UpdateConsumable(consumable *model.Consumable, item *model.Item) error
+	GetSpellByID(id uint) (*model.Spell, error)
+	UpdateItemPrices(items []*model.Item) error
 	DeleteItem(id uint, ownerType string, ownerID uint) error
+	FindTraits(traitIDs []uint) ([]model.Trait, error)
 }
 
 type ItemApi struct {
@@ -103,13 +115,67 @@ func (a *ItemApi) DeleteItem(ctx *gin.Context) {
 
 func ToExternalItem(item *model.Item) *model.ItemExternal {
 	return &model.ItemExternal{
-		ID:          item.ID,
-		Name:        item.Name,
-		Description: item.Description,
-		Level:       item.Level,
-		Bulk:        item.Bulk,
-		Price:       item.Price,
-		OwnerType:   item.OwnerType,
-		OwnerID:     item.OwnerID,
+		ID:            item.ID,
+		Name:          item.Name,
+		Description:   item.Description,
+		Level:         item.Level,
+		Bulk:          item.Bulk,
+		Capacity:      item.Capacity,
+		BulkReduction: item.BulkReduction,
+		Price:         item.Price,
+		PriceCopper:   item.PriceCopper,
+		OwnerType:     item.OwnerType,
+		OwnerID:       item.OwnerID,
 	}
 }
+
+// priceItem sets the copper pieces of the item from its price or responds with an error
+func priceItem(ctx *gin.Context, item *model.Item) bool {
+	price, err := rules.ParsePrice(item.Price)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	item.PriceCopper = price
+	return true
+}
+
+// MigratePrices godoc
+//
+// @Summary Converts item prices to copper pieces
+// @Description Permissions for Admin, reports the items with a price that isn't in cp, sp, gp or pp,
+// @Description they keep their copper pieces
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.PriceMigrationExternal "Migration report"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /admin/prices [post]
+func (a *ItemApi) MigratePrices(ctx *gin.Context) {
+	items, err := a.DB.GetItems()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := &model.PriceMigrationExternal{Unparseable: []model.UnparseablePriceExternal{}}
+	converted := []*model.Item{}
+	for _, item := range items {
+		price, err := rules.ParsePrice(item.Price)
+		if err != nil {
+			resp.Unparseable = append(resp.Unparseable, model.UnparseablePriceExternal{
+				ItemID: item.ID,
+				Name:   item.Name,
+				Price:  item.Price,
+				Error:  err.Error(),
+			})
+			continue
+		}
+		item.PriceCopper = price
+		converted = append(converted, item)
+	}
+	if success := SuccessOrAbort(ctx, 500, a.DB.UpdateItemPrices(converted)); !success {
+		return
+	}
+	resp.Converted = len(converted)
+	ctx.JSON(http.StatusOK, resp)
+}
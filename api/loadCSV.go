@@ -5,6 +5,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"io"
 	"kingdom/model"
+	"kingdom/rules"
 	"log"
 	"net/http"
 	"os"
@@ -25,6 +26,7 @@ type LoadCSVDatabase interface {
 	CreateTrait(Trait *model.Trait) error
 	GetFeatByName(name string) (*model.Feat, error)
 	CreateFeat(feat *model.Feat) error
+	SetFeatPrerequisites(feat *model.Feat, prerequisites []model.Feat) error
 	FindTraits(traitIDs []uint) ([]model.Trait, error)
 	FindTraditions(IDs []uint) ([]model.Tradition, error)
 	CreateSpell(spell *model.Spell) error
@@ -36,7 +38,15 @@ type LoadCSVDatabase interface {
 	CreateAncestry(ancestry *model.Ancestry) error
 	GetBackgroundByName(name string) (*model.Background, error)
 	CreateBackground(background *model.Background) error
+	GetConditionByName(name string) (*model.Condition, error)
+	CreateCondition(condition *model.Condition) error
+	GetSpellByName(name string) (*model.Spell, error)
+	GetCreatureByName(name string) (*model.Creature, error)
+	CreateCreature(creature *model.Creature) error
+	GetImmunityResistanceWeakness(name string) (*model.ImmunityResistanceWeakness, error)
 	GetUserByID(id uint) (*model.User, error)
+	GetItemByName(name string, ownerType string) (*model.Item, error)
+	CreateArmor(armor *model.Armor, item *model.Item) error
 }
 
 type LoadCSVApi struct {
@@ -46,7 +56,7 @@ type LoadCSVApi struct {
 // LoadCSV godoc
 //
 // @Summary Create and returns models from csv files or nil
-// @Description Permissions for Admin, csv - Tradition, Character Class, Trait, Action, Skill, Feat, Spell, Race, Ancestry, Background
+// @Description Permissions for Admin, csv - Tradition, Character Class, Trait, Action, Skill, Feat, Spell, Race, Ancestry, Background, Condition, Creature, Armor
 // @Tags CSV
 // @Accept json
 // @Produce json
@@ -65,6 +75,9 @@ func (a *LoadCSVApi) LoadCSV(ctx *gin.Context) {
 	a.LoadFeat(ctx)
 	a.LoadBackground(ctx)
 	a.LoadSpell(ctx)
+	a.LoadCondition(ctx)
+	a.LoadCreature(ctx)
+	a.LoadArmor(ctx)
 }
 
 func (a *LoadCSVApi) LoadDomain(ctx *gin.Context) {
@@ -514,6 +527,11 @@ func (a *LoadCSVApi) LoadCharacterClass(ctx *gin.Context) {
 			characterClass.TraditionID = &tradition.ID
 		}
 
+		if len(record) > 14 && record[14] != "" {
+			keyAbility := model.Ability(record[14])
+			characterClass.KeyAbility = &keyAbility
+		}
+
 		characterClasses = append(characterClasses, characterClass)
 		if existCharacterClass, err := a.DB.GetCharacterClassByName(characterClass.Name); err == nil && existCharacterClass != nil {
 			continue
@@ -551,7 +569,8 @@ func (a *LoadCSVApi) LoadFeat(ctx *gin.Context) {
 			break
 		}
 
-		if len(record) != 8 {
+		// category, class and ancestry columns are optional, older files have 8 columns
+		if len(record) != 8 && len(record) != 11 {
 			log.Printf("Wrong record count %v", record)
 			continue
 		}
@@ -582,6 +601,20 @@ func (a *LoadCSVApi) LoadFeat(ctx *gin.Context) {
 			feat.PrerequisiteMastery = model.MasteryLevel(record[4])
 		}
 
+		if len(record) == 11 {
+			feat.Category = model.FeatCategory(record[8])
+			if record[9] != "" {
+				if characterClass, err := a.DB.GetCharacterClassByName(record[9]); err == nil && characterClass != nil {
+					feat.CharacterClassID = &characterClass.ID
+				}
+			}
+			if record[10] != "" {
+				if race, err := a.DB.GetRaceByName(record[10]); err == nil && race != nil {
+					feat.RaceID = &race.ID
+				}
+			}
+		}
+
 		if traits != nil {
 			featTraits, err := a.DB.FindTraits(traits)
 			if err != nil {
@@ -600,6 +633,27 @@ func (a *LoadCSVApi) LoadFeat(ctx *gin.Context) {
 			return
 		}
 	}
+
+	// prerequisite feats may come later in the file, so they are linked once all feats exist
+	for _, feat := range feats {
+		var prerequisites []model.Feat
+		for _, name := range rules.PrerequisiteFeatNames(*feat.PrerequisiteFeat) {
+			if prerequisite, err := a.DB.GetFeatByName(name); err == nil && prerequisite != nil {
+				prerequisites = append(prerequisites, *prerequisite)
+			}
+		}
+		if len(prerequisites) == 0 {
+			continue
+		}
+		existFeat, err := a.DB.GetFeatByName(feat.Name)
+		if err != nil || existFeat == nil {
+			continue
+		}
+		if err := a.DB.SetFeatPrerequisites(existFeat, prerequisites); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
 }
 
 func (a *LoadCSVApi) LoadBackground(ctx *gin.Context) {
@@ -651,6 +705,11 @@ func (a *LoadCSVApi) LoadBackground(ctx *gin.Context) {
 		if secondSkill != nil {
 			background.SecondSkillID = &secondSkill.ID
 		}
+		if len(record) > 5 && record[5] != "" {
+			if name, err := rules.LoreName(record[5]); err == nil {
+				background.LoreSkill = name
+			}
+		}
 
 		backgrounds = append(backgrounds, background)
 		if existBackground, err := a.DB.GetBackgroundByName(background.Name); err == nil && existBackground != nil {
@@ -664,6 +723,269 @@ func (a *LoadCSVApi) LoadBackground(ctx *gin.Context) {
 	}
 }
 
+func (a *LoadCSVApi) LoadCondition(ctx *gin.Context) {
+	file, err := os.Open("./csv/Condition.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}(file)
+	reader := csv.NewReader(file)
+	reader.Comma = ';'
+
+	if _, err := reader.Read(); err != nil {
+		log.Fatal(err)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		valued, _ := strconv.ParseBool(record[2])
+		decrement, _ := strconv.ParseBool(record[3])
+		allChecks, _ := strconv.ParseBool(record[6])
+		armorClass, _ := strconv.ParseUint(record[7], 10, 8)
+		condition := model.Condition{
+			Name:        record[0],
+			Description: record[1],
+			Valued:      valued,
+			Decrement:   decrement,
+			PenaltyType: record[4],
+			AllChecks:   allChecks,
+			ArmorClass:  uint8(armorClass),
+		}
+		// abilities are separated by commas, like Intelligence, Wisdom, Charisma
+		for _, ability := range strings.Split(record[5], ",") {
+			if ability = strings.TrimSpace(ability); ability != "" {
+				condition.Abilities = append(condition.Abilities, model.ConditionAbility{Ability: model.Ability(ability)})
+			}
+		}
+
+		if existCondition, err := a.DB.GetConditionByName(condition.Name); err == nil && existCondition != nil {
+			continue
+		}
+		err = a.DB.CreateCondition(&condition)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+}
+
+// LoadCreature reads the bestiary, defences are lists like "fire 5, cold 5" and strikes are
+// separated by " / " with fields Name|melee or ranged|attack bonus|damage|traits
+func (a *LoadCSVApi) LoadCreature(ctx *gin.Context) {
+	file, err := os.Open("./csv/Creature.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}(file)
+	reader := csv.NewReader(file)
+	reader.Comma = ';'
+
+	if _, err := reader.Read(); err != nil {
+		log.Fatal(err)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(record) != 23 {
+			log.Printf("Wrong record count %v", record)
+			continue
+		}
+
+		if existCreature, err := a.DB.GetCreatureByName(record[0]); err == nil && existCreature != nil {
+			continue
+		}
+		number := func(i int) int {
+			value, _ := strconv.Atoi(strings.TrimPrefix(record[i], "+"))
+			return value
+		}
+		creature := model.Creature{
+			Name:        record[0],
+			Description: record[1],
+			Level:       int8(number(2)),
+			Rarity:      model.Rarity(record[3]),
+			Size:        model.SquareSize(record[4]),
+			Perception:  int16(number(6)),
+			ArmorClass:  uint8(number(7)),
+			Fortitude:   int16(number(8)),
+			Reflex:      int16(number(9)),
+			Will:        int16(number(10)),
+			HitPoint:    uint16(number(11)),
+			Speed:       uint8(number(12)),
+			FlySpeed:    uint8(number(13)),
+			SwimSpeed:   uint8(number(14)),
+			ClimbSpeed:  uint8(number(15)),
+			BurrowSpeed: uint8(number(16)),
+			Language:    record[17],
+		}
+
+		if record[5] != "" {
+			if traits := a.GetTraits(ctx, record[5]); traits != nil {
+				creatureTraits, err := a.DB.FindTraits(traits)
+				if err != nil {
+					log.Fatal(err)
+				}
+				creature.Traits = creatureTraits
+			}
+		}
+
+		for i, kind := range []string{model.Immunity, model.Resistance, model.Weakness} {
+			for _, part := range splitList(record[18+i], ", ") {
+				name, value := part, 0
+				if index := strings.LastIndex(part, " "); index > 0 && kind != model.Immunity {
+					name = part[:index]
+					value, _ = strconv.Atoi(part[index+1:])
+				}
+				irw, err := a.DB.GetImmunityResistanceWeakness(name)
+				if err != nil {
+					ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+				creature.Defences = append(creature.Defences, model.CreatureDefence{
+					ImmunityResistanceWeaknessID: irw.ID,
+					Kind:                         kind,
+					Value:                        uint16(value),
+				})
+			}
+		}
+
+		for _, part := range splitList(record[21], " / ") {
+			fields := strings.Split(part, "|")
+			if len(fields) != 5 {
+				log.Printf("Wrong strike %v of %v", part, creature.Name)
+				continue
+			}
+			attackBonus, _ := strconv.Atoi(strings.TrimPrefix(fields[2], "+"))
+			creature.Strikes = append(creature.Strikes, model.CreatureStrike{
+				Name:        fields[0],
+				Ranged:      fields[1] == "ranged",
+				AttackBonus: int16(attackBonus),
+				Damage:      fields[3],
+				Traits:      fields[4],
+			})
+		}
+
+		for _, name := range splitList(record[22], ", ") {
+			spell, err := a.DB.GetSpellByName(name)
+			if err != nil || spell == nil {
+				log.Printf("Unknown spell %v of %v", name, creature.Name)
+				continue
+			}
+			creature.Spells = append(creature.Spells, *spell)
+		}
+
+		err = a.DB.CreateCreature(&creature)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+}
+
+// LoadArmor reads Name;Description;Bulk;Level;Price;ArmorClass;Category;DexCap;CheckPenalty;SpeedPenalty;
+// Strength;Group;Traits, an empty Dex cap means the armor has none, the file is optional
+func (a *LoadCSVApi) LoadArmor(ctx *gin.Context) {
+	file, err := os.Open("./csv/Armor.csv")
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}(file)
+	reader := csv.NewReader(file)
+	reader.Comma = ';'
+
+	if _, err := reader.Read(); err != nil {
+		log.Fatal(err)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if existItem, err := a.DB.GetItemByName(record[0], "armors"); err == nil && existItem != nil {
+			continue
+		}
+
+		level, _ := strconv.Atoi(record[3])
+		armorClass, _ := strconv.Atoi(record[5])
+		checkPenalty, _ := strconv.Atoi(record[8])
+		speedPenalty, _ := strconv.Atoi(record[9])
+		strength, _ := strconv.Atoi(record[10])
+
+		armor := model.Armor{
+			ArmorClass:   uint8(armorClass),
+			Category:     model.ArmorCategory(record[6]),
+			CheckPenalty: uint8(checkPenalty),
+			SpeedPenalty: uint8(speedPenalty),
+			Strength:     uint8(strength),
+			ArmorGroup:   record[11],
+		}
+		if value, err := strconv.Atoi(record[7]); err == nil {
+			dexCap := uint8(value)
+			armor.DexCap = &dexCap
+		}
+		if record[12] != "" {
+			if traits := a.GetTraits(ctx, record[12]); traits != nil {
+				armorTraits, err := a.DB.FindTraits(traits)
+				if err != nil {
+					log.Fatal(err)
+				}
+				armor.Traits = armorTraits
+			}
+		}
+
+		item := model.Item{
+			Name:        record[0],
+			Description: record[1],
+			Bulk:        parseBulk(record[2]),
+			Level:       uint8(level),
+			Price:       record[4],
+			OwnerType:   "armors",
+		}
+		if item.PriceCopper, err = rules.ParsePrice(item.Price); err != nil {
+			log.Println(err)
+		}
+		err = a.DB.CreateArmor(&armor, &item)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+}
+
 func (a *LoadCSVApi) GetTraits(ctx *gin.Context, traits string) []uint {
 	parts := strings.Split(traits, ", ")
 	var traitsID []uint
@@ -697,3 +1019,23 @@ func (a *LoadCSVApi) GetTraditions(ctx *gin.Context, traditions string) []uint {
 	}
 	return traditionsID
 }
+
+func splitList(value string, separator string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return strings.Split(value, separator)
+}
+
+// parseBulk reads a Bulk value where L is light and a dash is negligible
+func parseBulk(value string) float64 {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "L") {
+		return 1.0 / rules.LightPerBulk
+	}
+	bulk, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return bulk
+}
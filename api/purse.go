@@ -0,0 +1,232 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+)
+
+// GetPurse godoc
+//
+// @Summary Returns coins of Character
+// @Description Permissions for Character's User or Admin, the total is in copper pieces
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Success 200 {object} model.PurseExternal "character purse"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/purse [get]
+func (a *CharacterApi) GetPurse(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalPurse(character))
+	})
+}
+
+// UpdatePurse godoc
+//
+// @Summary Sets coins of Character
+// @Description Permissions for Character's User or Admin
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param purse body model.Purse true "Coins"
+// @Success 200 {object} model.PurseExternal "character purse"
+// @Failure 400 {string} string "Wrong coins"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/purse [put]
+func (a *CharacterApi) UpdatePurse(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		purse := &model.Purse{}
+		if err := ctx.ShouldBindJSON(purse); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		character.Purse = *purse
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdatePurse(character)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalPurse(character))
+	})
+}
+
+// BuyItem godoc
+//
+// @Summary Buys an item for Character
+// @Description Permissions for Character's User or Admin, the price is paid with the smallest coins first
+// @Description and change is given back, a plain stack of the item the character has already gets more quantity,
+// @Description a runed, charged or damaged one stays apart and a new instance is created
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param buy body model.BuyItem true "Item to buy"
+// @Success 200 {object} model.TradeExternal "purse and item"
+// @Failure 400 {string} string "Not enough coins, item has no price or isn't for sale"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/buy [post]
+func (a *CharacterApi) BuyItem(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		buy := &model.BuyItem{}
+		if err := ctx.ShouldBindJSON(buy); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if buy.Quantity == 0 {
+			buy.Quantity = 1
+		}
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		item, err := a.DB.GetItemByID(buy.ItemID)
+		if err != nil || item == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item not found"})
+			return
+		}
+		if rules.NotForSale(item.Price) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item isn't for sale"})
+			return
+		}
+		if item.PriceCopper == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item has no price"})
+			return
+		}
+		price, err := rules.TotalPrice(*item.PriceCopper, buy.Quantity)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if character.Purse, err = rules.Pay(character.Purse, price); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Not enough coins, " + err.Error()})
+			return
+		}
+
+		items, err := a.DB.GetCharacterItems(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		var characterItem *model.CharacterItem
+		for _, owned := range items {
+			if owned.ItemID == item.ID && rules.PlainItem(owned) {
+				characterItem = owned
+				characterItem.Quantity += buy.Quantity
+				break
+			}
+		}
+		if characterItem == nil {
+			characterItem = &model.CharacterItem{
+				CharacterID: id,
+				ItemID:      item.ID,
+				Quantity:    buy.Quantity,
+				Placement:   buy.Placement,
+				ContainerID: buy.ContainerID,
+			}
+			if !placeItem(ctx, a.DB, characterItem, item) {
+				return
+			}
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.BuyCharacterItem(character, characterItem)); !success {
+			return
+		}
+		a.respondTrade(ctx, character, price, characterItem.ID)
+	})
+}
+
+// SellItem godoc
+//
+// @Summary Sells an item of Character
+// @Description Permissions for Character's User or Admin, items sell for half their price,
+// @Description an item sold out is removed from the inventory
+// @Tags Character
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param sell body model.SellItem true "Item to sell"
+// @Success 200 {object} model.TradeExternal "purse and item"
+// @Failure 400 {string} string "Not enough items or item has no price"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/sell [post]
+func (a *CharacterApi) SellItem(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		sell := &model.SellItem{}
+		if err := ctx.ShouldBindJSON(sell); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if sell.Quantity == 0 {
+			sell.Quantity = 1
+		}
+		character, err := a.DB.GetCharacterByID(id)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		characterItem, err := a.DB.GetCharacterItemByID(sell.CharacterItemID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if characterItem == nil || characterItem.CharacterID != id {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item doesn't belong to the character"})
+			return
+		}
+		if sell.Quantity > characterItem.Quantity {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Not enough items"})
+			return
+		}
+		if characterItem.Item.PriceCopper == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Item has no price"})
+			return
+		}
+		price := rules.SellPrice(*characterItem.Item.PriceCopper, sell.Quantity)
+		character.Purse = rules.Receive(character.Purse, price)
+		characterItem.Quantity -= sell.Quantity
+		if success := SuccessOrAbort(ctx, 500, a.DB.SellCharacterItem(character, characterItem)); !success {
+			return
+		}
+		a.respondTrade(ctx, character, price, characterItem.ID)
+	})
+}
+
+// respondTrade recomputes the encumbrance and responds with the purse and what is left of the item
+func (a *CharacterApi) respondTrade(ctx *gin.Context, character *model.Character, price uint, characterItemID uint) {
+	if _, err := syncEncumbrance(a.DB, character.ID); err != nil {
+		SuccessOrAbort(ctx, 500, err)
+		return
+	}
+	resp := &model.TradeExternal{Price: price, Purse: *ToExternalPurse(character)}
+	characterItem, err := a.DB.GetCharacterItemByID(characterItemID)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	if characterItem != nil {
+		resp.CharacterItem = ToExternalCharacterItem(characterItem, &characterItem.Character, &characterItem.Item)
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func ToExternalPurse(character *model.Character) *model.PurseExternal {
+	total := rules.PurseValue(character.Purse)
+	return &model.PurseExternal{
+		CharacterID: character.ID,
+		Copper:      character.Purse.Copper,
+		Silver:      character.Purse.Silver,
+		Gold:        character.Purse.Gold,
+		Platinum:    character.Purse.Platinum,
+		Total:       total,
+		Value:       rules.FormatPrice(total),
+	}
+}
@@ -0,0 +1,336 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"kingdom/auth"
+	"kingdom/dice"
+	"kingdom/model"
+	"kingdom/rules"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type DiceApi struct {
+	Roller *dice.Roller
+}
+
+// Roll godoc
+//
+// @Summary Rolls dice notation
+// @Description Notation like 2d8+4, 4d6kh3 or 1d20+12, with a DC the degree of success is returned
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param roll body model.RollDice true "Roll data"
+// @Success 200 {object} model.RollExternal "roll result"
+// @Failure 400 {string} string "Wrong dice notation"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /roll [post]
+func (a *DiceApi) Roll(ctx *gin.Context) {
+	roll := &model.RollDice{}
+	if err := ctx.ShouldBindJSON(roll); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result, err := a.Roller.RollNotation(roll.Notation, dice.ModeOf(roll.Fortune, roll.Misfortune))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, ToExternalRoll(newRoll(result, roll.DC)))
+}
+
+// RollSkill godoc
+//
+// @Summary Rolls skill check of Character
+// @Description Uses the derived skill modifier, Perception is rolled as a skill
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param roll body model.RollCheck true "Skill check"
+// @Success 200 {object} model.RollExternal "roll result"
+// @Failure 400 {string} string "Unknown skill"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/roll/skill [post]
+func (a *CharacterApi) RollSkill(ctx *gin.Context) {
+	a.rollCheck(ctx, model.RollSkill, func(stats *model.CharacterStatsExternal) []model.Statistic {
+		return append(stats.Skills, stats.Perception)
+	})
+}
+
+// RollRecallKnowledge godoc
+//
+// @Summary Rolls Recall Knowledge check of Character
+// @Description Uses the derived modifier of a knowledge skill, Arcana, Crafting, Medicine, Nature, Occultism,
+// @Description Religion, Society or any Lore skill of the character
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param roll body model.RollCheck true "Recall Knowledge check"
+// @Success 200 {object} model.RollExternal "roll result"
+// @Failure 400 {string} string "Unknown recall-knowledge skill"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/roll/recall-knowledge [post]
+func (a *CharacterApi) RollRecallKnowledge(ctx *gin.Context) {
+	a.rollCheck(ctx, model.RollRecallKnowledge, func(stats *model.CharacterStatsExternal) []model.Statistic {
+		var skills []model.Statistic
+		for _, skill := range stats.Skills {
+			if rules.IsRecallKnowledgeSkill(skill.Name) {
+				skills = append(skills, skill)
+			}
+		}
+		return skills
+	})
+}
+
+// RollSave godoc
+//
+// @Summary Rolls saving throw of Character
+// @Description Uses the derived Fortitude, Reflex or Will modifier
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param roll body model.RollCheck true "Saving throw"
+// @Success 200 {object} model.RollExternal "roll result"
+// @Failure 400 {string} string "Unknown save"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/roll/save [post]
+func (a *CharacterApi) RollSave(ctx *gin.Context) {
+	a.rollCheck(ctx, model.RollSave, func(stats *model.CharacterStatsExternal) []model.Statistic {
+		return []model.Statistic{stats.Fortitude, stats.Reflex, stats.Will}
+	})
+}
+
+// RollStrike godoc
+//
+// @Summary Rolls strike of Character
+// @Description Attack with the fist or an equipped weapon including MAP of the attack, damage is rolled
+// @Description on a hit and doubled on a critical hit
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param roll body model.RollStrikeCreate true "Strike"
+// @Success 200 {object} model.StrikeRollExternal "attack and damage"
+// @Failure 400 {string} string "Weapon isn't equipped"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Character not found"
+// @Router /character/{id}/roll/strike [post]
+func (a *CharacterApi) RollStrike(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		roll := &model.RollStrikeCreate{}
+		if err := ctx.ShouldBindJSON(roll); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		character, sheet := a.rollSheet(ctx, id)
+		if sheet == nil {
+			return
+		}
+		weapons, err := equippedWeapons(a.DB, character.ID)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		strike := findStrike(sheet, weapons, roll)
+		if strike == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Weapon isn't equipped"})
+			return
+		}
+
+		attack := strike.Attacks[max(roll.Attack, 1)-1]
+		result, err := a.Roller.RollNotation(checkNotation(attack.Value), dice.ModeOf(roll.Fortune, roll.Misfortune))
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		attackRoll := newRoll(result, roll.DC)
+		attackRoll.Kind = model.RollStrike
+		attackRoll.Name = strike.Name
+		rolls := []*model.CharacterRoll{attackRoll}
+
+		degree := dice.Degree(attackRoll.Degree)
+		if roll.DC == nil || degree == dice.Success || degree == dice.CriticalSuccess {
+			damage, err := a.Roller.RollNotation(strings.Fields(strike.Damage)[0], dice.Normal)
+			if success := SuccessOrAbort(ctx, 500, err); !success {
+				return
+			}
+			damageRoll := newRoll(damage, nil)
+			damageRoll.Kind = model.RollDamage
+			damageRoll.Name = strike.Damage
+			// property runes add their own dice, doubled with the rest on a critical hit
+			for _, extra := range strike.ExtraDamage {
+				extraDamage, err := a.Roller.RollNotation(strings.Fields(extra)[0], dice.Normal)
+				if success := SuccessOrAbort(ctx, 500, err); !success {
+					return
+				}
+				damageRoll.Total += extraDamage.Total
+				damageRoll.Detail += " + " + extraDamage.String()
+				damageRoll.Name += " + " + extra
+			}
+			if degree == dice.CriticalSuccess {
+				damageRoll.Total *= 2
+				damageRoll.Detail += " x2"
+			}
+			damageRoll.Total = max(damageRoll.Total, 1)
+			rolls = append(rolls, damageRoll)
+		}
+		if !a.saveRolls(ctx, character.ID, rolls) {
+			return
+		}
+
+		resp := &model.StrikeRollExternal{Attack: *ToExternalRoll(attackRoll)}
+		if len(rolls) > 1 {
+			resp.Damage = ToExternalRoll(rolls[1])
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// GetCharacterRolls godoc
+//
+// @Summary Returns roll history of Character
+// @Description Checks, strikes and damage rolled for the character, the latest first
+// @Tags Roll
+// @Accept json
+// @Produce json
+// @Param id path int true "character id"
+// @Param limit query int false "Limit for pagination"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} []model.RollExternal "roll history"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /character/{id}/rolls [get]
+func (a *CharacterApi) GetCharacterRolls(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+		offset, _ := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+		rolls, err := a.DB.GetCharacterRolls(id, limit, limit*offset)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		resp := []*model.RollExternal{}
+		for _, roll := range rolls {
+			resp = append(resp, ToExternalRoll(roll))
+		}
+		ctx.JSON(http.StatusOK, resp)
+	})
+}
+
+// rollCheck rolls d20 with the modifier of the named statistic and saves it to the history
+func (a *CharacterApi) rollCheck(
+	ctx *gin.Context,
+	kind string,
+	statistics func(stats *model.CharacterStatsExternal) []model.Statistic,
+) {
+	withID(ctx, "id", func(id uint) {
+		roll := &model.RollCheck{}
+		if err := ctx.ShouldBindJSON(roll); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		character, sheet := a.rollSheet(ctx, id)
+		if sheet == nil {
+			return
+		}
+		var statistic *model.Statistic
+		for _, candidate := range statistics(rules.Compute(sheet)) {
+			if strings.EqualFold(candidate.Name, roll.Name) {
+				statistic = &candidate
+			}
+		}
+		if statistic == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Unknown %s %s", kind, roll.Name)})
+			return
+		}
+
+		result, err := a.Roller.RollNotation(checkNotation(statistic.Value), dice.ModeOf(roll.Fortune, roll.Misfortune))
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		characterRoll := newRoll(result, roll.DC)
+		characterRoll.Kind = kind
+		characterRoll.Name = statistic.Name
+		if !a.saveRolls(ctx, character.ID, []*model.CharacterRoll{characterRoll}) {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalRoll(characterRoll))
+	})
+}
+
+func (a *CharacterApi) rollSheet(ctx *gin.Context, id uint) (*model.Character, *rules.Sheet) {
+	character, err := a.DB.GetCharacterByID(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return nil, nil
+	}
+	sheet, err := loadSheet(a.DB, character)
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return nil, nil
+	}
+	return character, sheet
+}
+
+func (a *CharacterApi) saveRolls(ctx *gin.Context, characterID uint, rolls []*model.CharacterRoll) bool {
+	userID := auth.GetUserID(ctx)
+	for _, roll := range rolls {
+		roll.CharacterID = characterID
+		roll.UserID = userID
+	}
+	return SuccessOrAbort(ctx, 500, a.DB.CreateCharacterRolls(rolls...))
+}
+
+// findStrike returns the strike of the weapon, the fist when no weapon is given
+func findStrike(sheet *rules.Sheet, weapons []*rules.Weapon, roll *model.RollStrikeCreate) *model.StrikeExternal {
+	for _, weapon := range weapons {
+		if (roll.CharacterItemID == nil) != (weapon.CharacterItemID == nil) {
+			continue
+		}
+		if roll.CharacterItemID != nil && *roll.CharacterItemID != *weapon.CharacterItemID {
+			continue
+		}
+		for _, strike := range rules.Strikes(sheet, weapon) {
+			if roll.Kind == "" || roll.Kind == strike.Kind {
+				return &strike
+			}
+		}
+	}
+	return nil
+}
+
+func checkNotation(modifier int) string {
+	return fmt.Sprintf("1d20%+d", modifier)
+}
+
+func newRoll(result *dice.Result, dc *int) *model.CharacterRoll {
+	roll := &model.CharacterRoll{
+		Notation: result.Notation,
+		Detail:   result.String(),
+		Total:    result.Total,
+		DC:       dc,
+	}
+	if dc != nil {
+		roll.Degree = string(dice.DegreeOf(result.Total, result.Natural(), *dc))
+	}
+	return roll
+}
+
+func ToExternalRoll(roll *model.CharacterRoll) *model.RollExternal {
+	return &model.RollExternal{
+		ID:          roll.ID,
+		CharacterID: roll.CharacterID,
+		Kind:        roll.Kind,
+		Name:        roll.Name,
+		Notation:    roll.Notation,
+		Detail:      roll.Detail,
+		Total:       roll.Total,
+		DC:          roll.DC,
+		Degree:      roll.Degree,
+		CreatedAt:   roll.CreatedAt,
+	}
+}
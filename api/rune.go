@@ -0,0 +1,184 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+type RuneDatabase interface {
+	CreateRune(propertyRune *model.Rune) error
+	GetRuneByID(id uint) (*model.Rune, error)
+	GetRunes() ([]*model.Rune, error)
+	UpdateRune(propertyRune *model.Rune) error
+	DeleteRune(id uint) error
+}
+
+type RuneApi struct {
+	DB RuneDatabase
+}
+
+// CreateRune godoc
+//
+// @Summary Create and returns Rune or nil
+// @Description Permissions for Admin, property runes with damage dice add extra damage to strikes
+// @Tags Rune
+// @Accept json
+// @Produce json
+// @Param rune body model.CreateRune true "Rune data"
+// @Success 201 {object} model.RuneExternal "Rune details"
+// @Failure 400 {string} string "Wrong rune data"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /rune [post]
+func (a *RuneApi) CreateRune(ctx *gin.Context) {
+	propertyRune := &model.CreateRune{}
+	if err := ctx.ShouldBindJSON(propertyRune); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	internal := toInternalRune(propertyRune)
+	if success := SuccessOrAbort(ctx, 500, a.DB.CreateRune(internal)); !success {
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToExternalRune(internal))
+}
+
+// GetRuneByID godoc
+//
+// @Summary Returns Rune by id
+// @Description Retrieve Rune details using its ID
+// @Tags Rune
+// @Accept json
+// @Produce json
+// @Param id path int true "Rune id"
+// @Success 200 {object} model.RuneExternal "Rune details"
+// @Failure 404 {string} string "Rune not found"
+// @Router /rune/{id} [get]
+func (a *RuneApi) GetRuneByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		propertyRune, err := a.DB.GetRuneByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if propertyRune == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Rune not found"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalRune(propertyRune))
+	})
+}
+
+// GetRunes godoc
+//
+// @Summary Returns all Runes
+// @Description Return all Runes
+// @Tags Rune
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.RuneExternal "Rune details"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /rune [get]
+func (a *RuneApi) GetRunes(ctx *gin.Context) {
+	runes, err := a.DB.GetRunes()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.RuneExternal{}
+	for _, propertyRune := range runes {
+		resp = append(resp, ToExternalRune(propertyRune))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateRune Updates Rune by ID
+//
+// @Summary Updates Rune by ID or nil
+// @Description Permissions for Admin
+// @Tags Rune
+// @Accept json
+// @Produce json
+// @Param id path int true "Rune id"
+// @Param rune body model.CreateRune true "Rune data"
+// @Success 200 {object} model.RuneExternal "Rune details"
+// @Failure 400 {string} string "Wrong rune data"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Rune doesn't exist"
+// @Router /rune/{id} [patch]
+func (a *RuneApi) UpdateRune(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		propertyRune := &model.CreateRune{}
+		if err := ctx.ShouldBindJSON(propertyRune); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldRune, err := a.DB.GetRuneByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldRune == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Rune doesn't exist"})
+			return
+		}
+		internal := toInternalRune(propertyRune)
+		internal.ID = oldRune.ID
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateRune(internal)); !success {
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalRune(internal))
+	})
+}
+
+// DeleteRune Deletes Rune by ID
+//
+// @Summary Deletes Rune by ID or returns nil
+// @Description Permissions for Admin, the rune is removed from the items it was etched on
+// @Tags Rune
+// @Accept json
+// @Produce json
+// @Param id path int true "Rune id"
+// @Success 204
+// @Failure 404 {string} string "Rune doesn't exist"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /rune/{id} [delete]
+func (a *RuneApi) DeleteRune(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		propertyRune, err := a.DB.GetRuneByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if propertyRune == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Rune doesn't exist"})
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.DeleteRune(id)); !success {
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+}
+
+func toInternalRune(propertyRune *model.CreateRune) *model.Rune {
+	return &model.Rune{
+		Name:        propertyRune.Name,
+		Description: propertyRune.Description,
+		Target:      propertyRune.Target,
+		Level:       propertyRune.Level,
+		Price:       propertyRune.Price,
+		DamageDice:  propertyRune.DamageDice,
+		DamageType:  propertyRune.DamageType,
+	}
+}
+
+func ToExternalRune(propertyRune *model.Rune) *model.RuneExternal {
+	return &model.RuneExternal{
+		ID:          propertyRune.ID,
+		Name:        propertyRune.Name,
+		Description: propertyRune.Description,
+		Target:      propertyRune.Target,
+		Level:       propertyRune.Level,
+		Price:       propertyRune.Price,
+		DamageDice:  propertyRune.DamageDice,
+		DamageType:  propertyRune.DamageType,
+	}
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+)
+
+// CreateShield godoc
+//
+// @Summary Create and returns Shield or nil
+// @Description Permissions for Admin, the AC bonus applies while the shield is raised
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param shield body model.CreateShield true "Shield data"
+// @Success 201 {object} model.ShieldExternal "Shield details"
+// @Failure 400 {string} string "Wrong shield data"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "You can't access for this API"
+// @Router /item/shield [post]
+func (a *ItemApi) CreateShield(ctx *gin.Context) {
+	shield := &model.CreateShield{}
+	if err := ctx.ShouldBindJSON(shield); err == nil {
+		if shield.BrokenThreshold >= shield.HitPoint {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "broken_threshold must be below hit_point"})
+			return
+		}
+		internalShield := &model.Shield{
+			ArmorClass:      2,
+			Hardness:        shield.Hardness,
+			HitPoint:        shield.HitPoint,
+			BrokenThreshold: shield.BrokenThreshold,
+		}
+		if shield.ArmorClass != nil {
+			internalShield.ArmorClass = *shield.ArmorClass
+		}
+		internalItem := &model.Item{
+			Name:        shield.Name,
+			Description: shield.Description,
+			Bulk:        shield.Bulk,
+			Price:       shield.Price,
+			OwnerType:   "shields",
+		}
+		if shield.Level != nil {
+			internalItem.Level = *shield.Level
+		}
+		if !priceItem(ctx, internalItem) {
+			return
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.CreateShield(internalShield, internalItem)); !success {
+			return
+		}
+		ctx.JSON(http.StatusCreated, ToExternalShield(internalShield, internalItem))
+	} else {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
+
+// GetShields godoc
+//
+// @Summary Returns all shields
+// @Description Return all shields
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.ShieldExternal "Shield details"
+// @Failure 401 {string} string ""Unauthorized"
+// @Router /item/shield [get]
+func (a *ItemApi) GetShields(ctx *gin.Context) {
+	shields, err := a.DB.GetShields()
+	if success := SuccessOrAbort(ctx, 500, err); !success {
+		return
+	}
+	resp := []*model.ShieldExternal{}
+	for _, shield := range shields {
+		resp = append(resp, ToExternalShield(shield, &shield.Item))
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetShieldByID godoc
+//
+// @Summary Returns Shield by ID
+// @Description Permissions for auth users
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param id path int true "Shield id"
+// @Success 200 {object} model.ShieldExternal "Shield details"
+// @Failure 401 {string} string ""Unauthorized"
+// @Failure 404 {string} string "Shield doesn't exist"
+// @Router /item/shield/{id} [get]
+func (a *ItemApi) GetShieldByID(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		shield, err := a.DB.GetShieldByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if shield == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Shield doesn't exist"})
+			return
+		}
+		ctx.JSON(http.StatusOK, ToExternalShield(shield, &shield.Item))
+	})
+}
+
+// UpdateShield Updates Shield by ID
+//
+// @Summary Updates Shield by ID or nil
+// @Description Permissions for Admin, omitted values are kept
+// @Tags Item
+// @Accept json
+// @Produce json
+// @Param id path int true "Shield id"
+// @Param shield body model.UpdateShield true "Shield data"
+// @Success 200 {object} model.ShieldExternal "Shield details"
+// @Failure 400 {string} string "Wrong shield data"
+// @Failure 403 {string} string "You can't access for this API"
+// @Failure 404 {string} string "Shield doesn't exist"
+// @Router /item/shield/{id} [patch]
+func (a *ItemApi) UpdateShield(ctx *gin.Context) {
+	withID(ctx, "id", func(id uint) {
+		var shield model.UpdateShield
+		if err := ctx.ShouldBindJSON(&shield); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		oldShield, err := a.DB.GetShieldByID(id)
+		if success := SuccessOrAbort(ctx, 500, err); !success {
+			return
+		}
+		if oldShield == nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Shield doesn't exist"})
+			return
+		}
+		internalShield := &model.Shield{
+			ID:              oldShield.ID,
+			ArmorClass:      oldShield.ArmorClass,
+			Hardness:        oldShield.Hardness,
+			HitPoint:        oldShield.HitPoint,
+			BrokenThreshold: oldShield.BrokenThreshold,
+		}
+		if shield.ArmorClass != nil {
+			internalShield.ArmorClass = *shield.ArmorClass
+		}
+		if shield.Hardness != nil {
+			internalShield.Hardness = *shield.Hardness
+		}
+		if shield.HitPoint != nil {
+			internalShield.HitPoint = *shield.HitPoint
+		}
+		if shield.BrokenThreshold != nil {
+			internalShield.BrokenThreshold = *shield.BrokenThreshold
+		}
+		if internalShield.BrokenThreshold >= internalShield.HitPoint {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "broken_threshold must be below hit_point"})
+			return
+		}
+		internalItem := &model.Item{
+			ID:    oldShield.Item.ID,
+			Level: oldShield.Item.Level,
+		}
+		if shield.Level != nil {
+			internalItem.Level = *shield.Level
+		}
+		if success := SuccessOrAbort(ctx, 500, a.DB.UpdateShield(internalShield, internalItem)); !success {
+			return
+		}
+		newShield, _ := a.DB.GetShieldByID(id)
+		ctx.JSON(http.StatusOK, ToExternalShield(newShield, &newShield.Item))
+	})
+}
+
+func ToExternalShield(shield *model.Shield, item *model.Item) *model.ShieldExternal {
+	return &model.ShieldExternal{
+		ID:              shield.ID,
+		Name:            item.Name,
+		Description:     item.Description,
+		Bulk:            item.Bulk,
+		Level:           item.Level,
+		Price:           item.Price,
+		ArmorClass:      shield.ArmorClass,
+		Hardness:        shield.Hardness,
+		HitPoint:        shield.HitPoint,
+		BrokenThreshold: shield.BrokenThreshold,
+		ItemID:          item.ID,
+	}
+}
@@ -44,6 +44,7 @@ type UserApi struct {
 // @Accept json
 // @Produce json
 // @Success 200 {object} model.UserExternal "User current"
+// @Failure 401 {string} string "User not found"
 // @Failure 500
 // @Router /user/current [get]
 func (a *UserApi) GetCurrentUser(ctx *gin.Context) {
@@ -51,6 +52,10 @@ func (a *UserApi) GetCurrentUser(ctx *gin.Context) {
 	if success := SuccessOrAbort(ctx, 500, err); !success {
 		return
 	}
+	if user == nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
 	ctx.JSON(200, toExternalUser(user))
 }
 
@@ -188,7 +193,11 @@ func (a *UserApi) UpdateUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
-	currentUser, _ := a.DB.GetUserByID(currentUserID.(uint))
+	currentUser, err := a.DB.GetUserByID(currentUserID.(uint))
+	if err != nil || currentUser == nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
 	if !currentUser.Admin {
 		ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
 		return
@@ -248,7 +257,11 @@ func (a *UserApi) DeleteUserByID(ctx *gin.Context) {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
-	currentUser, _ := a.DB.GetUserByID(currentUserID.(uint))
+	currentUser, err := a.DB.GetUserByID(currentUserID.(uint))
+	if err != nil || currentUser == nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
 	if !currentUser.Admin {
 		ctx.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
 		return
@@ -294,6 +307,10 @@ func (a *UserApi) ChangePassword(ctx *gin.Context) {
 		if success := SuccessOrAbort(ctx, 500, err); !success {
 			return
 		}
+		if user == nil {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			return
+		}
 		user.Password = password.CreatePassword(pw.Password, a.PasswordStrength)
 		SuccessOrAbort(ctx, 500, a.DB.UpdateUser(user))
 	}
@@ -2,8 +2,10 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"kingdom/mode"
+	"kingdom/test"
 	"kingdom/test/testdb"
 	"net/http/httptest"
 	"testing"
@@ -55,3 +57,10 @@ func (s *UserSuite) BeforeTest(suiteName, testName string) {
 //func externalOf(user *model.User) *model.UserExternal {
 //	return &model.UserExternal{Username: user.Username, Admin: user.Admin, ID: user.ID}
 //}
+
+func (s *UserSuite) Test_GetCurrentUser_Deleted() {
+	test.WithUser(s.ctx, 5)
+	s.a.GetCurrentUser(s.ctx)
+
+	assert.Equal(s.T(), 401, s.recorder.Code)
+}
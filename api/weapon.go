@@ -26,6 +26,13 @@ func (a *ItemApi) CreateWeapon(ctx *gin.Context) {
 			DiceQuantity: weapon.DiceQuantity,
 			Damage:       *weapon.Damage,
 			DamageType:   weapon.DamageType,
+			Category:     weapon.Category,
+			Ranged:       weapon.Ranged,
+			Range:        weapon.Range,
+			Agile:        weapon.Agile,
+			Finesse:      weapon.Finesse,
+			Propulsive:   weapon.Propulsive,
+			Thrown:       weapon.Thrown,
 		}
 		internalItem := &model.Item{
 			Name:        weapon.Name,
@@ -35,6 +42,9 @@ func (a *ItemApi) CreateWeapon(ctx *gin.Context) {
 			Price:       weapon.Price,
 			OwnerType:   "weapons",
 		}
+		if !priceItem(ctx, internalItem) {
+			return
+		}
 		if success := SuccessOrAbort(ctx, 500, a.DB.CreateWeapon(internalWeapon, internalItem)); !success {
 			ctx.JSON(http.StatusInternalServerError, success)
 			return
@@ -117,8 +127,18 @@ func (a *ItemApi) UpdateWeapon(ctx *gin.Context) {
 				DiceQuantity: weapon.DiceQuantity,
 				Damage:       *weapon.Damage,
 				DamageType:   weapon.DamageType,
+				Category:     weapon.Category,
+				Ranged:       weapon.Ranged,
+				Range:        weapon.Range,
+				Agile:        weapon.Agile,
+				Finesse:      weapon.Finesse,
+				Propulsive:   weapon.Propulsive,
+				Thrown:       weapon.Thrown,
 				ID:           oldWeapon.ID,
 			}
+			if internalWeapon.Category == "" {
+				internalWeapon.Category = oldWeapon.Category
+			}
 			internalItem := &model.Item{
 				ID:          oldWeapon.Item.ID,
 				Name:        weapon.Name,
@@ -149,6 +169,13 @@ func ToExternalWeapon(weapon *model.Weapon, item *model.Item) *model.WeaponExter
 		DiceQuantity: weapon.DiceQuantity,
 		Damage:       weapon.Damage,
 		DamageType:   weapon.DamageType,
+		Category:     weapon.Category,
+		Ranged:       weapon.Ranged,
+		Range:        weapon.Range,
+		Agile:        weapon.Agile,
+		Finesse:      weapon.Finesse,
+		Propulsive:   weapon.Propulsive,
+		Thrown:       weapon.Thrown,
 		ItemID:       item.ID,
 	}
 }
@@ -19,6 +19,9 @@ type Database interface {
 	GetUserByUsername(name string) (*model.User, error)
 	GetUserByID(id uint) (*model.User, error)
 	GetUserByToken(token string) (*model.User, error)
+	GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error)
+	IsCharacterGameMaster(characterID uint, userID uint) (bool, error)
+	GetCampaignByID(id uint) (*model.Campaign, error)
 }
 
 type Auth struct {
@@ -208,7 +211,12 @@ func (a *Auth) RequireAdmin(c *gin.Context) {
 		c.Abort()
 		return
 	}
-	user, _ := a.DB.GetUserByID(GetUserID(c))
+	user, err := a.DB.GetUserByID(GetUserID(c))
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		c.Abort()
+		return
+	}
 	if !user.Admin {
 		c.JSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
 		c.Abort()
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+	"strconv"
+)
+
+// RequireGameMaster allows the request only for the Game Master of the campaign
+// behind the path parameter or for admin
+func (a *Auth) RequireGameMaster(param string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param(param), 10, 32)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+		campaign, err := a.DB.GetCampaignByID(uint(id))
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if campaign == nil {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Campaign not found"})
+			return
+		}
+		if userID := TryGetUserID(ctx); userID == nil || !a.isGameMaster(campaign, *userID) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+func (a *Auth) isGameMaster(campaign *model.Campaign, userID uint) bool {
+	if campaign.GameMasterID == userID {
+		return true
+	}
+	user, err := a.DB.GetUserByID(userID)
+	return err == nil && user != nil && user.Admin
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+	"kingdom/model"
+	"net/http"
+	"strconv"
+)
+
+// AccessDatabase resolves users and the characters owning resources
+type AccessDatabase interface {
+	GetUserByID(id uint) (*model.User, error)
+	GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error)
+	IsCharacterGameMaster(characterID uint, userID uint) (bool, error)
+}
+
+// RequireCharacterAccess allows the request only for the owner of the character
+// behind the path parameter, for admin, or for reading by the campaign Game Master
+func (a *Auth) RequireCharacterAccess(resource model.OwnedResource, param string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param(param), 10, 32)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+		if !CheckCharacterAccess(ctx, a.DB, resource, uint(id)) {
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// CheckCharacterAccess aborts the request and returns false when the user can't access the resource
+func CheckCharacterAccess(ctx *gin.Context, db AccessDatabase, resource model.OwnedResource, id uint) bool {
+	character, err := db.GetOwningCharacter(resource, id)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	if character == nil {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+		return false
+	}
+	if !CanAccessCharacter(ctx, db, character) {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "You can't access for this API"})
+		return false
+	}
+	return true
+}
+
+// CanAccessCharacter reports whether the authenticated user owns the character or is admin,
+// Game Master of the character's campaign may only read it
+func CanAccessCharacter(ctx *gin.Context, db AccessDatabase, character *model.Character) bool {
+	userID := TryGetUserID(ctx)
+	if userID == nil {
+		return false
+	}
+	if character.UserID == *userID {
+		return true
+	}
+	if user, err := db.GetUserByID(*userID); err == nil && user != nil && user.Admin {
+		return true
+	}
+	if ctx.Request.Method != http.MethodGet {
+		return false
+	}
+	gameMaster, err := db.IsCharacterGameMaster(character.ID, *userID)
+	return err == nil && gameMaster
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/mode"
+	"kingdom/model"
+	"kingdom/test"
+	"kingdom/test/testdb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireCharacterAccess(t *testing.T) {
+	mode.Set(mode.TestDev)
+	db := testdb.NewDB(t)
+	owner := db.NewUser(1)
+	other := db.NewUser(2)
+	admin := db.NewUser(3)
+	require.NoError(t, db.DB.Model(admin).Update("admin", true).Error)
+
+	character := &model.Character{Name: "Valeros", UserID: owner.ID}
+	require.NoError(t, db.DB.Create(character).Error)
+	slot := &model.Slot{CharacterID: character.ID}
+	require.NoError(t, db.DB.Create(slot).Error)
+	skill := &model.CharacterSkill{CharacterID: character.ID, Name: "Athletics", Mastery: model.Train}
+	require.NoError(t, db.DB.Create(skill).Error)
+
+	a := &Auth{DB: db}
+	send := func(method string, userID uint, resource model.OwnedResource, id uint) int {
+		recorder := httptest.NewRecorder()
+		g := gin.New()
+		g.Handle(method, "/:id",
+			func(ctx *gin.Context) { test.WithUser(ctx, userID) },
+			a.RequireCharacterAccess(resource, "id"),
+			func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+		g.ServeHTTP(recorder, httptest.NewRequest(method, fmt.Sprintf("/%d", id), nil))
+		return recorder.Code
+	}
+	request := func(userID uint, resource model.OwnedResource, id uint) int {
+		return send(http.MethodGet, userID, resource, id)
+	}
+
+	assert.Equal(t, http.StatusOK, request(owner.ID, model.CharacterResource, character.ID))
+	assert.Equal(t, http.StatusForbidden, request(other.ID, model.CharacterResource, character.ID))
+	assert.Equal(t, http.StatusOK, request(admin.ID, model.CharacterResource, character.ID))
+	assert.Equal(t, http.StatusNotFound, request(owner.ID, model.CharacterResource, character.ID+100))
+
+	assert.Equal(t, http.StatusOK, request(owner.ID, model.SlotResource, slot.ID))
+	assert.Equal(t, http.StatusForbidden, request(other.ID, model.SlotResource, slot.ID))
+	assert.Equal(t, http.StatusForbidden, request(other.ID, model.CharacterSkillResource, skill.ID))
+	assert.Equal(t, http.StatusNotFound, request(owner.ID, model.CharacterItemResource, 1))
+	assert.Equal(t, http.StatusForbidden, send(http.MethodPatch, other.ID, model.CharacterResource, character.ID))
+
+	gameMaster := db.NewUser(4)
+	campaign := &model.Campaign{Name: "Abomination Vaults", GameMasterID: gameMaster.ID}
+	require.NoError(t, db.CreateCampaign(campaign))
+	require.NoError(t, db.DB.Model(character).Update("campaign_id", campaign.ID).Error)
+	assert.Equal(t, http.StatusOK, request(gameMaster.ID, model.CharacterResource, character.ID))
+	assert.Equal(t, http.StatusOK, request(gameMaster.ID, model.SlotResource, slot.ID))
+	assert.Equal(t, http.StatusForbidden, send(http.MethodPatch, gameMaster.ID, model.CharacterResource, character.ID))
+	assert.Equal(t, http.StatusForbidden, request(other.ID, model.CharacterResource, character.ID))
+}
+
+func TestRequireGameMaster(t *testing.T) {
+	mode.Set(mode.TestDev)
+	db := testdb.NewDB(t)
+	gameMaster := db.NewUser(11)
+	player := db.NewUser(12)
+	campaign := &model.Campaign{Name: "Age of Ashes", GameMasterID: gameMaster.ID}
+	require.NoError(t, db.CreateCampaign(campaign))
+
+	a := &Auth{DB: db}
+	request := func(userID uint, id uint) int {
+		recorder := httptest.NewRecorder()
+		g := gin.New()
+		g.GET("/:id",
+			func(ctx *gin.Context) { test.WithUser(ctx, userID) },
+			a.RequireGameMaster("id"),
+			func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+		g.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d", id), nil))
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request(gameMaster.ID, campaign.ID))
+	assert.Equal(t, http.StatusForbidden, request(player.ID, campaign.ID))
+	assert.Equal(t, http.StatusNotFound, request(gameMaster.ID, campaign.ID+100))
+}
@@ -11,6 +11,8 @@ import (
 	"time"
 )
 
+const inviteMessage = "invite"
+
 type RMQConsumerDatabase interface {
 	CreateUserCode(code *model.UserCode) error
 }
@@ -77,6 +79,11 @@ func (r *RMQConsumer) HandleMessage() {
 				log.Println("Failed to unmarshal the message", err)
 				continue
 			}
+			if d.Type == inviteMessage {
+				campaign, _ := d.Headers["campaign"].(string)
+				r.SendInvite(string(d.Body), campaign)
+				continue
+			}
 			r.SendEmail(string(d.Body))
 		}
 	}()
@@ -85,7 +92,20 @@ func (r *RMQConsumer) HandleMessage() {
 }
 
 func (r *RMQConsumer) Publish(email string) {
+	r.publish(amqp091.Publishing{ContentType: "text/plain", Body: []byte(email)})
+}
 
+// PublishInvite queues an email inviting the player to the campaign
+func (r *RMQConsumer) PublishInvite(email string, campaign string) {
+	r.publish(amqp091.Publishing{
+		ContentType: "text/plain",
+		Type:        inviteMessage,
+		Headers:     amqp091.Table{"campaign": campaign},
+		Body:        []byte(email),
+	})
+}
+
+func (r *RMQConsumer) publish(message amqp091.Publishing) {
 	if r.Channel == nil {
 		log.Println("Failed to connect to RabbitMQ")
 		return
@@ -100,7 +120,7 @@ func (r *RMQConsumer) Publish(email string) {
 		r.Queue,
 		false,
 		false,
-		amqp091.Publishing{ContentType: "text/plain", Body: []byte(email)},
+		message,
 	)
 	if err != nil {
 		log.Println("Failed to publish a message")
@@ -118,6 +138,22 @@ func (r *RMQConsumer) SendEmail(email string) {
 	}
 
 	log.Printf("Sending email to %s with code %s", email, code)
+	sendMail(email, "Kingdom Register", "Your code for register is "+code)
+
+	err := r.DB.CreateUserCode(userCode)
+	if err != nil {
+		log.Println("Failed to create a user code")
+	}
+}
+
+// SendInvite sends the campaign invitation email
+func (r *RMQConsumer) SendInvite(email string, campaign string) {
+	log.Printf("Sending invite to campaign %s to %s", campaign, email)
+	sendMail(email, "Kingdom Campaign Invite",
+		"You are invited to join campaign "+campaign+". Log in to Kingdom to accept the invite.")
+}
+
+func sendMail(email string, subject string, body string) {
 	emailFrom := os.Getenv("EMAIL_FROM")
 	emailPassword := os.Getenv("EMAIL_PASSWORD")
 	smtpHost := os.Getenv("SMTP_HOST")
@@ -125,8 +161,8 @@ func (r *RMQConsumer) SendEmail(email string) {
 
 	message := "From: " + emailFrom + "\n" +
 		"To: " + email + "\n" +
-		"Subject: " + "Kingdom Register" + "\n" +
-		"Your code for register is " + code + "\n"
+		"Subject: " + subject + "\n" +
+		body + "\n"
 
 	auth := smtp.PlainAuth("", emailFrom, emailPassword, smtpHost)
 	err := smtp.SendMail(smtpHost+":"+smtpPort, auth, emailFrom, []string{email}, []byte(message))
@@ -135,11 +171,6 @@ func (r *RMQConsumer) SendEmail(email string) {
 	} else {
 		log.Println("Email sent successfully")
 	}
-
-	err = r.DB.CreateUserCode(userCode)
-	if err != nil {
-		log.Println("Failed to create a user code")
-	}
 }
 
 func GenerateCode() string {
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateArchetype creates Archetype and links its dedication and follow-up Feats
+func (d *GormDatabase) CreateArchetype(archetype *model.Archetype, dedicationFeatID uint, featIDs []uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Feats").Create(archetype).Error; err != nil {
+			return err
+		}
+		return linkArchetypeFeats(tx, archetype.ID, dedicationFeatID, featIDs)
+	})
+}
+
+// GetArchetypeByID returns Archetype by ID or nil
+func (d *GormDatabase) GetArchetypeByID(id uint) (*model.Archetype, error) {
+	var archetypes []*model.Archetype
+	err := preloadArchetype(d.DB).Where("id = ?", id).Find(&archetypes).Error
+	if err != nil || len(archetypes) == 0 {
+		return nil, err
+	}
+	return archetypes[0], nil
+}
+
+// GetArchetypes returns all Archetypes ordered by name
+func (d *GormDatabase) GetArchetypes() ([]*model.Archetype, error) {
+	var archetypes []*model.Archetype
+	err := preloadArchetype(d.DB).Order("name").Find(&archetypes).Error
+	return archetypes, err
+}
+
+// GetCharacterArchetypes returns Archetypes whose dedication Feat the Character has taken
+func (d *GormDatabase) GetCharacterArchetypes(characterID uint) ([]*model.Archetype, error) {
+	var archetypes []*model.Archetype
+	err := preloadArchetype(d.DB).
+		Where("id IN (?)", d.DB.Table("feats").
+			Select("feats.archetype_id").
+			Joins("JOIN character_feats ON character_feats.feat_id = feats.id").
+			Where("character_feats.character_id = ? AND feats.dedication = ?", characterID, true)).
+		Order("name").
+		Find(&archetypes).Error
+	return archetypes, err
+}
+
+// UpdateArchetype updates Archetype and replaces its Feats and proficiencies
+func (d *GormDatabase) UpdateArchetype(archetype *model.Archetype, dedicationFeatID uint, featIDs []uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Feats", "Proficiencies", "CharacterClass").Save(archetype).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("archetype_id = ?", archetype.ID).Delete(&model.ArchetypeProficiency{}).Error; err != nil {
+			return err
+		}
+		for i := range archetype.Proficiencies {
+			archetype.Proficiencies[i].ID = 0
+			archetype.Proficiencies[i].ArchetypeID = archetype.ID
+		}
+		if len(archetype.Proficiencies) > 0 {
+			if err := tx.Create(&archetype.Proficiencies).Error; err != nil {
+				return err
+			}
+		}
+		return linkArchetypeFeats(tx, archetype.ID, dedicationFeatID, featIDs)
+	})
+}
+
+// DeleteArchetype deletes Archetype by ID, its Feats stay as plain feats
+func (d *GormDatabase) DeleteArchetype(id uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := unlinkArchetypeFeats(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Where("archetype_id = ?", id).Delete(&model.ArchetypeProficiency{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Archetype{}, id).Error
+	})
+}
+
+func linkArchetypeFeats(tx *gorm.DB, archetypeID uint, dedicationFeatID uint, featIDs []uint) error {
+	if err := unlinkArchetypeFeats(tx, archetypeID); err != nil {
+		return err
+	}
+	IDs := append([]uint{dedicationFeatID}, featIDs...)
+	err := tx.Model(&model.Feat{}).Where("id IN (?)", IDs).
+		UpdateColumns(map[string]interface{}{"archetype_id": archetypeID, "category": model.ArchetypeFeat}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Model(&model.Feat{}).Where("id = ?", dedicationFeatID).UpdateColumn("dedication", true).Error
+}
+
+func unlinkArchetypeFeats(tx *gorm.DB, archetypeID uint) error {
+	return tx.Model(&model.Feat{}).Where("archetype_id = ?", archetypeID).
+		UpdateColumns(map[string]interface{}{"archetype_id": nil, "dedication": false}).Error
+}
+
+func preloadArchetype(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("CharacterClass").
+		Preload("Feats", func(db *gorm.DB) *gorm.DB { return db.Order("dedication DESC, level, name") }).
+		Preload("Proficiencies")
+}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestArchetype() {
+	dedication := &model.Feat{Name: "Fighter Dedication", Level: 2, Category: model.ClassFeat}
+	followUp := &model.Feat{Name: "Basic Maneuver", Level: 4, Category: model.ClassFeat}
+	other := &model.Feat{Name: "Opportunist", Level: 4, Category: model.ClassFeat}
+	for _, feat := range []*model.Feat{dedication, followUp, other} {
+		require.NoError(s.T(), s.db.CreateFeat(feat))
+	}
+
+	archetype := &model.Archetype{
+		Name:          "Fighter",
+		Proficiencies: []model.ArchetypeProficiency{{Target: "martial_weapon", Mastery: model.Train}},
+	}
+	require.NoError(s.T(), s.db.CreateArchetype(archetype, dedication.ID, []uint{followUp.ID}))
+
+	saved, err := s.db.GetArchetypeByID(archetype.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), saved.Feats, 2)
+	assert.Equal(s.T(), dedication.ID, saved.Feats[0].ID)
+	assert.True(s.T(), saved.Feats[0].Dedication)
+	assert.Equal(s.T(), model.ArchetypeFeat, saved.Feats[1].Category)
+	require.Len(s.T(), saved.Proficiencies, 1)
+
+	character := &model.Character{Name: "Multiclass"}
+	require.NoError(s.T(), s.db.DB.Create(character).Error)
+	archetypes, err := s.db.GetCharacterArchetypes(character.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), archetypes)
+	require.NoError(s.T(), s.db.CreateCharacterFeat(&model.CharacterFeat{CharacterID: character.ID, FeatID: dedication.ID}))
+	archetypes, err = s.db.GetCharacterArchetypes(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), archetypes, 1)
+	assert.Equal(s.T(), "Fighter", archetypes[0].Name)
+
+	archetype.Proficiencies = []model.ArchetypeProficiency{
+		{Target: "martial_weapon", Mastery: model.Train},
+		{Target: "Athletics", Mastery: model.Train},
+	}
+	require.NoError(s.T(), s.db.UpdateArchetype(archetype, dedication.ID, []uint{other.ID}))
+	saved, err = s.db.GetArchetypeByID(archetype.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), saved.Feats, 2)
+	assert.Equal(s.T(), other.ID, saved.Feats[1].ID)
+	assert.Len(s.T(), saved.Proficiencies, 2)
+
+	archetypeID := archetype.ID
+	feats, err := s.db.FilterFeats(&model.FeatFilter{ArchetypeID: &archetypeID})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), feats, 2)
+
+	require.NoError(s.T(), s.db.DeleteArchetype(archetype.ID))
+	saved, err = s.db.GetArchetypeByID(archetype.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), saved)
+	feat, err := s.db.GetFeatByID(dedication.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), feat.ArchetypeID)
+	assert.False(s.T(), feat.Dedication)
+}
@@ -9,14 +9,14 @@ import (
 // GetArmors Returns all armors
 func (d *GormDatabase) GetArmors() ([]*model.Armor, error) {
 	var armors []*model.Armor
-	err := d.DB.Preload("Item").Find(&armors).Error
+	err := d.DB.Preload("Item").Preload("Traits").Find(&armors).Error
 	return armors, err
 }
 
 // GetArmorByID Returns Armor by ID
 func (d *GormDatabase) GetArmorByID(id uint) (*model.Armor, error) {
 	armor := new(model.Armor)
-	err := d.DB.Preload("Item").Find(armor, id).Error
+	err := d.DB.Preload("Item").Preload("Traits").Find(armor, id).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
@@ -41,10 +41,14 @@ func (d *GormDatabase) CreateArmor(armor *model.Armor, item *model.Item) error {
 	return err
 }
 
-// UpdateArmor updates Armor and Item with Owner ID
+// UpdateArmor updates Armor and Item with Owner ID and replaces the armor traits
 func (d *GormDatabase) UpdateArmor(armor *model.Armor, item *model.Item) error {
 	err := d.DB.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Model(&armor).Select("ArmorClass").Updates(armor).Error; err != nil {
+		if err := tx.Model(&armor).Select("ArmorClass", "Category", "DexCap", "CheckPenalty", "SpeedPenalty",
+			"Strength", "ArmorGroup").Updates(armor).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(armor).Association("Traits").Replace(armor.Traits); err != nil {
 			return err
 		}
 		if err := tx.Model(&item).Select("Level").Updates(item).Error; err != nil {
@@ -0,0 +1,108 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateCampaign creates new Campaign
+func (d *GormDatabase) CreateCampaign(campaign *model.Campaign) error {
+	return d.DB.Create(campaign).Error
+}
+
+// GetCampaignByID returns Campaign with its characters or nil
+func (d *GormDatabase) GetCampaignByID(id uint) (*model.Campaign, error) {
+	campaign := new(model.Campaign)
+	err := d.DB.Preload("Characters").First(campaign, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return campaign, err
+}
+
+// GetCampaigns returns campaigns the user runs or plays in
+func (d *GormDatabase) GetCampaigns(userID uint) ([]*model.Campaign, error) {
+	var campaigns []*model.Campaign
+	err := d.DB.Preload("Characters").
+		Where("game_master_id = ? OR id IN (?)", userID,
+			d.DB.Model(&model.Character{}).Select("campaign_id").Where("user_id = ?", userID)).
+		Find(&campaigns).Error
+	return campaigns, err
+}
+
+// UpdateCampaign updates Campaign
+func (d *GormDatabase) UpdateCampaign(campaign *model.Campaign) error {
+	return d.DB.Model(campaign).Select("name", "description", "milestone").Updates(campaign).Error
+}
+
+// DeleteCampaign deletes Campaign and detaches its characters
+func (d *GormDatabase) DeleteCampaign(id uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Character{}).Where("campaign_id = ?", id).
+			Update("campaign_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("campaign_id = ?", id).Delete(&model.CampaignInvite{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Campaign{}, id).Error
+	})
+}
+
+// CreateCampaignInvite creates invite or renews the existing one for the email
+func (d *GormDatabase) CreateCampaignInvite(invite *model.CampaignInvite) error {
+	return d.DB.Where(model.CampaignInvite{CampaignID: invite.CampaignID, Email: invite.Email}).
+		Assign(model.CampaignInvite{Accepted: false}).
+		FirstOrCreate(invite).Error
+}
+
+// GetCampaignInvite returns pending invite of the email to the campaign or nil, an accepted invite is used up
+func (d *GormDatabase) GetCampaignInvite(campaignID uint, email string) (*model.CampaignInvite, error) {
+	invite := new(model.CampaignInvite)
+	err := d.DB.Where("campaign_id = ? AND email = ? AND accepted = ?", campaignID, email, false).
+		First(invite).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return invite, err
+}
+
+// GetCampaignInvitesByEmail returns pending invites of the email
+func (d *GormDatabase) GetCampaignInvitesByEmail(email string) ([]*model.CampaignInvite, error) {
+	var invites []*model.CampaignInvite
+	err := d.DB.Where("email = ? AND accepted = ?", email, false).Find(&invites).Error
+	return invites, err
+}
+
+// JoinCampaign accepts the invite and attaches character to the campaign, an invite lets in one character
+func (d *GormDatabase) JoinCampaign(invite *model.CampaignInvite, characterID uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		accepted := tx.Model(invite).Where("accepted = ?", false).Update("accepted", true)
+		if accepted.Error != nil {
+			return accepted.Error
+		}
+		if accepted.RowsAffected == 0 {
+			return errors.New("invite is already accepted")
+		}
+		return tx.Model(&model.Character{ID: characterID}).
+			Update("campaign_id", invite.CampaignID).Error
+	})
+}
+
+// LeaveCampaign detaches character from the campaign
+func (d *GormDatabase) LeaveCampaign(campaignID uint, characterID uint) error {
+	return d.DB.Model(&model.Character{}).
+		Where("id = ? AND campaign_id = ?", characterID, campaignID).
+		Update("campaign_id", nil).Error
+}
+
+// IsCharacterGameMaster reports whether the user runs the campaign of the character
+func (d *GormDatabase) IsCharacterGameMaster(characterID uint, userID uint) (bool, error) {
+	var count int64
+	err := d.DB.Model(&model.Character{}).
+		Joins("JOIN campaigns ON campaigns.id = characters.campaign_id").
+		Where("characters.id = ? AND campaigns.game_master_id = ?", characterID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
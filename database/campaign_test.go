@@ -0,0 +1,69 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCampaign() {
+	campaign, err := s.db.GetCampaignByID(1)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), campaign)
+
+	campaign = &model.Campaign{Name: "Abomination Vaults", GameMasterID: 1}
+	require.NoError(s.T(), s.db.CreateCampaign(campaign))
+	character := &model.Character{Name: "Test Character", UserID: 2}
+	require.NoError(s.T(), s.db.CreateCharacter(character))
+
+	invite := &model.CampaignInvite{CampaignID: campaign.ID, Email: "player@example.com"}
+	require.NoError(s.T(), s.db.CreateCampaignInvite(invite))
+	require.NoError(s.T(), s.db.CreateCampaignInvite(&model.CampaignInvite{
+		CampaignID: campaign.ID,
+		Email:      "player@example.com",
+	}))
+	invites, err := s.db.GetCampaignInvitesByEmail("player@example.com")
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), invites, 1)
+
+	gameMaster, err := s.db.IsCharacterGameMaster(character.ID, 1)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), gameMaster)
+
+	require.NoError(s.T(), s.db.JoinCampaign(invite, character.ID))
+	campaign, err = s.db.GetCampaignByID(campaign.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), campaign.Characters, 1)
+	assert.Equal(s.T(), character.ID, campaign.Characters[0].ID)
+	invites, err = s.db.GetCampaignInvitesByEmail("player@example.com")
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), invites)
+	used, err := s.db.GetCampaignInvite(campaign.ID, "player@example.com")
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), used)
+	assert.Error(s.T(), s.db.JoinCampaign(invite, character.ID))
+
+	gameMaster, err = s.db.IsCharacterGameMaster(character.ID, 1)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), gameMaster)
+	gameMaster, err = s.db.IsCharacterGameMaster(character.ID, 2)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), gameMaster)
+
+	campaigns, err := s.db.GetCampaigns(2)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), campaigns, 1)
+	campaigns, err = s.db.GetCampaigns(3)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), campaigns)
+
+	require.NoError(s.T(), s.db.LeaveCampaign(campaign.ID, character.ID))
+	campaigns, err = s.db.GetCampaigns(2)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), campaigns)
+
+	require.NoError(s.T(), s.db.DeleteCampaign(campaign.ID))
+	campaign, err = s.db.GetCampaignByID(campaign.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), campaign)
+}
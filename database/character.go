@@ -24,6 +24,7 @@ func (d *GormDatabase) GetCharacterByID(id uint) (*model.Character, error) {
 		Preload("Attribute").
 		Preload("CharacterSkill").
 		Preload("CharacterFeat").
+		Preload("CharacterCondition.Condition.Abilities").
 		Preload("CharacterInfo").
 		First(character, id).Error
 	if err != nil {
@@ -45,16 +46,12 @@ func (d *GormDatabase) GetCharacters(id uint) ([]*model.Character, error) {
 	return characters, err
 }
 
-// UpdateCharacter updates character by its id
+// UpdateCharacter updates names of character by its id
 func (d *GormDatabase) UpdateCharacter(character *model.Character) error {
-	return d.DB.Save(character).Error
+	return d.DB.Model(character).Select("name", "alias", "last_name").Updates(character).Error
 }
 
 // DeleteCharacterByID deletes character by its id
 func (d *GormDatabase) DeleteCharacterByID(id uint) error {
 	return d.DB.Where("id = ?", id).Delete(&model.Character{}, id).Error
 }
-
-func (d *GormDatabase) UpdateHitPoint(defence *model.CharacterDefence) error {
-	return d.DB.Model(&defence).Select("max_hit_points").Updates(defence).Error
-}
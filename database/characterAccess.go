@@ -0,0 +1,37 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+var ownedResourceModels = map[model.OwnedResource]func() interface{}{
+	model.SlotResource:           func() interface{} { return &model.Slot{} },
+	model.CharacterItemResource:  func() interface{} { return &model.CharacterItem{} },
+	model.CharacterSkillResource: func() interface{} { return &model.CharacterSkill{} },
+}
+
+// GetOwningCharacter returns ID and User of the Character the resource belongs to
+func (d *GormDatabase) GetOwningCharacter(resource model.OwnedResource, id uint) (*model.Character, error) {
+	characterID := id
+	if resource != model.CharacterResource {
+		newModel, ok := ownedResourceModels[resource]
+		if !ok {
+			return nil, fmt.Errorf("unknown resource %s", resource)
+		}
+		var characterIDs []uint
+		err := d.DB.Model(newModel()).Where("id = ?", id).Pluck("character_id", &characterIDs).Error
+		if err != nil || len(characterIDs) == 0 {
+			return nil, err
+		}
+		characterID = characterIDs[0]
+	}
+	character := &model.Character{}
+	err := d.DB.Select("id", "user_id").First(character, characterID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return character, err
+}
@@ -21,9 +21,32 @@ func (d *GormDatabase) GetCharacterBoostByID(characterID uint) (*model.Character
 	return characterBoost, nil
 }
 
-// UpdateCharacterBoost updates Boost object
-func (d *GormDatabase) UpdateCharacterBoost(characterBoost *model.CharacterBoost) error {
-	return d.DB.Model(characterBoost).
-		Select("strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma", "free").
-		Updates(characterBoost).Error
+// GetAttributeBoosts returns spent attribute boosts of character in the order they apply
+func (d *GormDatabase) GetAttributeBoosts(characterID uint) ([]*model.AttributeBoost, error) {
+	var boosts []*model.AttributeBoost
+	err := d.DB.Where("character_id = ?", characterID).Order("level, id").Find(&boosts).Error
+	return boosts, err
+}
+
+// ReplaceAttributeBoosts replaces creation boosts of one source and saves recomputed attributes
+func (d *GormDatabase) ReplaceAttributeBoosts(
+	characterID uint,
+	source model.BoostSource,
+	boosts []*model.AttributeBoost,
+	attribute *model.Attribute,
+) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("character_id = ? AND source = ?", characterID, source).
+			Delete(&model.AttributeBoost{}).Error; err != nil {
+			return err
+		}
+		for _, boost := range boosts {
+			if err := tx.Create(boost).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(attribute).
+			Select("strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma").
+			Updates(attribute).Error
+	})
 }
@@ -27,3 +27,15 @@ func (d *GormDatabase) UpdateCharacterDefence(defence *model.CharacterDefence) e
 		Select("armor_class", "hit_point", "temporary_hit_point", "wounded", "speed").
 		Updates(defence).Error
 }
+
+// UpdateDyingWounded updates dying and wounded values of Character Defence
+func (d *GormDatabase) UpdateDyingWounded(defence *model.CharacterDefence) error {
+	return d.DB.Model(defence).Select("dying", "wounded").Updates(defence).Error
+}
+
+// UpdateCharacterSpeed updates the Speed of the Character Defence of the character
+func (d *GormDatabase) UpdateCharacterSpeed(characterID uint, speed uint8) error {
+	return d.DB.Model(&model.CharacterDefence{}).
+		Where("character_id = ?", characterID).
+		UpdateColumn("speed", speed).Error
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// ApplyHealthChange saves hit points, dying and wounded of Character Defence
+// and records the change in the health log in one transaction, a blocking shield keeps its hit points
+func (d *GormDatabase) ApplyHealthChange(defence *model.CharacterDefence, healthLog *model.HealthLog) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(defence).
+			Select("hit_point", "temporary_hit_point", "dying", "wounded").
+			Updates(defence).Error; err != nil {
+			return err
+		}
+		if healthLog.ShieldItemID != nil {
+			if err := tx.Model(&model.CharacterItem{}).
+				Where("id = ?", *healthLog.ShieldItemID).
+				UpdateColumn("hit_point", healthLog.ShieldHitPoint).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(healthLog).Error
+	})
+}
+
+// GetHealthLogs returns the health changes of Character, the latest first
+func (d *GormDatabase) GetHealthLogs(characterID uint) ([]*model.HealthLog, error) {
+	var healthLogs []*model.HealthLog
+	err := d.DB.Where("character_id = ?", characterID).Order("id desc").Find(&healthLogs).Error
+	return healthLogs, err
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestApplyHealthChange() {
+	defence := &model.CharacterDefence{CharacterID: 1, MaxHitPoint: 20, HitPoint: 20}
+	require.NoError(s.T(), s.db.CreateCharacterDefence(defence))
+
+	defence.HitPoint = 0
+	defence.Dying = 1
+	require.NoError(s.T(), s.db.ApplyHealthChange(defence, &model.HealthLog{
+		CharacterID: 1,
+		UserID:      1,
+		Kind:        model.HealthChangeDamage,
+		Amount:      25,
+		Dying:       1,
+	}))
+	roll := uint8(15)
+	defence.Dying = 0
+	defence.Wounded = 1
+	require.NoError(s.T(), s.db.ApplyHealthChange(defence, &model.HealthLog{
+		CharacterID: 1,
+		UserID:      1,
+		Kind:        model.HealthChangeRecovery,
+		Roll:        &roll,
+		Wounded:     1,
+	}))
+
+	saved, err := s.db.GetCharacterDefenceByID(defence.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint16(0), saved.HitPoint)
+	assert.Equal(s.T(), uint8(0), saved.Dying)
+	assert.Equal(s.T(), uint8(1), saved.Wounded)
+
+	healthLogs, err := s.db.GetHealthLogs(1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), healthLogs, 2)
+	assert.Equal(s.T(), model.HealthChangeRecovery, healthLogs[0].Kind)
+	assert.Equal(s.T(), roll, *healthLogs[0].Roll)
+	assert.Equal(s.T(), model.HealthChangeDamage, healthLogs[1].Kind)
+}
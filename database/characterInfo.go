@@ -14,7 +14,7 @@ func (d *GormDatabase) GetCharacterInfoByID(characterID uint) (*model.CharacterI
 	return &characterInfo, err
 }
 
-// UpdateCharacterInfo updates character info object
+// UpdateCharacterInfo updates bulk of character info object
 func (d *GormDatabase) UpdateCharacterInfo(characterInfo *model.CharacterInfo) error {
-	return d.DB.Model(characterInfo).Select("bulk").Updates(characterInfo).Error
+	return d.DB.Model(characterInfo).Select("bulk", "max_bulk").Updates(characterInfo).Error
 }
@@ -14,7 +14,7 @@ func (d *GormDatabase) CreateCharacterItem(characterItem *model.CharacterItem) e
 // GetCharacterItemByID get character item by ID
 func (d *GormDatabase) GetCharacterItemByID(id uint) (*model.CharacterItem, error) {
 	characterItem := new(model.CharacterItem)
-	err := d.DB.Preload("Character").Preload("Item").Find(characterItem, id).Error
+	err := d.DB.Preload("Character").Preload("Item").Preload("Runes").Find(characterItem, id).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
@@ -27,16 +27,70 @@ func (d *GormDatabase) GetCharacterItemByID(id uint) (*model.CharacterItem, erro
 // GetCharacterItems get character items
 func (d *GormDatabase) GetCharacterItems(characterId uint) ([]*model.CharacterItem, error) {
 	var characterItems []*model.CharacterItem
-	err := d.DB.Where("character_id = ?", characterId).Preload("Character").Preload("Item").Find(&characterItems).Error
+	err := d.DB.Where("character_id = ?", characterId).
+		Preload("Character").Preload("Item").Preload("Runes").
+		Find(&characterItems).Error
 	return characterItems, err
 }
 
-// UpdateCharacterItem updates character item by ID
+// UpdateCharacterItem updates quantity and placement of character item by ID
 func (d *GormDatabase) UpdateCharacterItem(item *model.CharacterItem) error {
-	return d.DB.Updates(item).Error
+	return d.DB.Model(item).Select("quantity", "placement", "container_id").Updates(item).Error
 }
 
-// DeleteCharacterItem deletes character item by ID
+// DeleteCharacterItem deletes character item by ID, items stowed in it are carried
 func (d *GormDatabase) DeleteCharacterItem(id uint) error {
-	return d.DB.Delete(&model.CharacterItem{}, id).Error
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		return deleteCharacterItem(tx, id)
+	})
+}
+
+func deleteCharacterItem(tx *gorm.DB, id uint) error {
+	err := tx.Model(&model.CharacterItem{}).Where("container_id = ?", id).
+		UpdateColumns(map[string]interface{}{"container_id": nil, "placement": model.CarriedItem}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Delete(&model.CharacterItem{}, id).Error
+}
+
+// SplitCharacterItem takes one item off the stack as its own character item in one transaction
+func (d *GormDatabase) SplitCharacterItem(stack *model.CharacterItem) (*model.CharacterItem, error) {
+	single := &model.CharacterItem{
+		CharacterID: stack.CharacterID,
+		ItemID:      stack.ItemID,
+		Quantity:    1,
+		Placement:   stack.Placement,
+		ContainerID: stack.ContainerID,
+		HitPoint:    stack.HitPoint,
+		Charges:     stack.Charges,
+	}
+	err := d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(stack).Update("quantity", stack.Quantity-1).Error; err != nil {
+			return err
+		}
+		return tx.Create(single).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.GetCharacterItemByID(single.ID)
+}
+
+// UpdateCharacterItemRunes saves the fundamental runes of character items and replaces their property runes
+// in one transaction
+func (d *GormDatabase) UpdateCharacterItemRunes(items ...*model.CharacterItem) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if err := tx.Model(item).
+				Select("potency", "striking", "resilient").
+				Updates(item).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(item).Association("Runes").Replace(item.Runes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
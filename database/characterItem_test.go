@@ -0,0 +1,42 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCharacterItemContainer() {
+	character := &model.Character{Name: "Porter"}
+	require.NoError(s.T(), s.db.DB.Create(character).Error)
+	backpack := &model.Gear{}
+	require.NoError(s.T(), s.db.CreateGear(backpack, &model.Item{
+		Name: "Backpack", Bulk: 0.1, Capacity: 4, BulkReduction: 2, OwnerType: "gears"}))
+	rope := &model.Gear{}
+	require.NoError(s.T(), s.db.CreateGear(rope, &model.Item{Name: "Rope", Bulk: 0.1, OwnerType: "gears"}))
+	gears, err := s.db.GetGears()
+	require.NoError(s.T(), err)
+	require.Len(s.T(), gears, 2)
+
+	container := &model.CharacterItem{CharacterID: character.ID, ItemID: gears[0].Item.ID, Quantity: 1,
+		Placement: model.WornItem}
+	require.NoError(s.T(), s.db.CreateCharacterItem(container))
+	stowed := &model.CharacterItem{CharacterID: character.ID, ItemID: gears[1].Item.ID, Quantity: 2,
+		Placement: model.StowedItem, ContainerID: &container.ID}
+	require.NoError(s.T(), s.db.CreateCharacterItem(stowed))
+
+	stowed.Quantity = 3
+	require.NoError(s.T(), s.db.UpdateCharacterItem(stowed))
+	items, err := s.db.GetCharacterItems(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 2)
+	assert.Equal(s.T(), uint(3), items[1].Quantity)
+	assert.Equal(s.T(), container.ID, *items[1].ContainerID)
+	assert.Equal(s.T(), float64(4), items[0].Item.Capacity)
+
+	require.NoError(s.T(), s.db.DeleteCharacterItem(container.ID))
+	item, err := s.db.GetCharacterItemByID(stowed.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), item.ContainerID)
+	assert.Equal(s.T(), model.CarriedItem, item.Placement)
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+	"strconv"
+)
+
+// GetCharacterLevel returns recorded level up of character or nil
+func (d *GormDatabase) GetCharacterLevel(characterID uint, level int8) (*model.CharacterLevel, error) {
+	characterLevel := new(model.CharacterLevel)
+	err := d.DB.Preload("Changes").
+		Where("character_id = ? AND level = ?", characterID, level).
+		First(characterLevel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return characterLevel, err
+}
+
+// GetCharacterLevels returns all recorded level ups of character
+func (d *GormDatabase) GetCharacterLevels(characterID uint) ([]*model.CharacterLevel, error) {
+	var characterLevels []*model.CharacterLevel
+	err := d.DB.Preload("Changes").
+		Where("character_id = ?", characterID).
+		Order("level").
+		Find(&characterLevels).Error
+	return characterLevels, err
+}
+
+// LevelUpCharacter saves level, defence, attribute and skills of character,
+// creates picked feats and boosts and records the level up in one transaction
+func (d *GormDatabase) LevelUpCharacter(
+	character *model.Character,
+	level *model.CharacterLevel,
+	skills []*model.CharacterSkill,
+	feats []*model.CharacterFeat,
+	boosts []*model.AttributeBoost,
+) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := saveProgression(tx, character, skills); err != nil {
+			return err
+		}
+		for _, feat := range feats {
+			if err := tx.Create(feat).Error; err != nil {
+				return err
+			}
+			// the level remembers the granted row so level down removes just that one
+			for i := range level.Changes {
+				change := &level.Changes[i]
+				if change.Kind == model.LevelChangeFeat && change.Target == strconv.Itoa(int(feat.FeatID)) {
+					change.CharacterFeatID = &feat.ID
+				}
+			}
+		}
+		for _, boost := range boosts {
+			if err := tx.Create(boost).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(level).Error
+	})
+}
+
+// LevelDownCharacter saves restored character, removes feats and boosts picked on the level
+// and deletes the level up record in one transaction
+func (d *GormDatabase) LevelDownCharacter(
+	character *model.Character,
+	level *model.CharacterLevel,
+	skills []*model.CharacterSkill,
+) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := saveProgression(tx, character, skills); err != nil {
+			return err
+		}
+		for _, change := range level.Changes {
+			if change.Kind != model.LevelChangeFeat {
+				continue
+			}
+			if change.CharacterFeatID != nil {
+				if err := tx.Delete(&model.CharacterFeat{}, *change.CharacterFeatID).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			featID, err := strconv.ParseUint(change.Target, 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := tx.Where("character_id = ? AND feat_id = ?", character.ID, featID).
+				Delete(&model.CharacterFeat{}).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("character_id = ? AND source = ? AND level = ?",
+			character.ID, model.LevelBoostSource, level.Level).
+			Delete(&model.AttributeBoost{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("character_level_id = ?", level.ID).Delete(&model.CharacterLevelChange{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(level).Error
+	})
+}
+
+func saveProgression(tx *gorm.DB, character *model.Character, skills []*model.CharacterSkill) error {
+	if err := tx.Model(character).Select("level", "experience", "level_ready").Updates(character).Error; err != nil {
+		return err
+	}
+	defence := &character.CharacterDefence
+	if err := tx.Model(defence).
+		Select("max_hit_point", "hit_point", "perception", "fortitude", "reflex", "will",
+			"unarmed", "light_armor", "medium_armor", "heavy_armor",
+			"un_armed_weapon", "common_weapon", "martial_weapon").
+		Updates(defence).Error; err != nil {
+		return err
+	}
+	attribute := &character.Attribute
+	if err := tx.Model(attribute).
+		Select("strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma").
+		Updates(attribute).Error; err != nil {
+		return err
+	}
+	for _, skill := range skills {
+		if err := tx.Model(skill).Select("mastery").Updates(skill).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
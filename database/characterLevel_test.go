@@ -0,0 +1,88 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCharacterLevel() {
+	character := &model.Character{Name: "Test Character", UserID: 1}
+	require.NoError(s.T(), s.db.CreateCharacter(character))
+	attribute := &model.Attribute{CharacterID: character.ID, Strength: 16}
+	require.NoError(s.T(), s.db.CreateAttribute(attribute))
+	defence := &model.CharacterDefence{CharacterID: character.ID, MaxHitPoint: 18, HitPoint: 18}
+	require.NoError(s.T(), s.db.CreateCharacterDefence(defence))
+	skill := &model.CharacterSkill{CharacterID: character.ID, Name: "Athletics", Mastery: model.Train}
+	require.NoError(s.T(), s.db.CharacterSkillCreate(skill))
+	feat := &model.Feat{Name: "Test Feat", Level: 1}
+	require.NoError(s.T(), s.db.CreateFeat(feat))
+
+	level, err := s.db.GetCharacterLevel(character.ID, 2)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), level)
+
+	character.Level = 2
+	character.Attribute = *attribute
+	character.Attribute.Strength = 18
+	character.CharacterDefence = *defence
+	character.CharacterDefence.MaxHitPoint = 28
+	character.CharacterDefence.Fortitude = model.Expert
+	skill.Mastery = model.Expert
+	level = &model.CharacterLevel{
+		CharacterID: character.ID,
+		Level:       2,
+		HitPoint:    10,
+		Experience:  1000,
+		Changes: []model.CharacterLevelChange{
+			{Kind: model.LevelChangeFeat, Target: "1", NewValue: feat.Name},
+		},
+	}
+	err = s.db.LevelUpCharacter(character, level,
+		[]*model.CharacterSkill{skill},
+		[]*model.CharacterFeat{{CharacterID: character.ID, FeatID: feat.ID}},
+		[]*model.AttributeBoost{{
+			CharacterID: character.ID,
+			Source:      model.LevelBoostSource,
+			Level:       2,
+			Ability:     model.Strength,
+		}})
+	require.NoError(s.T(), err)
+
+	level, err = s.db.GetCharacterLevel(character.ID, 2)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), level)
+	assert.Len(s.T(), level.Changes, 1)
+	assert.NotNil(s.T(), level.Changes[0].CharacterFeatID)
+	assert.Equal(s.T(), uint16(1000), level.Experience)
+
+	newAttribute, err := s.db.GetAttributeByID(character.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint8(18), newAttribute.Strength)
+	skills, err := s.db.GetCharacterSkills(character.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.Expert, skills[0].Mastery)
+	var featCount int64
+	s.db.DB.Model(&model.CharacterFeat{}).Where("character_id = ?", character.ID).Count(&featCount)
+	assert.Equal(s.T(), int64(1), featCount)
+	boosts, err := s.db.GetAttributeBoosts(character.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), boosts, 1)
+
+	character.Level = 1
+	character.Attribute.Strength = 16
+	skill.Mastery = model.Train
+	require.NoError(s.T(), s.db.LevelDownCharacter(character, level, []*model.CharacterSkill{skill}))
+
+	level, err = s.db.GetCharacterLevel(character.ID, 2)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), level)
+	newAttribute, err = s.db.GetAttributeByID(character.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint8(16), newAttribute.Strength)
+	s.db.DB.Model(&model.CharacterFeat{}).Where("character_id = ?", character.ID).Count(&featCount)
+	assert.Equal(s.T(), int64(0), featCount)
+	boosts, err = s.db.GetAttributeBoosts(character.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), boosts)
+}
@@ -26,5 +26,24 @@ func (d *GormDatabase) GetSlotByID(id uint) (*model.Slot, error) {
 
 // UpdateSlot updates slot
 func (d *GormDatabase) UpdateSlot(slot *model.Slot) error {
-	return d.DB.Model(&slot).Select("armor_id", "first_weapon_id", "second_weapon_id").Updates(slot).Error
+	return d.DB.Model(&slot).
+		Select("armor_id", "first_weapon_id", "second_weapon_id", "shield_id", "shield_raised").
+		Updates(slot).Error
+}
+
+// UpdateShieldRaised raises or lowers the shield in the slot of the character
+func (d *GormDatabase) UpdateShieldRaised(characterID uint, raised bool) error {
+	return d.DB.Model(&model.Slot{}).
+		Where("character_id = ?", characterID).
+		UpdateColumn("shield_raised", raised).Error
+}
+
+// GetSlotByCharacterID returns slot linked with character
+func (d *GormDatabase) GetSlotByCharacterID(characterID uint) (*model.Slot, error) {
+	slot := new(model.Slot)
+	err := d.DB.Where("character_id = ?", characterID).First(slot).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return slot, err
 }
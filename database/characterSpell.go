@@ -1 +1,127 @@
 package database
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"kingdom/model"
+)
+
+// GetCharacterSpells returns the repertoire or spellbook and focus spells of Character
+func (d *GormDatabase) GetCharacterSpells(characterID uint) ([]model.CharacterSpell, error) {
+	var spells []model.CharacterSpell
+	err := d.DB.Preload("Spell").Where("character_id = ?", characterID).Order("rank, id").Find(&spells).Error
+	return spells, err
+}
+
+// CreateCharacterSpell adds spell to Character
+func (d *GormDatabase) CreateCharacterSpell(characterSpell *model.CharacterSpell) error {
+	return d.DB.Create(characterSpell).Error
+}
+
+// DeleteCharacterSpell removes spell from Character together with its preparations
+func (d *GormDatabase) DeleteCharacterSpell(characterSpell *model.CharacterSpell) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("character_id = ? AND spell_id = ?", characterSpell.CharacterID, characterSpell.SpellID).
+			Delete(&model.CharacterPreparedSpell{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(characterSpell).Error
+	})
+}
+
+// GetPreparedSpells returns the spells Character prepared for the day
+func (d *GormDatabase) GetPreparedSpells(characterID uint) ([]model.CharacterPreparedSpell, error) {
+	var spells []model.CharacterPreparedSpell
+	err := d.DB.Preload("Spell").Where("character_id = ?", characterID).Order("rank, id").Find(&spells).Error
+	return spells, err
+}
+
+// ReplacePreparedSpells replaces the daily preparation of Character in one transaction
+func (d *GormDatabase) ReplacePreparedSpells(characterID uint, spells []*model.CharacterPreparedSpell) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("character_id = ?", characterID).Delete(&model.CharacterPreparedSpell{}).Error; err != nil {
+			return err
+		}
+		for _, spell := range spells {
+			if err := tx.Omit(clause.Associations).Create(spell).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ExpendPreparedSpell marks prepared spell as cast
+func (d *GormDatabase) ExpendPreparedSpell(spell *model.CharacterPreparedSpell) error {
+	return d.DB.Model(spell).Update("expended", true).Error
+}
+
+// GetSpellSlotUsage returns the slots Character used since the last rest
+func (d *GormDatabase) GetSpellSlotUsage(characterID uint) ([]model.CharacterSpellSlot, error) {
+	var slots []model.CharacterSpellSlot
+	err := d.DB.Where("character_id = ?", characterID).Order("rank").Find(&slots).Error
+	return slots, err
+}
+
+// UseSpellSlot spends one slot of the rank
+func (d *GormDatabase) UseSpellSlot(characterID uint, rank uint8) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		slot := &model.CharacterSpellSlot{}
+		if err := tx.Where(model.CharacterSpellSlot{CharacterID: characterID, Rank: rank}).
+			FirstOrCreate(slot).Error; err != nil {
+			return err
+		}
+		return tx.Model(slot).Update("used", gorm.Expr("used + 1")).Error
+	})
+}
+
+// UpdateFocusPoint saves focus points of Character
+func (d *GormDatabase) UpdateFocusPoint(character *model.Character) error {
+	return d.DB.Model(character).Update("focus_point", character.FocusPoint).Error
+}
+
+// RestCharacter refreshes spell slots, prepared spells, focus points and wand and staff charges of Character
+func (d *GormDatabase) RestCharacter(character *model.Character) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("character_id = ?", character.ID).Delete(&model.CharacterSpellSlot{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.CharacterPreparedSpell{}).
+			Where("character_id = ?", character.ID).
+			Update("expended", false).Error; err != nil {
+			return err
+		}
+		// wands and staves get their charges back with the daily preparations
+		if err := tx.Model(&model.CharacterItem{}).
+			Where("character_id = ? AND item_id IN (?)", character.ID,
+				tx.Table("items").Select("items.id").
+					Joins("JOIN consumables ON consumables.id = items.owner_id AND items.owner_type = ?", "consumables").
+					Where("consumables.kind IN ?", []model.ConsumableKind{model.WandItem, model.StaffItem})).
+			Update("charges", nil).Error; err != nil {
+			return err
+		}
+		return tx.Model(character).Update("focus_point", character.FocusPoint).Error
+	})
+}
+
+// GetSpellSlotTable returns the spell slot table of Character Class
+func (d *GormDatabase) GetSpellSlotTable(classID uint) ([]*model.SpellCharacterClass, error) {
+	var table []*model.SpellCharacterClass
+	err := d.DB.Where("character_class_id = ?", classID).Order("level, rank").Find(&table).Error
+	return table, err
+}
+
+// ReplaceSpellSlotTable replaces the spell slot table of Character Class in one transaction
+func (d *GormDatabase) ReplaceSpellSlotTable(classID uint, table []*model.SpellCharacterClass) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("character_class_id = ?", classID).Delete(&model.SpellCharacterClass{}).Error; err != nil {
+			return err
+		}
+		for _, row := range table {
+			if err := tx.Create(row).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
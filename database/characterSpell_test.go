@@ -0,0 +1,60 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCharacterSpell() {
+	spell := &model.Spell{Name: "Force Barrage", Rank: 1}
+	require.NoError(s.T(), s.db.CreateSpell(spell))
+	require.NoError(s.T(), s.db.CreateCharacterSpell(&model.CharacterSpell{CharacterID: 1, SpellID: spell.ID, Rank: 1}))
+	spells, err := s.db.GetCharacterSpells(1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), spells, 1)
+	assert.Equal(s.T(), "Force Barrage", spells[0].Spell.Name)
+
+	require.NoError(s.T(), s.db.ReplacePreparedSpells(1, []*model.CharacterPreparedSpell{
+		{CharacterID: 1, SpellID: spell.ID, Rank: 1},
+		{CharacterID: 1, SpellID: spell.ID, Rank: 1},
+	}))
+	prepared, err := s.db.GetPreparedSpells(1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), prepared, 2)
+	require.NoError(s.T(), s.db.ExpendPreparedSpell(&prepared[0]))
+
+	require.NoError(s.T(), s.db.UseSpellSlot(1, 1))
+	require.NoError(s.T(), s.db.UseSpellSlot(1, 1))
+	slots, err := s.db.GetSpellSlotUsage(1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), slots, 1)
+	assert.Equal(s.T(), uint8(2), slots[0].Used)
+
+	require.NoError(s.T(), s.db.RestCharacter(&model.Character{ID: 1, FocusPoint: 1}))
+	slots, err = s.db.GetSpellSlotUsage(1)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), slots)
+	prepared, err = s.db.GetPreparedSpells(1)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), prepared[0].Expended)
+
+	require.NoError(s.T(), s.db.DeleteCharacterSpell(&spells[0]))
+	prepared, err = s.db.GetPreparedSpells(1)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), prepared)
+}
+
+func (s *DatabaseSuite) TestSpellSlotTable() {
+	require.NoError(s.T(), s.db.ReplaceSpellSlotTable(1, []*model.SpellCharacterClass{
+		{CharacterClassID: 1, Level: 1, Rank: 0, SpellCount: 5},
+		{CharacterClassID: 1, Level: 1, Rank: 1, SpellCount: 2},
+	}))
+	require.NoError(s.T(), s.db.ReplaceSpellSlotTable(1, []*model.SpellCharacterClass{
+		{CharacterClassID: 1, Level: 1, Rank: 1, SpellCount: 3},
+	}))
+	table, err := s.db.GetSpellSlotTable(1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), table, 1)
+	assert.Equal(s.T(), uint8(3), table[0].SpellCount)
+}
@@ -31,3 +31,12 @@ func (d *GormDatabase) GetSkillFeatureByID(classFeatureID uint) ([]model.SkillFe
 	err := d.DB.Where("class_feature_id = ?", classFeatureID).Find(&classSkillFeatures).Error
 	return classSkillFeatures, err
 }
+
+// GetClassFeaturesByLevel returns all Feature for certain class and level
+func (d *GormDatabase) GetClassFeaturesByLevel(classID uint, level uint8) ([]model.ClassFeature, error) {
+	var classFeatures []model.ClassFeature
+	err := d.DB.
+		Where("character_class_id = ? AND level = ?", classID, level).
+		Find(&classFeatures).Error
+	return classFeatures, err
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateCondition creates new Condition
+func (d *GormDatabase) CreateCondition(condition *model.Condition) error {
+	return d.DB.Create(condition).Error
+}
+
+// GetConditionByID returns Condition by ID or nil
+func (d *GormDatabase) GetConditionByID(id uint) (*model.Condition, error) {
+	condition := new(model.Condition)
+	err := d.DB.Preload("Abilities").First(condition, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return condition, err
+}
+
+// GetConditionByName returns Condition by Name or nil
+func (d *GormDatabase) GetConditionByName(name string) (*model.Condition, error) {
+	condition := new(model.Condition)
+	err := d.DB.Preload("Abilities").Where("name = ?", name).First(condition).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return condition, err
+}
+
+// GetConditions returns all Conditions
+func (d *GormDatabase) GetConditions() ([]*model.Condition, error) {
+	var conditions []*model.Condition
+	err := d.DB.Preload("Abilities").Order("name").Find(&conditions).Error
+	return conditions, err
+}
+
+// UpdateCondition updates Condition and replaces its abilities
+func (d *GormDatabase) UpdateCondition(condition *model.Condition) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("condition_id = ?", condition.ID).Delete(&model.ConditionAbility{}).Error; err != nil {
+			return err
+		}
+		return tx.Save(condition).Error
+	})
+}
+
+// DeleteCondition deletes Condition
+func (d *GormDatabase) DeleteCondition(id uint) error {
+	return d.DB.Where("id = ?", id).Delete(&model.Condition{}).Error
+}
+
+// GetCharacterConditions returns conditions applied to Character
+func (d *GormDatabase) GetCharacterConditions(characterID uint) ([]model.CharacterCondition, error) {
+	var conditions []model.CharacterCondition
+	err := d.DB.Preload("Condition.Abilities").
+		Where("character_id = ?", characterID).
+		Order("id").
+		Find(&conditions).Error
+	return conditions, err
+}
+
+// SetCharacterCondition applies condition to Character or changes its count
+func (d *GormDatabase) SetCharacterCondition(characterCondition *model.CharacterCondition) error {
+	return d.DB.
+		Where(model.CharacterCondition{
+			CharacterID: characterCondition.CharacterID,
+			ConditionID: characterCondition.ConditionID,
+		}).
+		Assign(model.CharacterCondition{Count: characterCondition.Count}).
+		FirstOrCreate(characterCondition).Error
+}
+
+// DeleteCharacterCondition removes condition from Character
+func (d *GormDatabase) DeleteCharacterCondition(characterID uint, conditionID uint) error {
+	return d.DB.Where("character_id = ? AND condition_id = ?", characterID, conditionID).
+		Delete(&model.CharacterCondition{}).Error
+}
+
+// UpdateCharacterConditions saves changed counts and removes conditions with count 0
+func (d *GormDatabase) UpdateCharacterConditions(conditions []model.CharacterCondition) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for i := range conditions {
+			var err error
+			if conditions[i].Count <= 0 {
+				err = tx.Delete(&model.CharacterCondition{}, conditions[i].ID).Error
+			} else {
+				err = tx.Model(&conditions[i]).Select("count").Updates(&conditions[i]).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
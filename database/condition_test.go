@@ -0,0 +1,66 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCharacterCondition() {
+	frightened := &model.Condition{Name: "Frightened", Valued: true, Decrement: true, PenaltyType: "status"}
+	require.NoError(s.T(), s.db.CreateCondition(frightened))
+	stupefied := &model.Condition{Name: "Stupefied", Valued: true, PenaltyType: "status", Abilities: []model.ConditionAbility{
+		{Ability: model.Intelligence}, {Ability: model.Wisdom},
+	}}
+	require.NoError(s.T(), s.db.CreateCondition(stupefied))
+	stupefied.Abilities = []model.ConditionAbility{
+		{Ability: model.Intelligence}, {Ability: model.Wisdom}, {Ability: model.Charisma},
+	}
+	require.NoError(s.T(), s.db.UpdateCondition(stupefied))
+	condition, err := s.db.GetConditionByID(stupefied.ID)
+	require.NoError(s.T(), err)
+	var abilities []model.Ability
+	for _, ability := range condition.Abilities {
+		abilities = append(abilities, ability.Ability)
+	}
+	assert.ElementsMatch(s.T(), []model.Ability{model.Intelligence, model.Wisdom, model.Charisma}, abilities)
+	require.NoError(s.T(), s.db.DeleteCondition(stupefied.ID))
+
+	condition, err = s.db.GetConditionByName("Frightened")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), frightened.ID, condition.ID)
+	condition, err = s.db.GetConditionByName("Fascinated")
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), condition)
+
+	character := &model.Character{Name: "Test Character", UserID: 1}
+	require.NoError(s.T(), s.db.CreateCharacter(character))
+	require.NoError(s.T(), s.db.SetCharacterCondition(&model.CharacterCondition{
+		CharacterID: character.ID,
+		ConditionID: frightened.ID,
+		Count:       2,
+	}))
+	require.NoError(s.T(), s.db.SetCharacterCondition(&model.CharacterCondition{
+		CharacterID: character.ID,
+		ConditionID: frightened.ID,
+		Count:       3,
+	}))
+	conditions, err := s.db.GetCharacterConditions(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), conditions, 1)
+	assert.Equal(s.T(), int8(3), conditions[0].Count)
+	assert.Equal(s.T(), "Frightened", conditions[0].Condition.Name)
+
+	conditions[0].Count = 1
+	require.NoError(s.T(), s.db.UpdateCharacterConditions(conditions))
+	conditions, err = s.db.GetCharacterConditions(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), conditions, 1)
+	assert.Equal(s.T(), int8(1), conditions[0].Count)
+
+	conditions[0].Count = 0
+	require.NoError(s.T(), s.db.UpdateCharacterConditions(conditions))
+	conditions, err = s.db.GetCharacterConditions(character.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), conditions)
+}
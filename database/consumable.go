@@ -0,0 +1,55 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// GetConsumables Returns all consumables, wands and staves
+func (d *GormDatabase) GetConsumables() ([]*model.Consumable, error) {
+	var consumables []*model.Consumable
+	err := d.DB.Preload("Item").Preload("Spell").Find(&consumables).Error
+	return consumables, err
+}
+
+// GetConsumableByID Returns Consumable by ID
+func (d *GormDatabase) GetConsumableByID(id uint) (*model.Consumable, error) {
+	consumable := new(model.Consumable)
+	err := d.DB.Preload("Item").Preload("Spell").Find(consumable, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if consumable.ID == id {
+		return consumable, nil
+	}
+	return nil, err
+}
+
+// CreateConsumable creates Consumable and Item with Owner ID
+func (d *GormDatabase) CreateConsumable(consumable *model.Consumable, item *model.Item) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(consumable).Error; err != nil {
+			return err
+		}
+		item.OwnerID = consumable.ID
+		return tx.Create(item).Error
+	})
+}
+
+// UpdateConsumable updates Consumable and Item with Owner ID
+func (d *GormDatabase) UpdateConsumable(consumable *model.Consumable, item *model.Item) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(consumable).
+			Select("Kind", "Uses", "Charges", "SpellID", "SpellRank").
+			Updates(consumable).Error; err != nil {
+			return err
+		}
+		return tx.Model(item).Select("Level").Updates(item).Error
+	})
+}
+
+// UpdateCharacterItemCharges saves the quantity and the uses or charges left on character item
+func (d *GormDatabase) UpdateCharacterItemCharges(item *model.CharacterItem) error {
+	return d.DB.Model(item).Select("quantity", "charges").Updates(item).Error
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestConsumable() {
+	wand := &model.Consumable{Kind: model.WandItem, Charges: 1, SpellRank: 1}
+	item := &model.Item{Name: "Wand of Heal", Bulk: 0.1, Price: "60 gp", OwnerType: "consumables"}
+	require.NoError(s.T(), s.db.CreateConsumable(wand, item))
+	assert.Equal(s.T(), wand.ID, item.OwnerID)
+
+	wand.Charges = 2
+	wand.SpellRank = 0
+	require.NoError(s.T(), s.db.UpdateConsumable(wand, item))
+	updated, err := s.db.GetConsumableByID(wand.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.WandItem, updated.Kind)
+	assert.Equal(s.T(), uint8(2), updated.Charges)
+	assert.Equal(s.T(), uint8(0), updated.SpellRank)
+	assert.Equal(s.T(), "Wand of Heal", updated.Item.Name)
+
+	character := &model.Character{Name: "Wand Bearer"}
+	require.NoError(s.T(), s.db.DB.Create(character).Error)
+	characterItem := &model.CharacterItem{CharacterID: character.ID, ItemID: item.ID, Quantity: 1}
+	require.NoError(s.T(), s.db.CreateCharacterItem(characterItem))
+	spent := uint8(0)
+	characterItem.Charges = &spent
+	require.NoError(s.T(), s.db.UpdateCharacterItemCharges(characterItem))
+	activated, err := s.db.GetCharacterItemByID(characterItem.ID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), activated.Charges)
+	assert.Equal(s.T(), uint8(0), *activated.Charges)
+
+	require.NoError(s.T(), s.db.RestCharacter(character))
+	rested, err := s.db.GetCharacterItemByID(characterItem.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), rested.Charges)
+
+	require.NoError(s.T(), s.db.DeleteCharacterItem(characterItem.ID))
+	require.NoError(s.T(), s.db.DeleteItem(item.ID, item.OwnerType, wand.ID))
+	deleted, err := s.db.GetConsumableByID(wand.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), deleted)
+}
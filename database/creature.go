@@ -0,0 +1,134 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateCreature creates new Creature with its strikes and defences
+func (d *GormDatabase) CreateCreature(creature *model.Creature) error {
+	return d.DB.Create(creature).Error
+}
+
+// GetCreatureByID returns Creature with traits, spells, strikes and defences or nil
+func (d *GormDatabase) GetCreatureByID(id uint) (*model.Creature, error) {
+	creature := new(model.Creature)
+	err := preloadCreature(d.DB).First(creature, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return creature, err
+}
+
+// GetCreatureByName returns Creature by Name or nil
+func (d *GormDatabase) GetCreatureByName(name string) (*model.Creature, error) {
+	creature := new(model.Creature)
+	err := d.DB.Where("name = ?", name).First(creature).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return creature, err
+}
+
+// GetCreatures returns creatures matching the filter ordered by level and name
+func (d *GormDatabase) GetCreatures(filter *model.CreatureFilter) ([]*model.Creature, error) {
+	var creatures []*model.Creature
+	query := preloadCreature(d.DB).Order("level").Order("name")
+	if filter.Level != nil {
+		query = query.Where("level = ?", *filter.Level)
+	}
+	if filter.MinLevel != nil {
+		query = query.Where("level >= ?", *filter.MinLevel)
+	}
+	if filter.MaxLevel != nil {
+		query = query.Where("level <= ?", *filter.MaxLevel)
+	}
+	if filter.Rarity != "" {
+		query = query.Where("rarity = ?", filter.Rarity)
+	}
+	if filter.Trait != "" {
+		query = query.Where("id IN (?)", d.DB.Table("creature_traits").
+			Select("creature_traits.creature_id").
+			Joins("JOIN traits ON traits.id = creature_traits.trait_id").
+			Where("LOWER(traits.name) = LOWER(?)", filter.Trait))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset)
+	}
+	err := query.Find(&creatures).Error
+	return creatures, err
+}
+
+// UpdateCreature updates Creature and replaces its traits, spells, strikes and defences
+func (d *GormDatabase) UpdateCreature(creature *model.Creature) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Traits", "Spells", "Strikes", "Defences").Save(creature).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(creature).Association("Traits").Replace(creature.Traits); err != nil {
+			return err
+		}
+		if err := tx.Model(creature).Association("Spells").Replace(creature.Spells); err != nil {
+			return err
+		}
+		if err := tx.Where("creature_id = ?", creature.ID).Delete(&model.CreatureStrike{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("creature_id = ?", creature.ID).Delete(&model.CreatureDefence{}).Error; err != nil {
+			return err
+		}
+		for i := range creature.Strikes {
+			creature.Strikes[i].ID = 0
+			creature.Strikes[i].CreatureID = creature.ID
+		}
+		for i := range creature.Defences {
+			creature.Defences[i].ID = 0
+			creature.Defences[i].CreatureID = creature.ID
+		}
+		if len(creature.Strikes) > 0 {
+			if err := tx.Create(&creature.Strikes).Error; err != nil {
+				return err
+			}
+		}
+		if len(creature.Defences) > 0 {
+			return tx.Omit("ImmunityResistanceWeakness").Create(&creature.Defences).Error
+		}
+		return nil
+	})
+}
+
+// DeleteCreature deletes Creature with its strikes and defences
+func (d *GormDatabase) DeleteCreature(id uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		creature := &model.Creature{ID: id}
+		if err := tx.Model(creature).Association("Traits").Clear(); err != nil {
+			return err
+		}
+		if err := tx.Model(creature).Association("Spells").Clear(); err != nil {
+			return err
+		}
+		if err := tx.Where("creature_id = ?", id).Delete(&model.CreatureStrike{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("creature_id = ?", id).Delete(&model.CreatureDefence{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(creature).Error
+	})
+}
+
+// GetImmunityResistanceWeakness returns the damage type or property by name, creating it when missing
+func (d *GormDatabase) GetImmunityResistanceWeakness(name string) (*model.ImmunityResistanceWeakness, error) {
+	irw := &model.ImmunityResistanceWeakness{}
+	err := d.DB.Where(model.ImmunityResistanceWeakness{Name: name}).FirstOrCreate(irw).Error
+	return irw, err
+}
+
+func preloadCreature(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Traits").
+		Preload("Spells").
+		Preload("Strikes").
+		Preload("Defences.ImmunityResistanceWeakness")
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCreature() {
+	creature, err := s.db.GetCreatureByID(1)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), creature)
+
+	goblinTrait := &model.Trait{Name: "Goblin", Description: "Goblin"}
+	require.NoError(s.T(), s.db.CreateTrait(goblinTrait))
+	fire, err := s.db.GetImmunityResistanceWeakness("fire")
+	require.NoError(s.T(), err)
+	again, err := s.db.GetImmunityResistanceWeakness("fire")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fire.ID, again.ID)
+
+	goblin := &model.Creature{
+		Name:       "Goblin Warrior",
+		Level:      -1,
+		ArmorClass: 16,
+		HitPoint:   6,
+		Traits:     []model.Trait{*goblinTrait},
+		Strikes:    []model.CreatureStrike{{Name: "Dogslicer", AttackBonus: 8, Damage: "1d6 slashing"}},
+		Defences:   []model.CreatureDefence{{ImmunityResistanceWeaknessID: fire.ID, Kind: model.Weakness, Value: 2}},
+	}
+	require.NoError(s.T(), s.db.CreateCreature(goblin))
+	require.NoError(s.T(), s.db.CreateCreature(&model.Creature{
+		Name:     "Ogre",
+		Level:    3,
+		Rarity:   model.Uncommon,
+		Size:     model.Large,
+		HitPoint: 50,
+	}))
+
+	creature, err = s.db.GetCreatureByID(goblin.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.Medium, creature.Size)
+	assert.Equal(s.T(), model.Common, creature.Rarity)
+	require.Len(s.T(), creature.Traits, 1)
+	require.Len(s.T(), creature.Strikes, 1)
+	require.Len(s.T(), creature.Defences, 1)
+	assert.Equal(s.T(), "fire", creature.Defences[0].ImmunityResistanceWeakness.Name)
+
+	level := int8(3)
+	creatures, err := s.db.GetCreatures(&model.CreatureFilter{})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), creatures, 2)
+	creatures, err = s.db.GetCreatures(&model.CreatureFilter{MaxLevel: &level, Trait: "goblin"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), creatures, 1)
+	assert.Equal(s.T(), "Goblin Warrior", creatures[0].Name)
+	creatures, err = s.db.GetCreatures(&model.CreatureFilter{Level: &level, Rarity: model.Uncommon})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), creatures, 1)
+	assert.Equal(s.T(), "Ogre", creatures[0].Name)
+
+	creature.Traits = nil
+	creature.Strikes = []model.CreatureStrike{
+		{Name: "Dogslicer", AttackBonus: 8, Damage: "1d6 slashing"},
+		{Name: "Shortbow", Ranged: true, AttackBonus: 6, Damage: "1d6 piercing"},
+	}
+	creature.Defences = nil
+	require.NoError(s.T(), s.db.UpdateCreature(creature))
+	creature, err = s.db.GetCreatureByID(goblin.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), creature.Traits)
+	assert.Len(s.T(), creature.Strikes, 2)
+	assert.Empty(s.T(), creature.Defences)
+
+	require.NoError(s.T(), s.db.DeleteCreature(goblin.ID))
+	creature, err = s.db.GetCreatureByID(goblin.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), creature)
+}
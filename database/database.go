@@ -45,6 +45,8 @@ func New(
 		new(model.Action),
 		new(model.Attribute),
 		new(model.Item),
+		new(model.Archetype),
+		new(model.ArchetypeProficiency),
 		new(model.Feat),
 		new(model.Race),
 		new(model.Ancestry),
@@ -54,18 +56,46 @@ func New(
 		new(model.Armor),
 		new(model.Weapon),
 		new(model.Gear),
+		new(model.Shield),
+		new(model.Consumable),
+		new(model.Rune),
 		new(model.Slot),
 		new(model.CharacterBoost),
+		new(model.AttributeBoost),
 		new(model.Spell),
 		new(model.CharacterDefence),
 		new(model.CharacterSpell),
+		new(model.CharacterPreparedSpell),
+		new(model.CharacterSpellSlot),
+		new(model.SpellCharacterClass),
 		new(model.CharacterFeat),
 		new(model.CharacterSkill),
 		new(model.CharacterInfo),
+		new(model.CharacterLevel),
+		new(model.CharacterLevelChange),
 		new(model.UserCode),
+		new(model.Campaign),
+		new(model.CampaignInvite),
+		new(model.Condition),
+		new(model.ConditionAbility),
+		new(model.CharacterCondition),
+		new(model.HealthLog),
+		new(model.ExperienceLog),
+		new(model.CharacterRoll),
+		new(model.Encounter),
+		new(model.Combatant),
+		new(model.CombatantCondition),
+		new(model.PersistentDamage),
+		new(model.ImmunityResistanceWeakness),
+		new(model.Creature),
+		new(model.CreatureStrike),
+		new(model.CreatureDefence),
 	); err != nil {
 		return nil, err
 	}
+	if err := migrateConditionAbilities(db); err != nil {
+		return nil, err
+	}
 
 	userCount := int64(0)
 	db.Find(new(model.User)).Count(&userCount)
@@ -77,3 +107,17 @@ func New(
 
 	return &GormDatabase{DB: db}, nil
 }
+
+// migrateConditionAbilities moves the single ability of conditions into their ability list
+func migrateConditionAbilities(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&model.Condition{}, "ability") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT INTO condition_abilities (condition_id, ability) " +
+			"SELECT id, ability FROM conditions WHERE ability IS NOT NULL").Error; err != nil {
+			return err
+		}
+		return tx.Migrator().DropColumn(&model.Condition{}, "ability")
+	})
+}
@@ -32,13 +32,48 @@ func (s *DatabaseSuite) BeforeTest(suiteName, testName string) {
 		new(model.Skill),
 		new(model.Race),
 		new(model.Ancestry),
+		new(model.Archetype),
+		new(model.ArchetypeProficiency),
 		new(model.Feat),
 		new(model.Background),
 		new(model.Item),
 		new(model.Armor),
 		new(model.Weapon),
 		new(model.Gear),
+		new(model.Shield),
+		new(model.Consumable),
+		new(model.Rune),
 		new(model.Character),
+		new(model.Attribute),
+		new(model.AttributeBoost),
+		new(model.CharacterDefence),
+		new(model.CharacterSkill),
+		new(model.CharacterFeat),
+		new(model.CharacterItem),
+		new(model.CharacterInfo),
+		new(model.CharacterLevel),
+		new(model.CharacterLevelChange),
+		new(model.Campaign),
+		new(model.CampaignInvite),
+		new(model.Condition),
+		new(model.ConditionAbility),
+		new(model.CharacterCondition),
+		new(model.HealthLog),
+		new(model.ExperienceLog),
+		new(model.CharacterRoll),
+		new(model.Encounter),
+		new(model.Combatant),
+		new(model.CombatantCondition),
+		new(model.PersistentDamage),
+		new(model.Creature),
+		new(model.CreatureStrike),
+		new(model.CreatureDefence),
+		new(model.ImmunityResistanceWeakness),
+		new(model.Spell),
+		new(model.CharacterSpell),
+		new(model.CharacterPreparedSpell),
+		new(model.CharacterSpellSlot),
+		new(model.SpellCharacterClass),
 		new(model.Domain),
 		new(model.God))
 	if err != nil {
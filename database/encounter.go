@@ -0,0 +1,124 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateEncounter creates new Encounter
+func (d *GormDatabase) CreateEncounter(encounter *model.Encounter) error {
+	return d.DB.Create(encounter).Error
+}
+
+// GetEncounterByID returns Encounter with its combatants, their conditions and persistent damage or nil
+func (d *GormDatabase) GetEncounterByID(id uint) (*model.Encounter, error) {
+	encounter := new(model.Encounter)
+	err := d.DB.
+		Preload("Combatants", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Combatants.Conditions.Condition.Abilities").
+		Preload("Combatants.PersistentDamage").
+		First(encounter, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return encounter, err
+}
+
+// GetEncounters returns encounters of Campaign without their combatants
+func (d *GormDatabase) GetEncounters(campaignID uint) ([]*model.Encounter, error) {
+	var encounters []*model.Encounter
+	err := d.DB.Where("campaign_id = ?", campaignID).Order("id").Find(&encounters).Error
+	return encounters, err
+}
+
+// UpdateEncounterTurn saves round and current combatant of Encounter
+func (d *GormDatabase) UpdateEncounterTurn(encounter *model.Encounter) error {
+	return d.DB.Model(encounter).Select("round", "current_combatant_id").Updates(encounter).Error
+}
+
+// DeleteEncounter deletes Encounter with its combatants
+func (d *GormDatabase) DeleteEncounter(id uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		combatants := tx.Model(&model.Combatant{}).Select("id").Where("encounter_id = ?", id)
+		if err := deleteCombatants(tx, combatants); err != nil {
+			return err
+		}
+		return tx.Delete(&model.Encounter{}, id).Error
+	})
+}
+
+// CreateCombatant adds Combatant to Encounter
+func (d *GormDatabase) CreateCombatant(combatant *model.Combatant) error {
+	return d.DB.Create(combatant).Error
+}
+
+// UpdateCombatant saves initiative, hit points and reaction of Combatant
+func (d *GormDatabase) UpdateCombatant(combatant *model.Combatant) error {
+	return d.DB.Model(combatant).
+		Select("initiative", "hit_point", "temporary_hit_point", "reaction_used").
+		Updates(combatant).Error
+}
+
+// DeleteCombatant removes Combatant from Encounter
+func (d *GormDatabase) DeleteCombatant(id uint) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		return deleteCombatants(tx, []uint{id})
+	})
+}
+
+// SetCombatantCondition applies condition to Combatant or changes its count
+func (d *GormDatabase) SetCombatantCondition(combatantCondition *model.CombatantCondition) error {
+	return d.DB.
+		Where(model.CombatantCondition{
+			CombatantID: combatantCondition.CombatantID,
+			ConditionID: combatantCondition.ConditionID,
+		}).
+		Assign(model.CombatantCondition{Count: combatantCondition.Count}).
+		FirstOrCreate(combatantCondition).Error
+}
+
+// DeleteCombatantCondition removes condition from Combatant
+func (d *GormDatabase) DeleteCombatantCondition(combatantID uint, conditionID uint) error {
+	return d.DB.Where("combatant_id = ? AND condition_id = ?", combatantID, conditionID).
+		Delete(&model.CombatantCondition{}).Error
+}
+
+// UpdateCombatantConditions saves changed counts and removes conditions with count 0
+func (d *GormDatabase) UpdateCombatantConditions(conditions []model.CombatantCondition) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for i := range conditions {
+			var err error
+			if conditions[i].Count <= 0 {
+				err = tx.Delete(&model.CombatantCondition{}, conditions[i].ID).Error
+			} else {
+				err = tx.Model(&conditions[i]).Select("count").Updates(&conditions[i]).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CreatePersistentDamage adds persistent damage to Combatant
+func (d *GormDatabase) CreatePersistentDamage(persistentDamage *model.PersistentDamage) error {
+	return d.DB.Create(persistentDamage).Error
+}
+
+// DeletePersistentDamage ends persistent damage of Combatant
+func (d *GormDatabase) DeletePersistentDamage(combatantID uint, id uint) error {
+	return d.DB.Where("combatant_id = ? AND id = ?", combatantID, id).
+		Delete(&model.PersistentDamage{}).Error
+}
+
+func deleteCombatants(tx *gorm.DB, ids interface{}) error {
+	if err := tx.Where("combatant_id IN (?)", ids).Delete(&model.CombatantCondition{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("combatant_id IN (?)", ids).Delete(&model.PersistentDamage{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("id IN (?)", ids).Delete(&model.Combatant{}).Error
+}
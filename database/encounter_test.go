@@ -0,0 +1,70 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestEncounter() {
+	encounter, err := s.db.GetEncounterByID(1)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), encounter)
+
+	campaign := &model.Campaign{Name: "Abomination Vaults", GameMasterID: 1}
+	require.NoError(s.T(), s.db.CreateCampaign(campaign))
+	encounter = &model.Encounter{CampaignID: campaign.ID, Name: "Goblin ambush"}
+	require.NoError(s.T(), s.db.CreateEncounter(encounter))
+	goblin := &model.Combatant{EncounterID: encounter.ID, Name: "Goblin", MaxHitPoint: 6, HitPoint: 6}
+	require.NoError(s.T(), s.db.CreateCombatant(goblin))
+	frightened := &model.Condition{Name: "Frightened", Valued: true, Decrement: true}
+	require.NoError(s.T(), s.db.CreateCondition(frightened))
+	require.NoError(s.T(), s.db.SetCombatantCondition(&model.CombatantCondition{
+		CombatantID: goblin.ID,
+		ConditionID: frightened.ID,
+		Count:       2,
+	}))
+	require.NoError(s.T(), s.db.CreatePersistentDamage(&model.PersistentDamage{
+		CombatantID: goblin.ID,
+		Notation:    "1d6",
+		DamageType:  "fire",
+	}))
+
+	initiative := 14
+	goblin.Initiative = &initiative
+	goblin.HitPoint = 2
+	require.NoError(s.T(), s.db.UpdateCombatant(goblin))
+	encounter.Round = 1
+	encounter.CurrentCombatantID = &goblin.ID
+	require.NoError(s.T(), s.db.UpdateEncounterTurn(encounter))
+
+	encounter, err = s.db.GetEncounterByID(encounter.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint16(1), encounter.Round)
+	require.Len(s.T(), encounter.Combatants, 1)
+	combatant := encounter.Combatants[0]
+	assert.Equal(s.T(), 14, *combatant.Initiative)
+	assert.Equal(s.T(), uint16(2), combatant.HitPoint)
+	require.Len(s.T(), combatant.Conditions, 1)
+	assert.Equal(s.T(), "Frightened", combatant.Conditions[0].Condition.Name)
+	require.Len(s.T(), combatant.PersistentDamage, 1)
+
+	combatant.Conditions[0].Count = 0
+	require.NoError(s.T(), s.db.UpdateCombatantConditions(combatant.Conditions))
+	require.NoError(s.T(), s.db.DeletePersistentDamage(combatant.ID, combatant.PersistentDamage[0].ID))
+	encounter, err = s.db.GetEncounterByID(encounter.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), encounter.Combatants[0].Conditions)
+	assert.Empty(s.T(), encounter.Combatants[0].PersistentDamage)
+
+	encounters, err := s.db.GetEncounters(campaign.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), encounters, 1)
+	require.NoError(s.T(), s.db.DeleteEncounter(encounter.ID))
+	encounter, err = s.db.GetEncounterByID(encounter.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), encounter)
+	var count int64
+	s.db.DB.Model(&model.Combatant{}).Count(&count)
+	assert.Zero(s.T(), count)
+}
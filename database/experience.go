@@ -0,0 +1,30 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// AwardExperience saves experience and milestones of the characters and records the awards
+func (d *GormDatabase) AwardExperience(characters []*model.Character, logs []*model.ExperienceLog) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, character := range characters {
+			if err := tx.Model(character).Select("experience", "level_ready").Updates(character).Error; err != nil {
+				return err
+			}
+		}
+		for _, experienceLog := range logs {
+			if err := tx.Create(experienceLog).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetExperienceLogs returns the experience awards of Character, the latest first
+func (d *GormDatabase) GetExperienceLogs(characterID uint) ([]*model.ExperienceLog, error) {
+	var experienceLogs []*model.ExperienceLog
+	err := d.DB.Where("character_id = ?", characterID).Order("id desc").Find(&experienceLogs).Error
+	return experienceLogs, err
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestExperience() {
+	character := &model.Character{Name: "Test Character", UserID: 2}
+	require.NoError(s.T(), s.db.CreateCharacter(character))
+
+	character.Experience = 80
+	require.NoError(s.T(), s.db.AwardExperience([]*model.Character{character}, []*model.ExperienceLog{
+		{CharacterID: character.ID, AwardedByID: 1, Kind: model.ExperienceEncounter, Amount: 80, Experience: 80},
+	}))
+	character.Experience = 110
+	character.LevelReady = true
+	require.NoError(s.T(), s.db.AwardExperience([]*model.Character{character}, []*model.ExperienceLog{
+		{CharacterID: character.ID, AwardedByID: 1, Kind: model.ExperienceHazard, Amount: 30, Experience: 110},
+	}))
+
+	saved := &model.Character{}
+	require.NoError(s.T(), s.db.DB.First(saved, character.ID).Error)
+	assert.Equal(s.T(), uint16(110), saved.Experience)
+	assert.True(s.T(), saved.LevelReady)
+	experienceLogs, err := s.db.GetExperienceLogs(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), experienceLogs, 2)
+	assert.Equal(s.T(), model.ExperienceHazard, experienceLogs[0].Kind)
+}
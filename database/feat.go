@@ -12,7 +12,7 @@ func (d *GormDatabase) CreateFeat(feat *model.Feat) error { return d.DB.Create(&
 // GetFeatByID Returns Feat object by ID
 func (d *GormDatabase) GetFeatByID(id uint) (*model.Feat, error) {
 	feat := new(model.Feat)
-	err := d.DB.Preload("Traits").Find(&feat, id).Error
+	err := preloadFeat(d.DB).Find(&feat, id).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
@@ -39,10 +39,10 @@ func (d *GormDatabase) GetFeatByName(name string) (*model.Feat, error) {
 func (d *GormDatabase) GetFeats(limit int, offset int) (*[]model.Feat, error) {
 	var feats []model.Feat
 	if limit == 0 {
-		err := d.DB.Preload("Traits").Find(&feats).Error
+		err := preloadFeat(d.DB).Find(&feats).Error
 		return &feats, err
 	}
-	err := d.DB.Preload("Traits").Limit(limit).Offset(offset).Find(&feats).Error
+	err := preloadFeat(d.DB).Limit(limit).Offset(offset).Find(&feats).Error
 	return &feats, err
 }
 
@@ -55,3 +55,55 @@ func (d *GormDatabase) DeleteFeat(id uint) error {
 func (d *GormDatabase) UpdateFeat(feat *model.Feat) error {
 	return d.DB.Save(&feat).Error
 }
+
+// FilterFeats returns Feats by category, class, race, archetype, highest level and trait ordered by level and name
+func (d *GormDatabase) FilterFeats(filter *model.FeatFilter) ([]*model.Feat, error) {
+	var feats []*model.Feat
+	query := preloadFeat(d.DB).Order("level").Order("name")
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	if filter.ClassID != nil {
+		query = query.Where("character_class_id = ?", *filter.ClassID)
+	}
+	if filter.AncestryID != nil {
+		query = query.Where("race_id = ?", *filter.AncestryID)
+	}
+	if filter.ArchetypeID != nil {
+		query = query.Where("archetype_id = ?", *filter.ArchetypeID)
+	}
+	if filter.MaxLevel != nil {
+		query = query.Where("level <= ?", *filter.MaxLevel)
+	}
+	if filter.Trait != "" {
+		query = query.Where("id IN (?)", d.DB.Table("feat_traits").
+			Select("feat_traits.feat_id").
+			Joins("JOIN traits ON traits.id = feat_traits.trait_id").
+			Where("LOWER(traits.name) = LOWER(?)", filter.Trait))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset)
+	}
+	err := query.Find(&feats).Error
+	return feats, err
+}
+
+// FindFeats returns Feats by IDs
+func (d *GormDatabase) FindFeats(IDs []uint) ([]model.Feat, error) {
+	var feats []model.Feat
+	err := d.DB.Where("id IN (?)", IDs).Find(&feats).Error
+	return feats, err
+}
+
+// SetFeatPrerequisites replaces prerequisite Feats of the Feat
+func (d *GormDatabase) SetFeatPrerequisites(feat *model.Feat, prerequisites []model.Feat) error {
+	return d.DB.Model(feat).Association("PrerequisiteFeats").Replace(prerequisites)
+}
+
+func preloadFeat(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Traits").
+		Preload("PrerequisiteFeats").
+		Preload("CharacterClass").
+		Preload("Race")
+}
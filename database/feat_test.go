@@ -7,7 +7,7 @@ import (
 )
 
 func (s *DatabaseSuite) TestFeat() {
-	feats, err := s.db.GetFeats()
+	feats, err := s.db.GetFeats(0, 0)
 	require.NoError(s.T(), err)
 	assert.Empty(s.T(), feats)
 
@@ -27,11 +27,11 @@ func (s *DatabaseSuite) TestFeat() {
 	testBackground := &model.Background{
 		Name:        "Test Background",
 		Description: "Test Description",
-		FeatID:      testFeat.ID,
+		FeatID:      &testFeat.ID,
 	}
 	require.NoError(s.T(), s.db.CreateBackground(testBackground))
 	assert.Equal(s.T(), testBackground.Description, "Test Description")
-	assert.Equal(s.T(), testBackground.FeatID, uint(1))
+	assert.Equal(s.T(), *testBackground.FeatID, uint(1))
 
 	backgrounds, err = s.db.GetBackgrounds()
 	require.NoError(s.T(), err)
@@ -49,7 +49,7 @@ func (s *DatabaseSuite) TestFeat() {
 
 	err = s.db.DeleteFeat(testFeat.ID)
 	require.NoError(s.T(), err)
-	feats, err = s.db.GetFeats()
+	feats, err = s.db.GetFeats(0, 0)
 	require.NoError(s.T(), err)
 	assert.Empty(s.T(), feats)
 
@@ -59,3 +59,86 @@ func (s *DatabaseSuite) TestFeat() {
 	require.NoError(s.T(), err)
 	assert.Empty(s.T(), backgrounds)
 }
+
+func (s *DatabaseSuite) TestFeatPrerequisites() {
+	first := &model.Feat{Name: "First Feat", Level: 1}
+	second := &model.Feat{Name: "Second Feat", Level: 2}
+	third := &model.Feat{Name: "Third Feat", Level: 4}
+	for _, feat := range []*model.Feat{first, second, third} {
+		require.NoError(s.T(), s.db.CreateFeat(feat))
+	}
+
+	prerequisites, err := s.db.FindFeats([]uint{first.ID, second.ID})
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.db.SetFeatPrerequisites(third, prerequisites))
+
+	feat, err := s.db.GetFeatByID(third.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), feat.PrerequisiteFeats, 2)
+
+	require.NoError(s.T(), s.db.SetFeatPrerequisites(third, []model.Feat{*first}))
+	feat, err = s.db.GetFeatByID(third.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), feat.PrerequisiteFeats, 1)
+	assert.Equal(s.T(), first.ID, feat.PrerequisiteFeats[0].ID)
+
+	maxLevel := uint8(2)
+	feats, err := s.db.FilterFeats(&model.FeatFilter{MaxLevel: &maxLevel})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), feats, 2)
+	assert.Equal(s.T(), "First Feat", feats[0].Name)
+
+	for _, feat := range []*model.Feat{third, second, first} {
+		require.NoError(s.T(), s.db.DeleteFeat(feat.ID))
+	}
+}
+
+func (s *DatabaseSuite) TestFilterFeats() {
+	characterClass := &model.CharacterClass{Name: "Feat Filter Class"}
+	require.NoError(s.T(), s.db.DB.Create(characterClass).Error)
+	trait := &model.Trait{Name: "Flourish"}
+	require.NoError(s.T(), s.db.DB.Create(trait).Error)
+
+	classFeat := &model.Feat{
+		Name:             "Class Feat",
+		Level:            2,
+		Category:         model.ClassFeat,
+		CharacterClassID: &characterClass.ID,
+		Traits:           []model.Trait{*trait},
+	}
+	generalFeat := &model.Feat{Name: "General Feat", Level: 3}
+	require.NoError(s.T(), s.db.CreateFeat(classFeat))
+	require.NoError(s.T(), s.db.CreateFeat(generalFeat))
+	assert.Equal(s.T(), model.GeneralFeat, generalFeat.Category)
+	assert.Error(s.T(), s.db.CreateFeat(&model.Feat{Name: "Broken Feat", Category: "Mythic"}))
+
+	feats, err := s.db.FilterFeats(&model.FeatFilter{Category: model.ClassFeat})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), feats, 1)
+	require.NotNil(s.T(), feats[0].CharacterClass)
+	assert.Equal(s.T(), characterClass.Name, feats[0].CharacterClass.Name)
+
+	feats, err = s.db.FilterFeats(&model.FeatFilter{ClassID: &characterClass.ID})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), feats, 1)
+
+	feats, err = s.db.FilterFeats(&model.FeatFilter{Trait: "flourish"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), feats, 1)
+	assert.Equal(s.T(), classFeat.ID, feats[0].ID)
+
+	maxLevel := uint8(2)
+	feats, err = s.db.FilterFeats(&model.FeatFilter{MaxLevel: &maxLevel})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), feats, 1)
+
+	feats, err = s.db.FilterFeats(&model.FeatFilter{Limit: 1, Offset: 1})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), feats, 1)
+	assert.Equal(s.T(), generalFeat.ID, feats[0].ID)
+
+	require.NoError(s.T(), s.db.DeleteFeat(classFeat.ID))
+	require.NoError(s.T(), s.db.DeleteFeat(generalFeat.ID))
+	require.NoError(s.T(), s.db.DB.Delete(trait).Error)
+	require.NoError(s.T(), s.db.DB.Delete(characterClass).Error)
+}
@@ -47,7 +47,7 @@ func (d *GormDatabase) UpdateGear(Gear *model.Gear, item *model.Item) error {
 		if err := tx.Updates(Gear).Error; err != nil {
 			return err
 		}
-		if err := tx.Model(&item).Select("Level").Updates(item).Error; err != nil {
+		if err := tx.Model(&item).Select("Level", "Capacity", "BulkReduction").Updates(item).Error; err != nil {
 			return err
 		}
 		return nil
@@ -26,6 +26,16 @@ func (d *GormDatabase) GetItemByID(id uint) (*model.Item, error) {
 	return nil, err
 }
 
+// GetItemByName Returns Item of the owner type by name
+func (d *GormDatabase) GetItemByName(name string, ownerType string) (*model.Item, error) {
+	item := model.Item{}
+	err := d.DB.Where("name = ? AND owner_type = ?", name, ownerType).First(&item).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return &item, err
+}
+
 // DeleteItem Deletes item by ID
 func (d *GormDatabase) DeleteItem(id uint, ownerType string, ownerID uint) error {
 	err := d.DB.Transaction(func(tx *gorm.DB) error {
@@ -45,6 +55,16 @@ func (d *GormDatabase) DeleteItem(id uint, ownerType string, ownerID uint) error
 			if err != nil {
 				return err
 			}
+		case "shields":
+			err := d.DB.Delete(&model.Shield{}, "id = ?", ownerID).Error
+			if err != nil {
+				return err
+			}
+		case "consumables":
+			err := d.DB.Delete(&model.Consumable{}, "id = ?", ownerID).Error
+			if err != nil {
+				return err
+			}
 		}
 		err := d.DB.Delete(&model.Item{}, "id = ?", id).Error
 		if err != nil {
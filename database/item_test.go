@@ -11,8 +11,15 @@ func (s *DatabaseSuite) TestItem() {
 	require.NoError(s.T(), err)
 	assert.Nil(s.T(), item)
 
+	dexCap := uint8(1)
 	armor := &model.Armor{
-		ArmorClass: 2,
+		ArmorClass:   2,
+		Category:     model.MediumArmor,
+		DexCap:       &dexCap,
+		CheckPenalty: 2,
+		SpeedPenalty: 5,
+		Strength:     16,
+		ArmorGroup:   "chain",
 	}
 	testArmor := &model.Item{
 		Name:        "Test Armor",
@@ -26,6 +33,24 @@ func (s *DatabaseSuite) TestItem() {
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), testArmor.Name, "Test Armor")
 
+	armorItem, err := s.db.GetItemByName("Test Armor", "armors")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), testArmor.ID, armorItem.ID)
+	_, err = s.db.GetItemByName("Test Armor", "weapons")
+	assert.Error(s.T(), err)
+
+	armor.Category = model.HeavyArmor
+	armor.DexCap = nil
+	armor.CheckPenalty = 0
+	require.NoError(s.T(), s.db.UpdateArmor(armor, testArmor))
+	updatedArmor, err := s.db.GetArmorByID(armor.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.HeavyArmor, updatedArmor.Category)
+	assert.Nil(s.T(), updatedArmor.DexCap)
+	assert.Equal(s.T(), uint8(0), updatedArmor.CheckPenalty)
+	assert.Equal(s.T(), uint8(16), updatedArmor.Strength)
+	assert.Empty(s.T(), updatedArmor.Traits)
+
 	weapon := &model.Weapon{}
 	testWeapon := &model.Item{
 		Name:        "Test Weapon",
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// UpdatePurse saves the coins of Character
+func (d *GormDatabase) UpdatePurse(character *model.Character) error {
+	return updatePurse(d.DB, character)
+}
+
+// BuyCharacterItem saves the coins left to Character and adds the bought item or its quantity in one transaction
+func (d *GormDatabase) BuyCharacterItem(character *model.Character, item *model.CharacterItem) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := updatePurse(tx, character); err != nil {
+			return err
+		}
+		if item.ID == 0 {
+			return tx.Create(item).Error
+		}
+		return tx.Model(item).Select("quantity").Updates(item).Error
+	})
+}
+
+// SellCharacterItem saves the coins earned to Character and the quantity left of the item in one transaction,
+// an item sold out is deleted
+func (d *GormDatabase) SellCharacterItem(character *model.Character, item *model.CharacterItem) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := updatePurse(tx, character); err != nil {
+			return err
+		}
+		if item.Quantity == 0 {
+			return deleteCharacterItem(tx, item.ID)
+		}
+		return tx.Model(item).Select("quantity").Updates(item).Error
+	})
+}
+
+// UpdateItemPrices saves the copper pieces of items in one transaction
+func (d *GormDatabase) UpdateItemPrices(items []*model.Item) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if err := tx.Model(item).Select("price_copper").Updates(item).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func updatePurse(tx *gorm.DB, character *model.Character) error {
+	return tx.Model(&model.Character{ID: character.ID}).Updates(map[string]interface{}{
+		"copper":   character.Purse.Copper,
+		"silver":   character.Purse.Silver,
+		"gold":     character.Purse.Gold,
+		"platinum": character.Purse.Platinum,
+	}).Error
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestPurse() {
+	character := &model.Character{Name: "Merchant", Purse: model.Purse{Gold: 15}}
+	require.NoError(s.T(), s.db.DB.Create(character).Error)
+	torch := &model.Gear{}
+	item := &model.Item{Name: "Purse Torch", Bulk: 0.1, Price: "1 cp", OwnerType: "gears"}
+	require.NoError(s.T(), s.db.CreateGear(torch, item))
+	price := uint(1)
+	item.PriceCopper = &price
+	require.NoError(s.T(), s.db.UpdateItemPrices([]*model.Item{item}))
+
+	character.Purse = model.Purse{Copper: 8, Silver: 9, Gold: 14}
+	characterItem := &model.CharacterItem{CharacterID: character.ID, ItemID: item.ID, Quantity: 2}
+	require.NoError(s.T(), s.db.BuyCharacterItem(character, characterItem))
+	bought, err := s.db.GetCharacterItemByID(characterItem.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint(2), bought.Quantity)
+	require.NotNil(s.T(), bought.Item.PriceCopper)
+	assert.Equal(s.T(), uint(1), *bought.Item.PriceCopper)
+	assert.Equal(s.T(), model.Purse{Copper: 8, Silver: 9, Gold: 14}, bought.Character.Purse)
+
+	character.Purse.Copper = 9
+	characterItem.Quantity = 1
+	require.NoError(s.T(), s.db.SellCharacterItem(character, characterItem))
+	sold, err := s.db.GetCharacterItemByID(characterItem.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint(1), sold.Quantity)
+
+	characterItem.Quantity = 0
+	require.NoError(s.T(), s.db.SellCharacterItem(character, characterItem))
+	sold, err = s.db.GetCharacterItemByID(characterItem.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), sold)
+
+	character.Purse = model.Purse{Platinum: 2}
+	require.NoError(s.T(), s.db.UpdatePurse(character))
+	updated := &model.Character{}
+	require.NoError(s.T(), s.db.DB.First(updated, character.ID).Error)
+	assert.Equal(s.T(), model.Purse{Platinum: 2}, updated.Purse)
+
+	require.NoError(s.T(), s.db.DeleteItem(item.ID, item.OwnerType, torch.ID))
+}
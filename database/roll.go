@@ -0,0 +1,29 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateCharacterRolls saves rolls of Character in one transaction
+func (d *GormDatabase) CreateCharacterRolls(rolls ...*model.CharacterRoll) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, roll := range rolls {
+			if err := tx.Create(roll).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetCharacterRolls returns roll history of Character, the latest first
+func (d *GormDatabase) GetCharacterRolls(characterID uint, limit int, offset int) ([]*model.CharacterRoll, error) {
+	var rolls []*model.CharacterRoll
+	query := d.DB.Where("character_id = ?", characterID).Order("id desc")
+	if limit > 0 {
+		query = query.Limit(limit).Offset(offset)
+	}
+	err := query.Find(&rolls).Error
+	return rolls, err
+}
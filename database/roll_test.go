@@ -0,0 +1,29 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestCharacterRolls() {
+	dc := 15
+	require.NoError(s.T(), s.db.CreateCharacterRolls(
+		&model.CharacterRoll{CharacterID: 1, UserID: 1, Kind: model.RollStrike, Notation: "1d20+7", Total: 19, DC: &dc},
+		&model.CharacterRoll{CharacterID: 1, UserID: 1, Kind: model.RollDamage, Notation: "1d8+4", Total: 9},
+	))
+	require.NoError(s.T(), s.db.CreateCharacterRolls(
+		&model.CharacterRoll{CharacterID: 2, UserID: 2, Kind: model.RollSkill, Notation: "1d20+3", Total: 11},
+	))
+
+	rolls, err := s.db.GetCharacterRolls(1, 0, 0)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rolls, 2)
+	assert.Equal(s.T(), model.RollDamage, rolls[0].Kind)
+	assert.Equal(s.T(), dc, *rolls[1].DC)
+
+	rolls, err = s.db.GetCharacterRolls(1, 1, 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rolls, 1)
+	assert.Equal(s.T(), model.RollStrike, rolls[0].Kind)
+}
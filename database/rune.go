@@ -0,0 +1,46 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// CreateRune creates new Rune
+func (d *GormDatabase) CreateRune(propertyRune *model.Rune) error {
+	return d.DB.Create(propertyRune).Error
+}
+
+// GetRuneByID returns Rune by ID or nil
+func (d *GormDatabase) GetRuneByID(id uint) (*model.Rune, error) {
+	propertyRune := new(model.Rune)
+	err := d.DB.First(propertyRune, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return propertyRune, err
+}
+
+// GetRunes returns all Runes
+func (d *GormDatabase) GetRunes() ([]*model.Rune, error) {
+	var runes []*model.Rune
+	err := d.DB.Order("name").Find(&runes).Error
+	return runes, err
+}
+
+// FindRunes returns Runes by IDs
+func (d *GormDatabase) FindRunes(IDs []uint) ([]model.Rune, error) {
+	var runes []model.Rune
+	err := d.DB.Where("id IN (?)", IDs).Find(&runes).Error
+	return runes, err
+}
+
+// UpdateRune updates Rune
+func (d *GormDatabase) UpdateRune(propertyRune *model.Rune) error {
+	return d.DB.Save(propertyRune).Error
+}
+
+// DeleteRune deletes Rune
+func (d *GormDatabase) DeleteRune(id uint) error {
+	return d.DB.Select("CharacterItem").Delete(&model.Rune{ID: id}).Error
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+	"kingdom/rules"
+)
+
+func (s *DatabaseSuite) TestRune() {
+	flaming := &model.Rune{Name: "Flaming", Target: "weapons", Level: 8, DamageDice: 6, DamageType: "fire"}
+	require.NoError(s.T(), s.db.CreateRune(flaming))
+	frost := &model.Rune{Name: "Frost", Target: "weapons", Level: 8, DamageDice: 6, DamageType: "cold"}
+	require.NoError(s.T(), s.db.CreateRune(frost))
+	runes, err := s.db.GetRunes()
+	require.NoError(s.T(), err)
+	require.Len(s.T(), runes, 2)
+	assert.Equal(s.T(), "Flaming", runes[0].Name)
+
+	character := &model.Character{Name: "Runesmith"}
+	require.NoError(s.T(), s.db.DB.Create(character).Error)
+	weapon := &model.Weapon{DiceQuantity: 1, Dice: 8}
+	item := &model.Item{Name: "Rune Longsword", Bulk: 1, OwnerType: "weapons"}
+	require.NoError(s.T(), s.db.CreateWeapon(weapon, item))
+	longsword := &model.CharacterItem{CharacterID: character.ID, ItemID: item.ID, Quantity: 1}
+	require.NoError(s.T(), s.db.CreateCharacterItem(longsword))
+	// a second copy of the same longsword is its own character item
+	stack := &model.CharacterItem{CharacterID: character.ID, ItemID: item.ID, Quantity: 2}
+	require.NoError(s.T(), s.db.CreateCharacterItem(stack))
+	spare, err := s.db.SplitCharacterItem(stack)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint(1), spare.Quantity)
+	assert.NotEqual(s.T(), stack.ID, spare.ID)
+
+	found, err := s.db.FindRunes([]uint{flaming.ID, frost.ID})
+	require.NoError(s.T(), err)
+	longsword.Potency = 2
+	longsword.Striking = 1
+	longsword.Runes = found
+	require.NoError(s.T(), s.db.UpdateCharacterItemRunes(longsword))
+	etched, err := s.db.GetCharacterItemByID(longsword.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint8(2), etched.Potency)
+	assert.Equal(s.T(), uint8(1), etched.Striking)
+	assert.Len(s.T(), etched.Runes, 2)
+
+	require.NoError(s.T(), rules.TransferRunes(etched, spare, &model.TransferRunes{
+		TargetID: spare.ID,
+		Potency:  true,
+		Striking: true,
+		RunesID:  []uint{flaming.ID, frost.ID},
+	}))
+	require.NoError(s.T(), s.db.UpdateCharacterItemRunes(etched, spare))
+	items, err := s.db.GetCharacterItems(character.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 3)
+	assert.Equal(s.T(), uint8(0), items[0].Potency)
+	assert.Empty(s.T(), items[0].Runes)
+	assert.Equal(s.T(), uint(1), items[1].Quantity)
+	assert.Empty(s.T(), items[1].Runes)
+	assert.Equal(s.T(), uint8(2), items[2].Potency)
+	assert.Equal(s.T(), uint8(1), items[2].Striking)
+	assert.Len(s.T(), items[2].Runes, 2)
+
+	require.NoError(s.T(), s.db.DeleteRune(frost.ID))
+	deleted, err := s.db.GetRuneByID(frost.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), deleted)
+	etched, err = s.db.GetCharacterItemByID(spare.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), etched.Runes, 1)
+
+	require.NoError(s.T(), s.db.DeleteCharacterItem(longsword.ID))
+	require.NoError(s.T(), s.db.DeleteCharacterItem(stack.ID))
+	require.NoError(s.T(), s.db.DeleteCharacterItem(spare.ID))
+	require.NoError(s.T(), s.db.DeleteItem(item.ID, item.OwnerType, weapon.ID))
+	require.NoError(s.T(), s.db.DeleteRune(flaming.ID))
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"kingdom/model"
+)
+
+// GetShields Returns all shields
+func (d *GormDatabase) GetShields() ([]*model.Shield, error) {
+	var shields []*model.Shield
+	err := d.DB.Preload("Item").Find(&shields).Error
+	return shields, err
+}
+
+// GetShieldByID Returns Shield by ID
+func (d *GormDatabase) GetShieldByID(id uint) (*model.Shield, error) {
+	shield := new(model.Shield)
+	err := d.DB.Preload("Item").Find(shield, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if shield.ID == id {
+		return shield, nil
+	}
+	return nil, err
+}
+
+// CreateShield creates Shield and Item with Owner ID
+func (d *GormDatabase) CreateShield(shield *model.Shield, item *model.Item) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(shield).Error; err != nil {
+			return err
+		}
+		item.OwnerID = shield.ID
+		return tx.Create(item).Error
+	})
+}
+
+// UpdateShield updates Shield and Item with Owner ID
+func (d *GormDatabase) UpdateShield(shield *model.Shield, item *model.Item) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(shield).
+			Select("ArmorClass", "Hardness", "HitPoint", "BrokenThreshold").
+			Updates(shield).Error; err != nil {
+			return err
+		}
+		return tx.Model(item).Select("Level").Updates(item).Error
+	})
+}
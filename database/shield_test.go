@@ -0,0 +1,39 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kingdom/model"
+)
+
+func (s *DatabaseSuite) TestShield() {
+	shield := &model.Shield{ArmorClass: 2, Hardness: 5, HitPoint: 20, BrokenThreshold: 10}
+	item := &model.Item{
+		Name:        "Steel Shield",
+		Description: "Test Description",
+		Bulk:        1,
+		Level:       1,
+		Price:       "2 gp",
+		OwnerType:   "shields",
+	}
+	require.NoError(s.T(), s.db.CreateShield(shield, item))
+	assert.Equal(s.T(), shield.ID, item.OwnerID)
+
+	shield.Hardness = 8
+	shield.BrokenThreshold = 0
+	require.NoError(s.T(), s.db.UpdateShield(shield, item))
+	updated, err := s.db.GetShieldByID(shield.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint8(8), updated.Hardness)
+	assert.Equal(s.T(), uint16(0), updated.BrokenThreshold)
+	assert.Equal(s.T(), "Steel Shield", updated.Item.Name)
+
+	shields, err := s.db.GetShields()
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), shields, 1)
+
+	require.NoError(s.T(), s.db.DeleteItem(item.ID, item.OwnerType, shield.ID))
+	deleted, err := s.db.GetShieldByID(shield.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), deleted)
+}
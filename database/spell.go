@@ -58,3 +58,10 @@ func (d *GormDatabase) DeleteSpell(id uint) error {
 	return d.DB.Where("id = ?", id).
 		Delete(&model.Spell{}).Error
 }
+
+// FindSpells returns Spells by IDs
+func (d *GormDatabase) FindSpells(IDs []uint) ([]model.Spell, error) {
+	var spells []model.Spell
+	err := d.DB.Where("id IN (?)", IDs).Find(&spells).Error
+	return spells, err
+}
@@ -11,7 +11,7 @@ func (d *GormDatabase) GetUserByUsername(name string) (*model.User, error) {
 	user := new(model.User)
 	err := d.DB.Where("username = ?", name).First(user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, err
+		return nil, nil
 	}
 	if user.Username == name {
 		return user, err
@@ -37,7 +37,7 @@ func (d *GormDatabase) GetUserByID(id uint) (*model.User, error) {
 	user := new(model.User)
 	err := d.DB.Preload("Characters").First(user, id).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, err
+		return nil, nil
 	}
 	if user.ID == id {
 		return user, err
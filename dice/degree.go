@@ -0,0 +1,34 @@
+package dice
+
+// Degree is the degree of success of a check
+type Degree string
+
+const (
+	CriticalSuccess Degree = "Critical Success"
+	Success         Degree = "Success"
+	Failure         Degree = "Failure"
+	CriticalFailure Degree = "Critical Failure"
+)
+
+var degrees = []Degree{CriticalFailure, Failure, Success, CriticalSuccess}
+
+// DegreeOf returns the degree of success of the total against the DC,
+// a natural 20 improves it by one step and a natural 1 worsens it
+func DegreeOf(total int, natural int, dc int) Degree {
+	step := 1
+	switch {
+	case total >= dc+10:
+		step = 3
+	case total >= dc:
+		step = 2
+	case total <= dc-10:
+		step = 0
+	}
+	if natural == 20 && step < 3 {
+		step++
+	}
+	if natural == 1 && step > 0 {
+		step--
+	}
+	return degrees[step]
+}
@@ -0,0 +1,168 @@
+package dice
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxDice and MaxTerms limit the dice and the terms of a whole expression so a roll can't exhaust the server
+const (
+	MaxDice  = 100
+	MaxTerms = 20
+)
+
+// Source returns a random number in [0, n)
+type Source interface {
+	Intn(n int) int
+}
+
+// CryptoSource draws numbers from crypto/rand
+type CryptoSource struct{}
+
+func (CryptoSource) Intn(n int) int {
+	value, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(value.Int64())
+}
+
+// Term is a group of dice like 4d6kh3 or a flat number when Sides is 0
+type Term struct {
+	Sign     int
+	Quantity int
+	Sides    int
+	KeepHigh int
+	KeepLow  int
+	Flat     int
+}
+
+// Expression is a parsed dice notation
+type Expression struct {
+	Notation string
+	Terms    []Term
+}
+
+// Die is a single rolled die
+type Die struct {
+	Sides int  `json:"sides"`
+	Value int  `json:"value"`
+	Kept  bool `json:"kept"`
+}
+
+// Result is a rolled expression
+type Result struct {
+	Notation string `json:"notation"`
+	Dice     []Die  `json:"dice"`
+	Total    int    `json:"total"`
+}
+
+var termPattern = regexp.MustCompile(`^(\d*)d(\d+)(?:(kh|kl)(\d+))?$|^(\d+)$`)
+
+// Parse reads notation like 2d8+4, 4d6kh3 or 1d20+12
+func Parse(notation string) (*Expression, error) {
+	compact := strings.ToLower(strings.ReplaceAll(notation, " ", ""))
+	if compact == "" {
+		return nil, fmt.Errorf("empty dice notation")
+	}
+	expression := &Expression{Notation: compact}
+	sign := 1
+	start := 0
+	dice := 0
+	for i := 0; i <= len(compact); i++ {
+		if i < len(compact) && compact[i] != '+' && compact[i] != '-' {
+			continue
+		}
+		if i == start {
+			if i == 0 && i < len(compact) {
+				sign = signOf(compact[i])
+				start = i + 1
+				continue
+			}
+			return nil, fmt.Errorf("wrong dice notation %q", notation)
+		}
+		term, err := parseTerm(compact[start:i], sign)
+		if err != nil {
+			return nil, err
+		}
+		dice += term.Quantity
+		if dice > MaxDice || len(expression.Terms) == MaxTerms {
+			return nil, fmt.Errorf("dice notation %q rolls more than %d dice or %d terms", notation, MaxDice, MaxTerms)
+		}
+		expression.Terms = append(expression.Terms, term)
+		if i < len(compact) {
+			sign = signOf(compact[i])
+		}
+		start = i + 1
+	}
+	return expression, nil
+}
+
+func parseTerm(text string, sign int) (Term, error) {
+	match := termPattern.FindStringSubmatch(text)
+	if match == nil {
+		return Term{}, fmt.Errorf("wrong dice term %q", text)
+	}
+	if match[5] != "" {
+		flat, err := strconv.Atoi(match[5])
+		return Term{Sign: sign, Flat: flat}, err
+	}
+	term := Term{Sign: sign, Quantity: 1}
+	if match[1] != "" {
+		term.Quantity, _ = strconv.Atoi(match[1])
+	}
+	term.Sides, _ = strconv.Atoi(match[2])
+	if term.Quantity < 1 || term.Quantity > MaxDice || term.Sides < 1 || term.Sides > 1000 {
+		return Term{}, fmt.Errorf("wrong dice term %q", text)
+	}
+	if match[3] != "" {
+		keep, _ := strconv.Atoi(match[4])
+		if keep < 1 || keep > term.Quantity {
+			return Term{}, fmt.Errorf("wrong dice term %q", text)
+		}
+		if match[3] == "kh" {
+			term.KeepHigh = keep
+		} else {
+			term.KeepLow = keep
+		}
+	}
+	return term, nil
+}
+
+func signOf(char byte) int {
+	if char == '-' {
+		return -1
+	}
+	return 1
+}
+
+// Natural returns the value of the kept d20 of a check, 0 when the expression isn't a check
+func (r *Result) Natural() int {
+	natural := 0
+	for _, die := range r.Dice {
+		if die.Sides == 20 && die.Kept {
+			if natural != 0 {
+				return 0
+			}
+			natural = die.Value
+		}
+	}
+	return natural
+}
+
+// String formats the rolled dice like 1d20+5 = [14]+5 = 19
+func (r *Result) String() string {
+	var values []string
+	for _, die := range r.Dice {
+		if die.Kept {
+			values = append(values, strconv.Itoa(die.Value))
+		} else {
+			values = append(values, "~"+strconv.Itoa(die.Value))
+		}
+	}
+	return fmt.Sprintf("%s = [%s] = %d", r.Notation, strings.Join(values, ", "), r.Total)
+}
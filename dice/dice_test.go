@@ -0,0 +1,89 @@
+package dice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+// fixedSource returns the queued die values minus one
+type fixedSource struct {
+	values []int
+}
+
+func (s *fixedSource) Intn(n int) int {
+	value := s.values[0]
+	s.values = s.values[1:]
+	return value - 1
+}
+
+func roller(values ...int) *Roller {
+	return NewRoller(&fixedSource{values: values})
+}
+
+func TestParse(t *testing.T) {
+	expression, err := Parse("2d8 + 4")
+	require.NoError(t, err)
+	assert.Equal(t, []Term{{Sign: 1, Quantity: 2, Sides: 8}, {Sign: 1, Flat: 4}}, expression.Terms)
+
+	expression, err = Parse("4d6kh3-1")
+	require.NoError(t, err)
+	assert.Equal(t, []Term{{Sign: 1, Quantity: 4, Sides: 6, KeepHigh: 3}, {Sign: -1, Flat: 1}}, expression.Terms)
+
+	expression, err = Parse("d20")
+	require.NoError(t, err)
+	assert.Equal(t, []Term{{Sign: 1, Quantity: 1, Sides: 20}}, expression.Terms)
+
+	for _, notation := range []string{"", "2d", "d20+", "2d8++4", "1d20kh2", "1000d6", "fireball"} {
+		_, err = Parse(notation)
+		assert.Error(t, err, notation)
+	}
+
+	// the limits hold for the whole expression, not each term
+	_, err = Parse("60d6+40d6")
+	assert.NoError(t, err)
+	_, err = Parse("60d6+41d6")
+	assert.EqualError(t, err, `dice notation "60d6+41d6" rolls more than 100 dice or 20 terms`)
+	_, err = Parse(strings.Repeat("1+", MaxTerms) + "1")
+	assert.Error(t, err)
+}
+
+func TestRoll(t *testing.T) {
+	result, err := roller(3, 5).RollNotation("2d8+4", Normal)
+	require.NoError(t, err)
+	assert.Equal(t, 12, result.Total)
+	assert.Equal(t, "2d8+4 = [3, 5] = 12", result.String())
+	assert.Equal(t, 0, result.Natural())
+
+	result, err = roller(6, 1, 4, 3).RollNotation("4d6kh3", Normal)
+	require.NoError(t, err)
+	assert.Equal(t, 13, result.Total)
+	assert.False(t, result.Dice[1].Kept)
+
+	result, err = roller(6, 1, 4).RollNotation("3d6kl1", Normal)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+
+	result, err = roller(7, 15).RollNotation("1d20+12", Fortune)
+	require.NoError(t, err)
+	assert.Equal(t, 27, result.Total)
+	assert.Equal(t, 15, result.Natural())
+
+	result, err = roller(7, 15).RollNotation("1d20+12", Misfortune)
+	require.NoError(t, err)
+	assert.Equal(t, 19, result.Total)
+
+	assert.Equal(t, Normal, ModeOf(true, true))
+}
+
+func TestDegreeOf(t *testing.T) {
+	assert.Equal(t, CriticalSuccess, DegreeOf(25, 12, 15))
+	assert.Equal(t, Success, DegreeOf(15, 5, 15))
+	assert.Equal(t, Failure, DegreeOf(14, 9, 15))
+	assert.Equal(t, CriticalFailure, DegreeOf(5, 2, 15))
+	assert.Equal(t, CriticalSuccess, DegreeOf(20, 20, 15))
+	assert.Equal(t, Success, DegreeOf(10, 20, 15))
+	assert.Equal(t, Failure, DegreeOf(16, 1, 15))
+	assert.Equal(t, CriticalFailure, DegreeOf(3, 1, 15))
+}
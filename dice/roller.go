@@ -0,0 +1,106 @@
+package dice
+
+import "sort"
+
+// Mode selects how many times a roll is made and which result counts
+type Mode int
+
+const (
+	Normal Mode = iota
+	// Fortune rolls twice and keeps the higher result
+	Fortune
+	// Misfortune rolls twice and keeps the lower result
+	Misfortune
+)
+
+// Roller rolls expressions with its source of randomness
+type Roller struct {
+	source Source
+}
+
+// NewRoller returns roller drawing from the source, tests inject a fixed one
+func NewRoller(source Source) *Roller {
+	return &Roller{source: source}
+}
+
+// Die rolls one die with the sides
+func (r *Roller) Die(sides int) int {
+	return r.source.Intn(sides) + 1
+}
+
+// Roll rolls the expression, fortune and misfortune cancel each other out
+func (r *Roller) Roll(expression *Expression, mode Mode) *Result {
+	result := r.roll(expression)
+	if mode == Normal {
+		return result
+	}
+	second := r.roll(expression)
+	if (mode == Fortune) == (second.Total > result.Total) {
+		return second
+	}
+	return result
+}
+
+// RollNotation parses the notation and rolls it
+func (r *Roller) RollNotation(notation string, mode Mode) (*Result, error) {
+	expression, err := Parse(notation)
+	if err != nil {
+		return nil, err
+	}
+	return r.Roll(expression, mode), nil
+}
+
+func (r *Roller) roll(expression *Expression) *Result {
+	result := &Result{Notation: expression.Notation}
+	for _, term := range expression.Terms {
+		if term.Sides == 0 {
+			result.Total += term.Sign * term.Flat
+			continue
+		}
+		dice := make([]Die, term.Quantity)
+		for i := range dice {
+			dice[i] = Die{Sides: term.Sides, Value: r.Die(term.Sides), Kept: true}
+		}
+		keep(dice, term)
+		for _, die := range dice {
+			if die.Kept {
+				result.Total += term.Sign * die.Value
+			}
+		}
+		result.Dice = append(result.Dice, dice...)
+	}
+	return result
+}
+
+// keep marks dice dropped by the kh and kl modifiers of the term
+func keep(dice []Die, term Term) {
+	if term.KeepHigh == 0 && term.KeepLow == 0 {
+		return
+	}
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return dice[order[i]].Value > dice[order[j]].Value })
+	kept := term.KeepHigh
+	if term.KeepLow > 0 {
+		kept = term.KeepLow
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+	for _, index := range order[kept:] {
+		dice[index].Kept = false
+	}
+}
+
+// ModeOf returns the mode of a roll with the given fortune and misfortune effects
+func ModeOf(fortune bool, misfortune bool) Mode {
+	switch {
+	case fortune && !misfortune:
+		return Fortune
+	case misfortune && !fortune:
+		return Misfortune
+	}
+	return Normal
+}
@@ -218,7 +218,7 @@ const docTemplate = `{
         },
         "/admin/csv": {
             "post": {
-                "description": "Permissions for Admin, csv - Tradition, Character Class, Trait, Action, Skill, Feat, Spell, Race, Ancestry, Background",
+                "description": "Permissions for Admin, csv - Tradition, Character Class, Trait, Action, Skill, Feat, Spell, Race, Ancestry, Background, Condition, Creature, Armor",
                 "consumes": [
                     "application/json"
                 ],
@@ -245,6 +245,41 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/prices": {
+            "post": {
+                "description": "Permissions for Admin, reports the items with a price that isn't in cp, sp, gp or pp,\nthey keep their copper pieces",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Converts item prices to copper pieces",
+                "responses": {
+                    "200": {
+                        "description": "Migration report",
+                        "schema": {
+                            "$ref": "#/definitions/model.PriceMigrationExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/ancestry": {
             "get": {
                 "description": "Return all GetAncestries",
@@ -446,9 +481,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/attribute/{id}": {
+        "/archetype": {
             "get": {
-                "description": "Permissions for auth user or admin",
+                "description": "Permissions for auth users",
                 "consumes": [
                     "application/json"
                 ],
@@ -456,13 +491,95 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Attribute"
+                    "Archetype"
                 ],
-                "summary": "Returns attribute by id",
+                "summary": "Returns Archetypes",
+                "responses": {
+                    "200": {
+                        "description": "Archetype details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ArchetypeExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin, a multiclass archetype links the class it grants proficiencies or spellcasting from,\nproficiency target is a defence column (e.g. martial_weapon, heavy_armor, will) or a skill name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Archetype"
+                ],
+                "summary": "Create and returns Archetype",
+                "parameters": [
+                    {
+                        "description": "Archetype data",
+                        "name": "archetype",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ArchetypeCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Archetype details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ArchetypeExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong archetype data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/archetype/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Archetype"
+                ],
+                "summary": "Returns Archetype by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "character_id",
+                        "description": "Archetype id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -470,13 +587,58 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Attribute details",
+                        "description": "Archetype details",
                         "schema": {
-                            "$ref": "#/definitions/model.AttributeExternal"
+                            "$ref": "#/definitions/model.ArchetypeExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Attribute not found",
+                        "description": "Archetype doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin, its feats stay as plain feats",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Archetype"
+                ],
+                "summary": "Deletes Archetype by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Archetype id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Archetype doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -484,7 +646,7 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "Permissions for Character's User or Admin",
+                "description": "Permissions for Admin, feats and proficiencies are replaced",
                 "consumes": [
                     "application/json"
                 ],
@@ -492,26 +654,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Attribute"
+                    "Archetype"
                 ],
-                "summary": "Updates Attribute by ID or nil",
+                "summary": "Updates Archetype by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Attribute id",
+                        "description": "Archetype id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Attribute data",
-                        "name": "attribute",
+                        "description": "Archetype data",
+                        "name": "archetype",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateAttribute"
+                            "$ref": "#/definitions/model.ArchetypeCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Archetype details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ArchetypeExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong archetype data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Archetype doesn't exist",
+                        "schema": {
+                            "type": "string"
                         }
                     }
+                }
+            }
+        },
+        "/attribute/{id}": {
+            "get": {
+                "description": "Permissions for auth user or admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attribute"
+                ],
+                "summary": "Returns attribute by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "character_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -521,7 +733,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Attribute doesn't exist",
+                        "description": "Attribute not found",
                         "schema": {
                             "type": "string"
                         }
@@ -767,9 +979,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/character": {
+        "/campaign": {
             "get": {
-                "description": "Return all characters for current user",
+                "description": "Campaigns the user runs as Game Master or plays in",
                 "consumes": [
                     "application/json"
                 ],
@@ -777,14 +989,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character"
+                    "Campaign"
                 ],
-                "summary": "Returns all characters",
+                "summary": "Returns Campaigns of current user",
                 "responses": {
                     "200": {
-                        "description": "Character details",
+                        "description": "Campaign details",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CampaignExternal"
+                            }
                         }
                     },
                     "401": {
@@ -796,7 +1011,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create new character",
+                "description": "Current user becomes Game Master of the Campaign",
                 "consumes": [
                     "application/json"
                 ],
@@ -804,25 +1019,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character"
+                    "Campaign"
                 ],
-                "summary": "Create and returns character or nil",
+                "summary": "Create and returns Campaign",
                 "parameters": [
                     {
-                        "description": "Character data",
-                        "name": "character",
+                        "description": "Campaign data",
+                        "name": "campaign",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateCharacter"
+                            "$ref": "#/definitions/model.CreateCampaign"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Character details",
+                        "description": "Campaign details",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "$ref": "#/definitions/model.CampaignExternal"
                         }
                     },
                     "401": {
@@ -834,9 +1049,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/character-item": {
-            "post": {
-                "description": "Permissions for Admin",
+        "/campaign/invite": {
+            "get": {
+                "description": "Invites sent to the email of current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -844,25 +1059,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Item"
-                ],
-                "summary": "Create and returns CharacterItem or nil",
-                "parameters": [
-                    {
-                        "description": "CharacterItem data",
-                        "name": "characterItem",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateCharacterItem"
-                        }
-                    }
+                    "Campaign"
                 ],
+                "summary": "Returns pending Campaign invites of current user",
                 "responses": {
-                    "201": {
-                        "description": "CharacterItem details",
+                    "200": {
+                        "description": "Invite details",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterItemExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CampaignInviteExternal"
+                            }
                         }
                     },
                     "401": {
@@ -870,19 +1077,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
             }
         },
-        "/character-item/list/{character_id}": {
+        "/campaign/{id}": {
             "get": {
-                "description": "Return all CharacterItems",
+                "description": "Permissions for Game Master and players of the Campaign",
                 "consumes": [
                     "application/json"
                 ],
@@ -890,37 +1091,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Item"
+                    "Campaign"
                 ],
-                "summary": "Returns all CharacterItems",
+                "summary": "Returns Campaign by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character id",
-                        "name": "character_id",
+                        "description": "Campaign id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "CharacterItem details",
+                        "description": "Campaign details",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterItemExternal"
+                            "$ref": "#/definitions/model.CampaignExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/character-item/{id}": {
-            "get": {
-                "description": "Retrieve CharacterItem details using its ID",
+            },
+            "delete": {
+                "description": "Permissions for Game Master, characters stay with their players",
                 "consumes": [
                     "application/json"
                 ],
@@ -928,35 +1133,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Item"
+                    "Campaign"
                 ],
-                "summary": "Returns CharacterItem by id",
+                "summary": "Deletes Campaign by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "CharacterItem id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "CharacterItem details",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterItemExternal"
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "CharacterItem not found",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Permissions for Admin",
+            "patch": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -964,21 +1172,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Item"
+                    "Campaign"
                 ],
-                "summary": "Deletes CharacterItem by ID or returns nil",
+                "summary": "Updates Campaign by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "CharacterItem id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Campaign data",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCampaign"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Campaign details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CampaignExternal"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -987,15 +1207,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "CharacterItem doesn't exist",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/campaign/{id}/character/{character_id}": {
+            "delete": {
+                "description": "Permissions for Game Master and Character's User",
                 "consumes": [
                     "application/json"
                 ],
@@ -1003,33 +1225,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Item"
+                    "Campaign"
                 ],
-                "summary": "Updates CharacterItem by ID or nil",
+                "summary": "Detaches Character from Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "CharacterItem id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "CharacterItem data",
-                        "name": "characterItem",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateCharacterItem"
-                        }
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "character_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "CharacterItem details",
-                        "schema": {
-                            "$ref": "#/definitions/model.CharacterItemExternal"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -1038,17 +1255,15 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "CharacterItem doesn't exist",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/character-skill": {
-            "post": {
-                "description": "Permissions for Admin",
+            },
+            "patch": {
+                "description": "Permissions for Game Master, values are added to the current ones. A negative hit point change\nis damage and a positive one is healing, both follow the health rules and are logged",
                 "consumes": [
                     "application/json"
                 ],
@@ -1056,29 +1271,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Skill"
+                    "Campaign"
                 ],
-                "summary": "Create and returns Character Skill or nil",
+                "summary": "Adjusts hit points and experience of Character in Campaign",
                 "parameters": [
                     {
-                        "description": "Action data",
-                        "name": "characterSkill",
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "character_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Adjustments",
+                        "name": "adjust",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterSkillCreate"
+                            "$ref": "#/definitions/model.AdjustCampaignCharacter"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Action details",
+                    "200": {
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterSkillExternal"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Character is dead",
                         "schema": {
                             "type": "string"
                         }
@@ -1088,13 +1317,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Character doesn't play in this campaign",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/character-skill/{id}": {
-            "get": {
-                "description": "Return all Character Skills",
+        "/campaign/{id}/character/{character_id}/condition": {
+            "post": {
+                "description": "Permissions for Game Master, applying a present condition changes its count",
                 "consumes": [
                     "application/json"
                 ],
@@ -1102,35 +1337,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Skill"
+                    "Condition"
                 ],
-                "summary": "Returns all Character Skills",
+                "summary": "Applies condition to Character in Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character Skill id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "character_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Condition data",
+                        "name": "condition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCharacterCondition"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Character Skill details",
+                        "description": "character conditions",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterSkill"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterConditionExternal"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Wrong condition count",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Condition not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/campaign/{id}/character/{character_id}/condition/{condition_id}": {
+            "delete": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1138,32 +1406,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Skill"
+                    "Condition"
                 ],
-                "summary": "Updates Character Skill by ID or nil",
+                "summary": "Removes condition from Character in Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character Skill id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Character Skill data",
-                        "name": "characterSkill",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CharacterSkillUpdate"
-                        }
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "character_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Condition id",
+                        "name": "condition_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Action details",
+                        "description": "character conditions",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterSkillExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterConditionExternal"
+                            }
                         }
                     },
                     "403": {
@@ -1173,7 +1449,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Character Skill doesn't exist",
+                        "description": "Condition not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1181,9 +1457,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/character/{id}": {
-            "get": {
-                "description": "Retrieve Character details using its ID",
+        "/campaign/{id}/character/{character_id}/end-turn": {
+            "post": {
+                "description": "Permissions for Game Master, decrements conditions that fade at the end of turn",
                 "consumes": [
                     "application/json"
                 ],
@@ -1191,35 +1467,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character"
+                    "Condition"
                 ],
-                "summary": "Returns Character by id",
+                "summary": "Ends turn of Character in Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "character_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "character details",
+                        "description": "character conditions",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterConditionExternal"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Character not found",
+                        "description": "Character doesn't play in this campaign",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Character's User or Admin",
+            }
+        },
+        "/campaign/{id}/encounter": {
+            "get": {
+                "description": "Permissions for Game Master and players of the Campaign, combatants are not included",
                 "consumes": [
                     "application/json"
                 ],
@@ -1227,21 +1521,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character"
+                    "Encounter"
                 ],
-                "summary": "Deletes Character by ID or returns nil",
+                "summary": "Returns Encounters of Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "encounters",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.EncounterExternal"
+                            }
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -1250,15 +1550,15 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Character doesn't exist",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "patch": {
-                "description": "Permissions for Character's User or Admin",
+            "post": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1266,36 +1566,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character"
+                    "Encounter"
                 ],
-                "summary": "Updates Character by ID or nil",
+                "summary": "Creates Encounter in Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Character data",
-                        "name": "character",
+                        "description": "Encounter data",
+                        "name": "encounter",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterUpdate"
+                            "$ref": "#/definitions/model.CreateEncounter"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Character details",
+                    "201": {
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Character doesn't exist",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1303,9 +1609,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/character_boost/{id}": {
+        "/campaign/{id}/encounter/{encounter_id}": {
             "get": {
-                "description": "Permissions for auth user or admin",
+                "description": "Permissions for Game Master and players of the Campaign, combatants in initiative order\nwith hit points, conditions, reactions and persistent damage",
                 "consumes": [
                     "application/json"
                 ],
@@ -1313,35 +1619,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Boost"
+                    "Encounter"
                 ],
-                "summary": "Returns Boost by id",
+                "summary": "Returns state of Encounter",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "character_id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Boost details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterBoostExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Boost not found",
+                        "description": "Encounter not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "patch": {
-                "description": "Permissions for Character's User or Admin",
+            "delete": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1349,36 +1668,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Boost"
+                    "Encounter"
                 ],
-                "summary": "Updates Boost by ID or nil",
+                "summary": "Deletes Encounter",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Boost id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Boost data",
-                        "name": "Boost",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateCharacterBoost"
-                        }
-                    }
-                ],
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Boost details",
+                        "description": "ok",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterBoostExternal"
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Boost doesn't exist",
+                        "description": "Encounter not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1386,9 +1709,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/character_feat": {
+        "/campaign/{id}/encounter/{encounter_id}/combatant": {
             "post": {
-                "description": "Create new character",
+                "description": "Permissions for Game Master, a character must play in the Campaign, a creature of the bestiary\ntakes its statistics from there and an ad-hoc creature needs name, hit points and armor class",
                 "consumes": [
                     "application/json"
                 ],
@@ -1396,29 +1719,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Feat"
+                    "Encounter"
                 ],
-                "summary": "Create and returns character feat or nil",
+                "summary": "Adds Combatant to Encounter",
                 "parameters": [
                     {
-                        "description": "Character feat data",
-                        "name": "characterFeat",
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Combatant data",
+                        "name": "combatant",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateCharacterFeat"
+                            "$ref": "#/definitions/model.CreateCombatant"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Character feat details",
+                    "200": {
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterFeatExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Creature needs a name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Encounter not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1426,9 +1775,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/class": {
-            "get": {
-                "description": "Return all characters for current user",
+        "/campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}": {
+            "delete": {
+                "description": "Permissions for Game Master, the turn passes to the next combatant when the current one is removed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1436,26 +1785,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Class"
+                    "Encounter"
+                ],
+                "summary": "Removes Combatant from Encounter",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all characters",
                 "responses": {
                     "200": {
-                        "description": "Character details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Combatant not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create new Character Class",
+            "patch": {
+                "description": "Permissions for Game Master. Negative hit point deals damage and positive heals,\non a character it goes through its health log. Temporary hit points are set on creatures only",
                 "consumes": [
                     "application/json"
                 ],
@@ -1463,29 +1841,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Class"
+                    "Encounter"
                 ],
-                "summary": "Create and returns new Character Class or nil",
+                "summary": "Updates Combatant of Encounter",
                 "parameters": [
                     {
-                        "description": "Character Class data",
-                        "name": "god",
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Combatant data",
+                        "name": "combatant",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterClassCreate"
+                            "$ref": "#/definitions/model.UpdateCombatant"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Character Class details",
+                    "200": {
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterClass"
+                            "$ref": "#/definitions/model.EncounterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Character is dead",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Combatant not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1493,9 +1904,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/class-feature/all/{id}": {
-            "get": {
-                "description": "Retrieve All Class Feature details using its ID",
+        "/campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/condition": {
+            "post": {
+                "description": "Permissions for Game Master, conditions of a character are stored on the character.\nDying and wounded follow from hit points",
                 "consumes": [
                     "application/json"
                 ],
@@ -1503,27 +1914,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Class Feature"
+                    "Encounter"
                 ],
-                "summary": "Returns All Class Feature by id",
+                "summary": "Applies condition to Combatant",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Class Feature id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Condition data",
+                        "name": "condition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCharacterCondition"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "All Class Feature details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.ClassFeatureExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong condition count",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Class Feature not found",
+                        "description": "Condition not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1531,9 +1977,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/class-feature/{id}": {
-            "get": {
-                "description": "Retrieve Class Feature details using its ID",
+        "/campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/condition/{condition_id}": {
+            "delete": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1541,27 +1987,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Class Feature"
+                    "Encounter"
                 ],
-                "summary": "Returns Class Feature by id",
+                "summary": "Removes condition from Combatant",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Class Feature id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Condition id",
+                        "name": "condition_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Class Feature details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.ClassFeatureExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Class Feature not found",
+                        "description": "Combatant not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1569,9 +2042,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/class/{id}": {
-            "get": {
-                "description": "Retrieve Character class details using its ID",
+        "/campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/persistent-damage": {
+            "post": {
+                "description": "Permissions for Game Master, the damage is rolled at the end of the combatant turn\nfollowed by a DC 15 flat check to end it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1579,35 +2052,72 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Class"
+                    "Encounter"
                 ],
-                "summary": "Returns Character by id",
+                "summary": "Adds persistent damage to Combatant",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Persistent damage data",
+                        "name": "damage",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePersistentDamage"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "character details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong dice notation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Character not found",
+                        "description": "Combatant not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/campaign/{id}/encounter/{encounter_id}/combatant/{combatant_id}/persistent-damage/{damage_id}": {
             "delete": {
-                "description": "Permissions for Character's User or Admin",
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1615,21 +2125,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Class"
+                    "Encounter"
                 ],
-                "summary": "Deletes Character by ID or returns nil",
+                "summary": "Ends persistent damage of Combatant",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Combatant id",
+                        "name": "combatant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Persistent damage id",
+                        "name": "damage_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "encounter state",
+                        "schema": {
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -1638,15 +2172,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Character doesn't exist",
+                        "description": "Combatant not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Character's User or Admin",
+            }
+        },
+        "/campaign/{id}/encounter/{encounter_id}/initiative": {
+            "post": {
+                "description": "Permissions for Game Master. Characters roll Perception or the skill chosen by combatant id,\ncreatures roll with their initiative modifier",
                 "consumes": [
                     "application/json"
                 ],
@@ -1654,36 +2190,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Character Class"
+                    "Encounter"
                 ],
-                "summary": "Updates Character by ID or nil",
+                "summary": "Rolls initiative of Combatants without one",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Character id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Character data",
-                        "name": "character",
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skills by combatant id",
+                        "name": "initiative",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterUpdate"
+                            "$ref": "#/definitions/model.RollInitiative"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Character details",
+                        "description": "encounter state",
                         "schema": {
-                            "$ref": "#/definitions/model.CharacterExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown skill",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Character doesn't exist",
+                        "description": "Encounter not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1691,9 +2245,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/domain": {
-            "get": {
-                "description": "Return all domains",
+        "/campaign/{id}/encounter/{encounter_id}/next-turn": {
+            "post": {
+                "description": "Permissions for Game Master. At the end of turn conditions like frightened decrease and persistent\ndamage is rolled with a flat check to end it. The next combatant regains its reaction and\nlowers its shield, a new round begins after the last combatant",
                 "consumes": [
                     "application/json"
                 ],
@@ -1701,26 +2255,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Encounter"
+                ],
+                "summary": "Ends the current turn and starts the next one",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Encounter id",
+                        "name": "encounter_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all domains",
                 "responses": {
                     "200": {
-                        "description": "Domain details",
+                        "description": "encounter state with the log of the turn change",
                         "schema": {
-                            "$ref": "#/definitions/model.DomainExternal"
+                            "$ref": "#/definitions/model.EncounterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Encounter has no combatants",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Encounter not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/campaign/{id}/experience": {
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Permissions for Game Master, every listed character or the whole party gets the full amount.\nNot available in campaigns with milestone levelling",
                 "consumes": [
                     "application/json"
                 ],
@@ -1728,29 +2312,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Experience"
                 ],
-                "summary": "Create and returns domain or nil",
+                "summary": "Awards experience to the party of Campaign",
                 "parameters": [
                     {
-                        "description": "Domain data",
-                        "name": "domain",
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Award data",
+                        "name": "award",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateDomain"
+                            "$ref": "#/definitions/model.AwardExperience"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Domain details",
+                    "200": {
+                        "description": "Campaign details",
                         "schema": {
-                            "$ref": "#/definitions/model.DomainExternal"
+                            "$ref": "#/definitions/model.CampaignExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Campaign uses milestone levelling",
                         "schema": {
                             "type": "string"
                         }
@@ -1760,13 +2351,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Character doesn't play in this campaign",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/domain/load": {
+        "/campaign/{id}/invite": {
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Permissions for Game Master, the invite is sent by email",
                 "consumes": [
                     "application/json"
                 ],
@@ -1774,24 +2371,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Campaign"
+                ],
+                "summary": "Invites player to Campaign by email",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invite data",
+                        "name": "invite",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCampaignInvite"
+                        }
+                    }
                 ],
-                "summary": "Create Domain from csv file on server or nil",
                 "responses": {
                     "201": {
-                        "description": "Domain details",
+                        "description": "Invite details",
                         "schema": {
-                            "$ref": "#/definitions/model.DomainExternal"
+                            "$ref": "#/definitions/model.CampaignInviteExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "404": {
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1799,9 +2414,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/domain/{id}": {
-            "get": {
-                "description": "Retrieve Domain details using its ID",
+        "/campaign/{id}/join": {
+            "post": {
+                "description": "Permissions for Character's User invited to the Campaign, an invite lets in one character once\nPermissions for Character's User invited to the Campaign",
                 "consumes": [
                     "application/json"
                 ],
@@ -1809,35 +2424,57 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Campaign"
                 ],
-                "summary": "Returns Domain by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "domain id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Character data",
+                        "name": "join",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.JoinCampaign"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "domain details",
+                        "description": "Campaign details",
                         "schema": {
-                            "$ref": "#/definitions/model.DomainExternal"
+                            "$ref": "#/definitions/model.CampaignExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Character already plays in a campaign",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Domain not found",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/campaign/{id}/milestone": {
+            "post": {
+                "description": "Permissions for Game Master, only in campaigns with milestone levelling.\nThe listed characters or the whole party become ready to level up",
                 "consumes": [
                     "application/json"
                 ],
@@ -1845,21 +2482,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Experience"
                 ],
-                "summary": "Deletes Domain by ID or returns nil",
+                "summary": "Grants a level up milestone to the party of Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Domain id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Milestone data",
+                        "name": "milestone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AwardMilestone"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Campaign details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CampaignExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Campaign uses experience levelling",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -1868,15 +2523,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Domain doesn't exist",
+                        "description": "Character doesn't play in this campaign",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/campaign/{id}/party": {
+            "get": {
+                "description": "Permissions for Game Master",
                 "consumes": [
                     "application/json"
                 ],
@@ -1884,32 +2541,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Domain"
+                    "Campaign"
                 ],
-                "summary": "Updates Domain by ID or nil",
+                "summary": "Returns sheets of all Characters in Campaign",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Domain id",
+                        "description": "Campaign id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Domain data",
-                        "name": "character",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateDomain"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Domain details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.DomainExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterExternal"
+                            }
                         }
                     },
                     "403": {
@@ -1919,7 +2570,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Domain doesn't exist",
+                        "description": "Campaign not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1927,9 +2578,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/feat": {
+        "/character": {
             "get": {
-                "description": "Return all Feats",
+                "description": "Return all characters for current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1937,28 +2588,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Feat"
-                ],
-                "summary": "Returns all Feats",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Limit for pagination",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
-                    }
+                    "Character"
                 ],
+                "summary": "Returns all characters",
                 "responses": {
                     "200": {
-                        "description": "Feat details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.FeatExternal"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
                     "401": {
@@ -1970,7 +2607,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Create new character",
                 "consumes": [
                     "application/json"
                 ],
@@ -1978,25 +2615,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Feat"
+                    "Character"
                 ],
-                "summary": "Create and returns Feat or nil",
+                "summary": "Create and returns character or nil",
                 "parameters": [
                     {
-                        "description": "Feat data",
-                        "name": "feat",
+                        "description": "Character data",
+                        "name": "character",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateFeat"
+                            "$ref": "#/definitions/model.CreateCharacter"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Feat details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.FeatExternal"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
                     "401": {
@@ -2004,19 +2641,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
             }
         },
-        "/feat/{id}": {
-            "get": {
-                "description": "Permissions for auth users",
+        "/character-item": {
+            "post": {
+                "description": "Permissions for Character's User or Admin, a stowed item goes in a container of the character,\nbulk and the Encumbered condition are recomputed from the inventory",
                 "consumes": [
                     "application/json"
                 ],
@@ -2024,23 +2655,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Feat"
+                    "Character Item"
                 ],
-                "summary": "Returns Feat by ID",
+                "summary": "Create and returns CharacterItem or nil",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Feat id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "CharacterItem data",
+                        "name": "characterItem",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCharacterItem"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Feat details",
+                    "201": {
+                        "description": "CharacterItem details",
                         "schema": {
-                            "$ref": "#/definitions/model.FeatExternal"
+                            "$ref": "#/definitions/model.CharacterItemExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong container",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -2048,11 +2687,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character-item/list/{character_id}": {
+            "get": {
+                "description": "Return all CharacterItems with the bulk computed from them",
                 "consumes": [
                     "application/json"
                 ],
@@ -2060,38 +2707,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Feat"
+                    "Character Item"
                 ],
-                "summary": "Deletes Feat by ID or returns nil",
+                "summary": "Returns all CharacterItems",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Feat id",
-                        "name": "id",
+                        "description": "Character id",
+                        "name": "character_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "200": {
+                        "description": "CharacterItem details",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.CharacterItemExternal"
                         }
                     },
-                    "404": {
-                        "description": "Domain doesn't exist",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character-item/{id}": {
+            "get": {
+                "description": "Retrieve CharacterItem details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2099,52 +2745,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Feat"
+                    "Character Item"
                 ],
-                "summary": "Updates Feat by ID or nil",
+                "summary": "Returns CharacterItem by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Feat id",
+                        "description": "CharacterItem id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Feat data",
-                        "name": "Feat",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateFeat"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Feat details",
-                        "schema": {
-                            "$ref": "#/definitions/model.FeatExternal"
-                        }
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
+                        "description": "CharacterItem details",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.CharacterItemExternal"
                         }
                     },
                     "404": {
-                        "description": "Feat doesn't exist",
+                        "description": "CharacterItem not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/god": {
-            "get": {
-                "description": "Return all gods and their domains",
+            },
+            "delete": {
+                "description": "Permissions for Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2152,26 +2781,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "God"
+                    "Character Item"
+                ],
+                "summary": "Deletes CharacterItem by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "CharacterItem id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all gods",
                 "responses": {
-                    "200": {
-                        "description": "Character details",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
-                            "$ref": "#/definitions/model.God"
+                            "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "CharacterItem doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create new God",
+            "patch": {
+                "description": "Permissions for Character's User or Admin, without placement the item stays where it is,\nbulk and the Encumbered condition are recomputed from the inventory",
                 "consumes": [
                     "application/json"
                 ],
@@ -2179,29 +2820,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "God"
+                    "Character Item"
                 ],
-                "summary": "Create and returns new God or nil",
+                "summary": "Updates CharacterItem by ID or nil",
                 "parameters": [
                     {
-                        "description": "God data",
-                        "name": "god",
+                        "type": "integer",
+                        "description": "CharacterItem id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CharacterItem data",
+                        "name": "characterItem",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.GodCreate"
+                            "$ref": "#/definitions/model.UpdateCharacterItem"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "God details",
+                    "200": {
+                        "description": "CharacterItem details",
                         "schema": {
-                            "$ref": "#/definitions/model.God"
+                            "$ref": "#/definitions/model.CharacterItemExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Wrong container",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "CharacterItem doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -2209,9 +2869,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/god/{id}": {
-            "get": {
-                "description": "Retrieve God details using its ID",
+        "/character-item/{id}/activate": {
+            "post": {
+                "description": "Permissions for Character's User or Admin, a consumable spends a use and is used up with the last one,\na wand or staff spends a charge until the next rest,\none wand or staff of a stack is taken off it to keep its own charges,\nscrolls, wands and staves return the spell they cast",
                 "consumes": [
                     "application/json"
                 ],
@@ -2219,13 +2879,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "God"
+                    "Character Item"
                 ],
-                "summary": "Returns God by id",
+                "summary": "Activates a consumable, wand or staff of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "god id",
+                        "description": "CharacterItem id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2233,21 +2893,35 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "God details",
+                        "description": "Activation result",
                         "schema": {
-                            "$ref": "#/definitions/model.God"
+                            "$ref": "#/definitions/model.ActivationExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Item can't be activated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "God not found",
+                        "description": "CharacterItem doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Auth user",
+            }
+        },
+        "/character-item/{id}/runes": {
+            "put": {
+                "description": "Permissions for Character's User or Admin, potency and striking runes go on weapons,\npotency and resilient runes on armor and the potency rune grade limits the property runes",
                 "consumes": [
                     "application/json"
                 ],
@@ -2255,21 +2929,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "God"
+                    "Character Item"
                 ],
-                "summary": "Deletes God by ID or returns nil",
+                "summary": "Sets the runes of CharacterItem",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "God id",
+                        "description": "CharacterItem id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Runes data",
+                        "name": "runes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCharacterItemRunes"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "CharacterItem details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacterItemExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong runes",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -2278,15 +2970,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "God doesn't exist",
+                        "description": "CharacterItem doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates God",
+            }
+        },
+        "/character-item/{id}/runes/transfer": {
+            "post": {
+                "description": "Permissions for Character's User or Admin, runes move between items of the same type,\na fundamental rune only moves to an item without one",
                 "consumes": [
                     "application/json"
                 ],
@@ -2294,36 +2988,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "God"
+                    "Character Item"
                 ],
-                "summary": "Updates God by ID or nil",
+                "summary": "Moves runes of CharacterItem to another item of the Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "God id",
+                        "description": "CharacterItem id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "God data",
-                        "name": "character",
+                        "description": "Transfer data",
+                        "name": "transfer",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.GodUpdate"
+                            "$ref": "#/definitions/model.TransferRunes"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "God details",
+                        "description": "Both CharacterItems",
                         "schema": {
-                            "$ref": "#/definitions/model.God"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterItemExternal"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong transfer",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "God doesn't exist",
+                        "description": "CharacterItem doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -2331,9 +3040,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/item": {
-            "get": {
-                "description": "Return all items",
+        "/character-skill": {
+            "post": {
+                "description": "Adds a skill of the skill list or a Lore skill, a Lore name like \"Sailing\" or \"Sailing Lore\" is\nnormalized to \"Sailing Lore\", a starting mastery follows the skill progression rules unless\nan admin overrides them",
                 "consumes": [
                     "application/json"
                 ],
@@ -2341,14 +3050,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Skill"
+                ],
+                "summary": "Create and returns Character Skill or nil",
+                "parameters": [
+                    {
+                        "description": "Character Skill data",
+                        "name": "characterSkill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacterSkillCreate"
+                        }
+                    }
                 ],
-                "summary": "Returns all items",
                 "responses": {
-                    "200": {
-                        "description": "Item details",
+                    "201": {
+                        "description": "Character Skill details",
                         "schema": {
-                            "$ref": "#/definitions/model.Item"
+                            "$ref": "#/definitions/model.CharacterSkillExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong skill name or mastery",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -2356,13 +3082,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/item/armor": {
+        "/character-skill/{id}": {
             "get": {
-                "description": "Return all armors",
+                "description": "Return all Character Skills",
                 "consumes": [
                     "application/json"
                 ],
@@ -2370,14 +3102,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Skill"
+                ],
+                "summary": "Returns all Character Skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all armors",
                 "responses": {
                     "200": {
-                        "description": "Armor details",
+                        "description": "Character Skill details",
                         "schema": {
-                            "$ref": "#/definitions/model.Armor"
+                            "$ref": "#/definitions/model.CharacterSkill"
                         }
                     },
                     "401": {
@@ -2388,8 +3129,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Permissions for Admin",
+            "patch": {
+                "description": "A skill goes up one rank at a time, Expert from level 3, Master from 7 and Legendary from 15,\nevery rank is paid by a background skill, a class or Intelligence training or a skill increase,\nadmins can skip the rules with override",
                 "consumes": [
                     "application/json"
                 ],
@@ -2397,29 +3138,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Skill"
                 ],
-                "summary": "Create and returns armor or nil",
+                "summary": "Updates Character Skill by ID or nil",
                 "parameters": [
                     {
-                        "description": "Armor data",
-                        "name": "character",
+                        "type": "integer",
+                        "description": "Character Skill id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character Skill data",
+                        "name": "characterSkill",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateArmor"
+                            "$ref": "#/definitions/model.CharacterSkillUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Armor details",
+                    "200": {
+                        "description": "Action details",
                         "schema": {
-                            "$ref": "#/definitions/model.ArmorExternal"
+                            "$ref": "#/definitions/model.CharacterSkillExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Skill change breaks the progression rules",
                         "schema": {
                             "type": "string"
                         }
@@ -2429,13 +3177,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Character Skill doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/item/armor/{id}": {
+        "/character/{id}": {
             "get": {
-                "description": "Permissions for auth users",
+                "description": "Retrieve Character details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2443,13 +3197,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character"
                 ],
-                "summary": "Returns Armor by ID",
+                "summary": "Returns Character by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "armor id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2457,21 +3211,21 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Armor details",
+                        "description": "character details",
                         "schema": {
-                            "$ref": "#/definitions/model.Armor"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "patch": {
-                "description": "Permissions for Admin",
+            "delete": {
+                "description": "Permissions for Character's User or Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2479,33 +3233,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character"
                 ],
-                "summary": "Updates Armor by ID or nil",
+                "summary": "Deletes Character by ID or returns nil",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Armor id",
+                        "description": "Character id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Armor data",
-                        "name": "armor",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateArmor"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Armor details",
-                        "schema": {
-                            "$ref": "#/definitions/model.Armor"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -2514,17 +3256,15 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Armor doesn't exist",
+                        "description": "Character doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/item/gear": {
-            "get": {
-                "description": "Return all gears",
+            },
+            "patch": {
+                "description": "Permissions for Character's User or Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2532,26 +3272,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character"
+                ],
+                "summary": "Updates Character by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacterUpdate"
+                        }
+                    }
                 ],
-                "summary": "Returns all gears",
                 "responses": {
                     "200": {
-                        "description": "Gear details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.Gear"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/character/{id}/buy": {
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Permissions for Character's User or Admin, the price is paid with the smallest coins first\nand change is given back, a plain stack of the item the character has already gets more quantity,\na runed, charged or damaged one stays apart and a new instance is created",
                 "consumes": [
                     "application/json"
                 ],
@@ -2559,35 +3319,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character"
                 ],
-                "summary": "Create and returns Gear or nil",
+                "summary": "Buys an item for Character",
                 "parameters": [
                     {
-                        "description": "Gear data",
-                        "name": "gear",
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item to buy",
+                        "name": "buy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateGear"
+                            "$ref": "#/definitions/model.BuyItem"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Gear details",
+                    "200": {
+                        "description": "purse and item",
                         "schema": {
-                            "$ref": "#/definitions/model.GearExternal"
+                            "$ref": "#/definitions/model.TradeExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Not enough coins, item has no price or isn't for sale",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2595,9 +3362,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/item/gear/{id}": {
+        "/character/{id}/conditions": {
             "get": {
-                "description": "Permissions for auth users",
+                "description": "Conditions with their counts, dying and wounded included",
                 "consumes": [
                     "application/json"
                 ],
@@ -2605,13 +3372,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Condition"
                 ],
-                "summary": "Returns Gear by ID",
+                "summary": "Returns conditions of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Gear id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2619,21 +3386,26 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Gear details",
+                        "description": "character conditions",
                         "schema": {
-                            "$ref": "#/definitions/model.Gear"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterConditionExternal"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/damage": {
+            "post": {
+                "description": "Temporary hit points are spent first, at 0 hit points the character is dying.\nWith shield_block the raised shield prevents damage up to its Hardness, the character and\nthe shield both take the rest",
                 "consumes": [
                     "application/json"
                 ],
@@ -2641,32 +3413,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Health"
                 ],
-                "summary": "Updates Gear by ID or nil",
+                "summary": "Deals damage to Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Gear id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Gear data",
-                        "name": "Gear",
+                        "description": "Damage data",
+                        "name": "damage",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateGear"
+                            "$ref": "#/definitions/model.DamageCharacter"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Gear details",
+                        "description": "applied change",
                         "schema": {
-                            "$ref": "#/definitions/model.Gear"
+                            "$ref": "#/definitions/model.HealthLogExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Shield Block needs a raised shield",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "403": {
@@ -2676,7 +3454,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Gear doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2684,9 +3462,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/item/weapon": {
+        "/character/{id}/eligible-feats": {
             "get": {
-                "description": "Return all weapons",
+                "description": "Feats whose prerequisites are met and that fit a free feat slot of their category",
                 "consumes": [
                     "application/json"
                 ],
@@ -2694,62 +3472,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Feat"
+                ],
+                "summary": "Returns feats the Character can currently take",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all weapons",
                 "responses": {
                     "200": {
-                        "description": "Weapon details",
+                        "description": "eligible feats",
                         "schema": {
-                            "$ref": "#/definitions/model.Weapon"
+                            "$ref": "#/definitions/model.EligibleFeatExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Permissions for Admin",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Item"
-                ],
-                "summary": "Create and returns weapon or nil",
-                "parameters": [
-                    {
-                        "description": "Weapon data",
-                        "name": "weapon",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateWeapon"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Weapon details",
-                        "schema": {
-                            "$ref": "#/definitions/model.WeaponExternal"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2757,9 +3506,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/item/weapon/{id}": {
+        "/character/{id}/experience": {
             "get": {
-                "description": "Permissions for auth users",
+                "description": "Current experience, whether the character is ready to level up and the awards, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -2767,13 +3516,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Experience"
                 ],
-                "summary": "Returns weapon by ID",
+                "summary": "Returns experience of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Weapon id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2781,21 +3530,29 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Weapon details",
+                        "description": "character experience",
                         "schema": {
-                            "$ref": "#/definitions/model.Weapon"
+                            "$ref": "#/definitions/model.CharacterExperienceExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/heal": {
+            "post": {
+                "description": "Hit points are restored up to the maximum, a dying character recovers and gains wounded 1",
                 "consumes": [
                     "application/json"
                 ],
@@ -2803,32 +3560,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Health"
                 ],
-                "summary": "Updates weapon by ID or nil",
+                "summary": "Heals Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Weapon id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Weapon data",
-                        "name": "character",
+                        "description": "Heal data",
+                        "name": "heal",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateWeapon"
+                            "$ref": "#/definitions/model.HealCharacter"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Weapon details",
+                        "description": "applied change",
                         "schema": {
-                            "$ref": "#/definitions/model.Weapon"
+                            "$ref": "#/definitions/model.HealthLogExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Character is dead",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "403": {
@@ -2838,7 +3601,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Weapon doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2846,9 +3609,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/item/{id}": {
+        "/character/{id}/health-log": {
             "get": {
-                "description": "Permissions for auth users",
+                "description": "Every damage, heal and recovery check with the resulting state, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -2856,13 +3619,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Health"
                 ],
-                "summary": "Returns Item by ID",
+                "summary": "Returns health changes of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Item id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2870,21 +3633,26 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item details",
+                        "description": "health log",
                         "schema": {
-                            "$ref": "#/definitions/model.ItemExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.HealthLogExternal"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/level-down": {
+            "post": {
+                "description": "Undoes exactly the changes recorded by the last level up",
                 "consumes": [
                     "application/json"
                 ],
@@ -2892,21 +3660,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item"
+                    "Character Level"
                 ],
-                "summary": "Deletes Item by ID or returns nil",
+                "summary": "Rolls back the last level up of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Item id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "character details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacterExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "No recorded level up to roll back",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -2915,7 +3692,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2923,9 +3700,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/race": {
+        "/character/{id}/level-up": {
             "get": {
-                "description": "Return all Races",
+                "description": "Feat slots with the feats each slot accepts, skill increase, attribute boosts and mastery upgrades granted by class features",
                 "consumes": [
                     "application/json"
                 ],
@@ -2933,18 +3710,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Race"
+                    "Character Level"
+                ],
+                "summary": "Returns choices for the next level of Character",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all Races",
                 "responses": {
                     "200": {
-                        "description": "Race details",
+                        "description": "level up choices",
                         "schema": {
-                            "$ref": "#/definitions/model.Race"
+                            "$ref": "#/definitions/model.LevelUpChoices"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Character has reached max level",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -2952,7 +3744,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Applies hit points, mastery upgrades and the picks required by class features atomically",
                 "consumes": [
                     "application/json"
                 ],
@@ -2960,29 +3752,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Race"
+                    "Character Level"
                 ],
-                "summary": "Create and returns Race or nil",
+                "summary": "Raises Character level",
                 "parameters": [
                     {
-                        "description": "Feat data",
-                        "name": "race",
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Level up picks",
+                        "name": "levelUp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.RaceCreate"
+                            "$ref": "#/definitions/model.LevelUpCreate"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Feat details",
+                        "description": "applied level up",
                         "schema": {
-                            "$ref": "#/definitions/model.RaceExternal"
+                            "$ref": "#/definitions/model.CharacterLevelExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Wrong level up picks",
                         "schema": {
                             "type": "string"
                         }
@@ -2992,13 +3791,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Character not found",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/race/{id}": {
+        "/character/{id}/levels": {
             "get": {
-                "description": "Permissions for auth users",
+                "description": "Every level up with the changes it applied",
                 "consumes": [
                     "application/json"
                 ],
@@ -3006,13 +3811,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Race"
+                    "Character Level"
                 ],
-                "summary": "Returns Race by ID",
+                "summary": "Returns recorded level ups of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Race id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3020,21 +3825,26 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Race details",
+                        "description": "level ups",
                         "schema": {
-                            "$ref": "#/definitions/model.Race"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CharacterLevelExternal"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/purse": {
+            "get": {
+                "description": "Permissions for Character's User or Admin, the total is in copper pieces",
                 "consumes": [
                     "application/json"
                 ],
@@ -3042,38 +3852,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Race"
+                    "Character"
                 ],
-                "summary": "Deletes Race by ID or returns nil",
+                "summary": "Returns coins of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Race id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "200": {
+                        "description": "character purse",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.PurseExternal"
                         }
                     },
                     "404": {
-                        "description": "Domain doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "patch": {
-                "description": "Permissions for Admin",
+            "put": {
+                "description": "Permissions for Character's User or Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -3081,42 +3888,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Race"
+                    "Character"
                 ],
-                "summary": "Updates Race by ID or nil",
+                "summary": "Sets coins of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Race id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Race data",
-                        "name": "race",
+                        "description": "Coins",
+                        "name": "purse",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.RaceUpdate"
+                            "$ref": "#/definitions/model.Purse"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Race details",
+                        "description": "character purse",
                         "schema": {
-                            "$ref": "#/definitions/model.Race"
+                            "$ref": "#/definitions/model.PurseExternal"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "400": {
+                        "description": "Wrong coins",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Race doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3124,9 +3931,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/skill": {
-            "get": {
-                "description": "Return all Skills",
+        "/character/{id}/recovery-check": {
+            "post": {
+                "description": "Flat check against DC 10 + dying rolled by the server, recovering clears dying and increases wounded",
                 "consumes": [
                     "application/json"
                 ],
@@ -3134,26 +3941,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Skill"
+                    "Character Health"
+                ],
+                "summary": "Rolls recovery check of dying Character",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all Skills",
                 "responses": {
                     "200": {
-                        "description": "Skill details",
+                        "description": "applied change with the roll",
                         "schema": {
-                            "$ref": "#/definitions/model.SkillExternal"
+                            "$ref": "#/definitions/model.HealthLogExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Character is not dying",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/character/{id}/rest": {
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Restores spell slots, prepared spells, the focus pool and the charges of wands and staves",
                 "consumes": [
                     "application/json"
                 ],
@@ -3161,35 +3991,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Skill"
+                    "Character Spell"
                 ],
-                "summary": "Create and returns Skill or nil",
+                "summary": "Refreshes spellcasting of Character after a rest",
                 "parameters": [
                     {
-                        "description": "Skill data",
-                        "name": "Skill",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.SkillCreate"
-                        }
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Skill details",
+                    "200": {
+                        "description": "spellcasting",
                         "schema": {
-                            "$ref": "#/definitions/model.SkillExternal"
+                            "$ref": "#/definitions/model.SpellcastingExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3197,9 +4025,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/skill-feature/{id}": {
-            "get": {
-                "description": "Retrieve Class Feature for certain level details using its ID",
+        "/character/{id}/roll/recall-knowledge": {
+            "post": {
+                "description": "Uses the derived modifier of a knowledge skill, Arcana, Crafting, Medicine, Nature, Occultism,\nReligion, Society or any Lore skill of the character",
                 "consumes": [
                     "application/json"
                 ],
@@ -3207,27 +4035,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Class Feature"
+                    "Roll"
                 ],
-                "summary": "Returns Class Feature for certain level by id",
+                "summary": "Rolls Recall Knowledge check of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Class Feature id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Recall Knowledge check",
+                        "name": "roll",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RollCheck"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Class Feature details",
+                        "description": "roll result",
                         "schema": {
-                            "$ref": "#/definitions/model.SkillFeature"
+                            "$ref": "#/definitions/model.RollExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown recall-knowledge skill",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Class Feature not found",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3235,9 +4084,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/skill/{id}": {
-            "get": {
-                "description": "Retrieve Skill details using its ID",
+        "/character/{id}/roll/save": {
+            "post": {
+                "description": "Uses the derived Fortitude, Reflex or Will modifier",
                 "consumes": [
                     "application/json"
                 ],
@@ -3245,35 +4094,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Skill"
+                    "Roll"
                 ],
-                "summary": "Returns Skill by id",
+                "summary": "Rolls saving throw of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Saving throw",
+                        "name": "roll",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RollCheck"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Skill details",
+                        "description": "roll result",
                         "schema": {
-                            "$ref": "#/definitions/model.SkillExternal"
+                            "$ref": "#/definitions/model.RollExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown save",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Skill not found",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/roll/skill": {
+            "post": {
+                "description": "Uses the derived skill modifier, Perception is rolled as a skill",
                 "consumes": [
                     "application/json"
                 ],
@@ -3281,21 +4153,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Skill"
+                    "Roll"
                 ],
-                "summary": "Deletes Skill by ID or returns nil",
+                "summary": "Rolls skill check of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Skill check",
+                        "name": "roll",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RollCheck"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "roll result",
+                        "schema": {
+                            "$ref": "#/definitions/model.RollExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown skill",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -3304,15 +4194,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Skill doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/roll/strike": {
+            "post": {
+                "description": "Attack with the fist or an equipped weapon including MAP of the attack, damage is rolled\non a hit and doubled on a critical hit",
                 "consumes": [
                     "application/json"
                 ],
@@ -3320,32 +4212,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Skill"
+                    "Roll"
                 ],
-                "summary": "Updates Skill by ID or nil",
+                "summary": "Rolls strike of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Skill data",
-                        "name": "character",
+                        "description": "Strike",
+                        "name": "roll",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SkillUpdate"
+                            "$ref": "#/definitions/model.RollStrikeCreate"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Skill details",
+                        "description": "attack and damage",
                         "schema": {
-                            "$ref": "#/definitions/model.SkillExternal"
+                            "$ref": "#/definitions/model.StrikeRollExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Weapon isn't equipped",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "403": {
@@ -3355,7 +4253,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Skill doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3363,9 +4261,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/slot/{id}": {
+        "/character/{id}/rolls": {
             "get": {
-                "description": "Permissions for auth user",
+                "description": "Checks, strikes and damage rolled for the character, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -3373,35 +4271,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Slot"
+                    "Roll"
                 ],
-                "summary": "Returns slot by id",
+                "summary": "Returns roll history of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "slot id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "slot details",
+                        "description": "roll history",
                         "schema": {
-                            "$ref": "#/definitions/model.SlotExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.RollExternal"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Slot not found",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Character's User or Admin",
+            }
+        },
+        "/character/{id}/sell": {
+            "post": {
+                "description": "Permissions for Character's User or Admin, items sell for half their price,\nan item sold out is removed from the inventory",
                 "consumes": [
                     "application/json"
                 ],
@@ -3409,36 +4324,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Slot"
+                    "Character"
                 ],
-                "summary": "Updates Slot by ID or nil",
+                "summary": "Sells an item of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Slot id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Character data",
-                        "name": "slot",
+                        "description": "Item to sell",
+                        "name": "sell",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SlotUpdate"
+                            "$ref": "#/definitions/model.SellItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Character details",
+                        "description": "purse and item",
                         "schema": {
-                            "$ref": "#/definitions/model.SlotExternal"
+                            "$ref": "#/definitions/model.TradeExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Not enough items or item has no price",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Slot doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3446,9 +4367,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/spell": {
+        "/character/{id}/spells": {
             "get": {
-                "description": "Return all Spells",
+                "description": "Spell slots per rank with their usage, known and prepared spells and focus points",
                 "consumes": [
                     "application/json"
                 ],
@@ -3456,32 +4377,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Spell"
+                    "Character Spell"
                 ],
-                "summary": "Returns all Spells",
+                "summary": "Returns spellcasting of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Limit for pagination",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Spell details",
+                        "description": "spellcasting",
                         "schema": {
-                            "$ref": "#/definitions/model.SpellExternal"
+                            "$ref": "#/definitions/model.SpellcastingExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3489,7 +4405,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Spell must be of the class tradition, repertoire of a spontaneous caster is limited by its slots,\nfocus spells are learned at rank 0 and raise the focus pool",
                 "consumes": [
                     "application/json"
                 ],
@@ -3497,29 +4413,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Spell"
+                    "Character Spell"
                 ],
-                "summary": "Create and returns Spell or nil",
+                "summary": "Adds spell to repertoire or spellbook of Character",
                 "parameters": [
                     {
-                        "description": "spell data",
-                        "name": "Spell",
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spell data",
+                        "name": "spell",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SpellCreate"
+                            "$ref": "#/definitions/model.CharacterSpellCreate"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "spell details",
+                        "description": "spellcasting",
                         "schema": {
-                            "$ref": "#/definitions/model.SpellExternal"
+                            "$ref": "#/definitions/model.SpellcastingExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Wrong spell",
                         "schema": {
                             "type": "string"
                         }
@@ -3529,13 +4452,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Spell not found",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/spell/{id}": {
-            "get": {
-                "description": "Permissions for auth users",
+        "/character/{id}/spells/cast": {
+            "post": {
+                "description": "Expends a prepared spell or a slot of a spontaneous caster, cantrips are free,\nfocus spells spend a focus point",
                 "consumes": [
                     "application/json"
                 ],
@@ -3543,35 +4472,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Spell"
+                    "Character Spell"
                 ],
-                "summary": "Returns Spell by ID",
+                "summary": "Casts spell of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spell id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Spell to cast",
+                        "name": "spell",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CastSpell"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Spell details",
+                        "description": "spellcasting",
                         "schema": {
-                            "$ref": "#/definitions/model.SpellExternal"
+                            "$ref": "#/definitions/model.SpellcastingExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Spell can't be cast",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/spells/prepare": {
+            "post": {
+                "description": "Replaces the preparation of a prepared caster, spells come from the spellbook and fill slots per rank",
                 "consumes": [
                     "application/json"
                 ],
@@ -3579,21 +4531,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Spell"
+                    "Character Spell"
                 ],
-                "summary": "Deletes Spell by ID or returns nil",
+                "summary": "Prepares daily spells of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spell id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Prepared spells",
+                        "name": "spells",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PrepareSpells"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "spellcasting",
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellcastingExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong preparation",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
                         "description": "You can't access for this API",
@@ -3602,15 +4572,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "spell doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/spells/{spell_id}": {
+            "delete": {
+                "description": "Preparations of the spell are removed too",
                 "consumes": [
                     "application/json"
                 ],
@@ -3618,32 +4590,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Spell"
+                    "Character Spell"
                 ],
-                "summary": "Updates Spell by ID or nil",
+                "summary": "Removes spell from repertoire or spellbook of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spell id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Spell data",
-                        "name": "Spell",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.SpellUpdate"
-                        }
+                        "type": "integer",
+                        "description": "character spell id",
+                        "name": "spell_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Spell details",
+                        "description": "spellcasting",
                         "schema": {
-                            "$ref": "#/definitions/model.SpellExternal"
+                            "$ref": "#/definitions/model.SpellcastingExternal"
                         }
                     },
                     "403": {
@@ -3653,7 +4623,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Spell doesn't exist",
+                        "description": "Spell not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3661,9 +4631,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tradition": {
+        "/character/{id}/stats": {
             "get": {
-                "description": "Return all Traditions",
+                "description": "Armor class, saves, perception, class DC and skill modifiers with their breakdown",
                 "consumes": [
                     "application/json"
                 ],
@@ -3671,26 +4641,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tradition"
+                    "Character"
+                ],
+                "summary": "Returns derived statistics of Character",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all Traditions",
                 "responses": {
                     "200": {
-                        "description": "Tradition details",
+                        "description": "character statistics",
                         "schema": {
-                            "$ref": "#/definitions/model.Tradition"
+                            "$ref": "#/definitions/model.CharacterStatsExternal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character/{id}/strikes": {
+            "get": {
+                "description": "Attack bonuses for the first, second and third attack and damage of the fist and equipped weapons",
                 "consumes": [
                     "application/json"
                 ],
@@ -3698,35 +4679,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tradition"
+                    "Character"
                 ],
-                "summary": "Create and returns Tradition or nil",
+                "summary": "Returns strikes of Character",
                 "parameters": [
                     {
-                        "description": "Tradition data",
-                        "name": "tradition",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateTradition"
-                        }
+                        "type": "integer",
+                        "description": "character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Tradition details",
-                        "schema": {
-                            "$ref": "#/definitions/model.TraditionExternal"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "character strikes",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.CharacterStrikesExternal"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "404": {
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3734,9 +4707,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tradition/{id}": {
+        "/character_boost/{id}": {
             "get": {
-                "description": "Retrieve Tradition details using its ID",
+                "description": "Available boosts and the boosts already spent by Character",
                 "consumes": [
                     "application/json"
                 ],
@@ -3744,13 +4717,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tradition"
+                    "Boost"
                 ],
-                "summary": "Returns Tradition by id",
+                "summary": "Returns Boost by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Tradition id",
+                        "description": "character_id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3758,21 +4731,21 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tradition details",
+                        "description": "Boost details",
                         "schema": {
-                            "$ref": "#/definitions/model.Tradition"
+                            "$ref": "#/definitions/model.CharacterBoostExternal"
                         }
                     },
                     "404": {
-                        "description": "Tradition not found",
+                        "description": "Boost not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Permissions for Admin",
+            "patch": {
+                "description": "Replaces boosts of the source and recomputes attributes from the boost history,\nattributes set before boosts were tracked don't match the history and aren't recomputed",
                 "consumes": [
                     "application/json"
                 ],
@@ -3780,38 +4753,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tradition"
+                    "Boost"
                 ],
-                "summary": "Deletes Tradition by ID or returns nil",
+                "summary": "Spends ancestry, background, class or free boosts of Character",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Tradition id",
+                        "description": "character_id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Boost data",
+                        "name": "Boost",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCharacterBoost"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Boost details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CharacterBoostExternal"
+                        }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "400": {
+                        "description": "Wrong boosts",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Tradition doesn't exist",
+                        "description": "Boost doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Attributes don't match the boost history",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/character_feat": {
+            "post": {
+                "description": "Checks level, skill mastery, prerequisite feats, ancestry and class traits, dedication rules and free feat slots",
                 "consumes": [
                     "application/json"
                 ],
@@ -3819,42 +4812,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tradition"
+                    "Character Feat"
                 ],
-                "summary": "Updates Tradition by ID or nil",
+                "summary": "Create and returns character feat or nil",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Tradition id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Tradition data",
-                        "name": "character",
+                        "description": "Character feat data",
+                        "name": "characterFeat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateTradition"
+                            "$ref": "#/definitions/model.CreateCharacterFeat"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Tradition details",
+                    "201": {
+                        "description": "Character feat details",
                         "schema": {
-                            "$ref": "#/definitions/model.TraditionExternal"
+                            "$ref": "#/definitions/model.CharacterFeatExternal"
                         }
                     },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "400": {
+                        "description": "Unmet feat prerequisites",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Tradition doesn't exist",
+                        "description": "Character or feat not found",
                         "schema": {
                             "type": "string"
                         }
@@ -3862,9 +4854,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/trait": {
+        "/class": {
             "get": {
-                "description": "Return all Traits",
+                "description": "Return all characters for current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3872,14 +4864,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trait"
+                    "Character Class"
                 ],
-                "summary": "Returns all Traits",
+                "summary": "Returns all characters",
                 "responses": {
                     "200": {
-                        "description": "Trait details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.TraitExternal"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
                     "401": {
@@ -3891,7 +4883,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Permissions for Admin",
+                "description": "Create new Character Class",
                 "consumes": [
                     "application/json"
                 ],
@@ -3899,25 +4891,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trait"
+                    "Character Class"
                 ],
-                "summary": "Create and returns Trait or nil",
+                "summary": "Create and returns new Character Class or nil",
                 "parameters": [
                     {
-                        "description": "Trait data",
-                        "name": "trait",
+                        "description": "Character Class data",
+                        "name": "god",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateTrait"
+                            "$ref": "#/definitions/model.CharacterClassCreate"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Trait details",
+                        "description": "Character Class details",
                         "schema": {
-                            "$ref": "#/definitions/model.TraitExternal"
+                            "$ref": "#/definitions/model.CharacterClass"
                         }
                     },
                     "401": {
@@ -3925,19 +4917,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
             }
         },
-        "/trait/{id}": {
+        "/class-feature/all/{id}": {
             "get": {
-                "description": "Retrieve Trait details using its ID",
+                "description": "Retrieve All Class Feature details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3945,13 +4931,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trait"
+                    "Class Feature"
                 ],
-                "summary": "Returns Trait by id",
+                "summary": "Returns All Class Feature by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Trait id",
+                        "description": "Class Feature id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3959,21 +4945,23 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Trait details",
+                        "description": "All Class Feature details",
                         "schema": {
-                            "$ref": "#/definitions/model.TraitExternal"
+                            "$ref": "#/definitions/model.ClassFeatureExternal"
                         }
                     },
                     "404": {
-                        "description": "Trait not found",
+                        "description": "Class Feature not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/class-feature/{id}": {
+            "get": {
+                "description": "Retrieve Class Feature details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3981,38 +4969,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trait"
+                    "Class Feature"
                 ],
-                "summary": "Deletes Trait by ID or returns nil",
+                "summary": "Returns Class Feature by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Trait id",
+                        "description": "Class Feature id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
+                    "200": {
+                        "description": "Class Feature details",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.ClassFeatureExternal"
                         }
                     },
                     "404": {
-                        "description": "Trait doesn't exist",
+                        "description": "Class Feature not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Permissions for Admin",
+            }
+        },
+        "/class/{id}": {
+            "get": {
+                "description": "Retrieve Character class details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4020,52 +5007,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trait"
+                    "Character Class"
                 ],
-                "summary": "Updates Trait by ID or nil",
+                "summary": "Returns Character by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Trait id",
+                        "description": "character id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Trait data",
-                        "name": "character",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateTrait"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Trait details",
-                        "schema": {
-                            "$ref": "#/definitions/model.TraitExternal"
-                        }
-                    },
-                    "403": {
-                        "description": "You can't access for this API",
+                        "description": "character details",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
                     "404": {
-                        "description": "Trait doesn't exist",
+                        "description": "Character not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/user": {
-            "get": {
-                "description": "Returns all users",
+            },
+            "delete": {
+                "description": "Permissions for Character's User or Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4073,23 +5043,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Character Class"
+                ],
+                "summary": "Deletes Character by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all users",
                 "responses": {
-                    "200": {
-                        "description": "User list",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
-                            "$ref": "#/definitions/model.UserExternal"
+                            "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error"
+                    "404": {
+                        "description": "Character doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             },
-            "post": {
-                "description": "Create new user",
+            "patch": {
+                "description": "Permissions for Character's User or Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4097,29 +5082,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Character Class"
                 ],
-                "summary": "Create and returns user or nil",
+                "summary": "Updates Character by ID or nil",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "Character id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character data",
+                        "name": "character",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateUser"
+                            "$ref": "#/definitions/model.CharacterUpdate"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "user details",
+                        "description": "Character details",
                         "schema": {
-                            "$ref": "#/definitions/model.UserExternal"
+                            "$ref": "#/definitions/model.CharacterExternal"
                         }
                     },
                     "404": {
-                        "description": "User already exist",
+                        "description": "Character doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -4127,9 +5119,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/current": {
+        "/class/{id}/spell-slots": {
             "get": {
-                "description": "Returns current user",
+                "description": "Slots per rank from the character level on, rank 0 is the number of cantrips",
                 "consumes": [
                     "application/json"
                 ],
@@ -4137,25 +5129,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Character Class"
+                ],
+                "summary": "Returns spell slot table of Character Class",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "class id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns current user",
                 "responses": {
                     "200": {
-                        "description": "User current",
+                        "description": "spell slot table",
                         "schema": {
-                            "$ref": "#/definitions/model.UserExternal"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SpellSlotTableExternal"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error"
+                    "404": {
+                        "description": "Character Class doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
-            }
-        },
-        "/user/password": {
-            "patch": {
-                "description": "Permissions: Current User",
+            },
+            "put": {
+                "description": "Permissions for Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4163,35 +5168,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Character Class"
                 ],
-                "summary": "Changes User's password",
+                "summary": "Replaces spell slot table of Character Class",
                 "parameters": [
                     {
-                        "description": "User password data",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "class id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spell slot table",
+                        "name": "slots",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UserPasswordUpdate"
+                            "$ref": "#/definitions/model.UpdateSpellSlots"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Ok",
+                    "200": {
+                        "description": "spell slot table",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SpellSlotTableExternal"
+                            }
                         }
                     },
                     "400": {
-                        "description": "User doesn't exist",
+                        "description": "Wrong spell slot table",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "You need to provide a valid access token or user credentials to access this api",
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Character Class doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -4199,9 +5220,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/verification": {
+        "/condition": {
+            "get": {
+                "description": "Return all Conditions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Condition"
+                ],
+                "summary": "Returns all Conditions",
+                "responses": {
+                    "200": {
+                        "description": "Condition details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ConditionExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Verification user after email with code",
+                "description": "Permissions for Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4209,33 +5260,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Condition"
                 ],
-                "summary": "Verification user after email with code",
+                "summary": "Create and returns Condition or nil",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "description": "Condition data",
+                        "name": "condition",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UserCodeVerification"
+                            "$ref": "#/definitions/model.CreateCondition"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "user details",
+                    "201": {
+                        "description": "Condition details",
                         "schema": {
-                            "$ref": "#/definitions/model.UserExternal"
+                            "$ref": "#/definitions/model.ConditionExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/user/{id}": {
+        "/condition/{id}": {
             "get": {
-                "description": "Retrieve User details using its ID",
+                "description": "Retrieve Condition details using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4243,13 +5306,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Condition"
                 ],
-                "summary": "returns User by ID",
+                "summary": "Returns Condition by id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User id",
+                        "description": "Condition id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4257,13 +5320,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "user details",
+                        "description": "Condition details",
                         "schema": {
-                            "$ref": "#/definitions/model.UserExternal"
+                            "$ref": "#/definitions/model.ConditionExternal"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Condition not found",
                         "schema": {
                             "type": "string"
                         }
@@ -4271,7 +5334,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete User",
+                "description": "Permissions for Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4279,33 +5342,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Condition"
                 ],
-                "summary": "Returns and delete User by ID if you're admin",
+                "summary": "Deletes Condition by ID or returns nil",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User id",
+                        "description": "Condition id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Ok",
-                        "schema": {
-                            "type": "string"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "User doesn't exist",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "You need to provide a valid access token or user credentials to access this api",
+                    "404": {
+                        "description": "Condition doesn't exist",
                         "schema": {
                             "type": "string"
                         }
@@ -4313,7 +5373,7 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "Permissions for Admin and current user",
+                "description": "Permissions for Admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -4321,152 +5381,5205 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Condition"
                 ],
-                "summary": "Update User",
+                "summary": "Updates Condition by ID or nil",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User id",
+                        "description": "Condition id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "description": "Condition data",
+                        "name": "condition",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UserUpdateExternal"
+                            "$ref": "#/definitions/model.CreateCondition"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Ok",
+                    "200": {
+                        "description": "Condition details",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.ConditionExternal"
                         }
                     },
-                    "400": {
-                        "description": "User doesn't exist",
+                    "403": {
+                        "description": "You can't access for this API",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "You need to provide a valid access token or user credentials to access this api",
+                    "404": {
+                        "description": "Condition doesn't exist",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             }
-        }
+        },
+        "/creature": {
+            "get": {
+                "description": "Permissions for auth users, filters by level, level range, trait name and rarity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Creature"
+                ],
+                "summary": "Returns Creatures",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Level",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Lowest level",
+                        "name": "min_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Highest level",
+                        "name": "max_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trait name",
+                        "name": "trait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rarity",
+                        "name": "rarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Creature details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CreatureExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin, defences are immunities, resistances and weaknesses by damage type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Creature"
+                ],
+                "summary": "Create and returns Creature",
+                "parameters": [
+                    {
+                        "description": "Creature data",
+                        "name": "creature",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatureCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Creature details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatureExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong creature data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/creature/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Creature"
+                ],
+                "summary": "Returns Creature by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Creature id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Creature details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatureExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Creature doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Creature"
+                ],
+                "summary": "Deletes Creature by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Creature id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Creature doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin, traits, spells, strikes and defences are replaced",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Creature"
+                ],
+                "summary": "Updates Creature by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Creature id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Creature data",
+                        "name": "creature",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatureCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Creature details",
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatureExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong creature data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Creature doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/domain": {
+            "get": {
+                "description": "Return all domains",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Returns all domains",
+                "responses": {
+                    "200": {
+                        "description": "Domain details",
+                        "schema": {
+                            "$ref": "#/definitions/model.DomainExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Create and returns domain or nil",
+                "parameters": [
+                    {
+                        "description": "Domain data",
+                        "name": "domain",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateDomain"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Domain details",
+                        "schema": {
+                            "$ref": "#/definitions/model.DomainExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/domain/load": {
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Create Domain from csv file on server or nil",
+                "responses": {
+                    "201": {
+                        "description": "Domain details",
+                        "schema": {
+                            "$ref": "#/definitions/model.DomainExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/domain/{id}": {
+            "get": {
+                "description": "Retrieve Domain details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Returns Domain by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "domain id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "domain details",
+                        "schema": {
+                            "$ref": "#/definitions/model.DomainExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Deletes Domain by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Domain id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Domain"
+                ],
+                "summary": "Updates Domain by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Domain id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Domain data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateDomain"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domain details",
+                        "schema": {
+                            "$ref": "#/definitions/model.DomainExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/encounter-budget": {
+            "get": {
+                "description": "The party is the campaign or the listed characters, the budget of the threat is adjusted for\nthe party size. Listed creatures (repeat an id for several) are checked against the budget\nand suggestions are groups of bestiary creatures fitting it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Encounter"
+                ],
+                "summary": "Returns XP budget of an encounter for the party",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign id",
+                        "name": "campaign_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Character ids",
+                        "name": "character_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "trivial",
+                            "low",
+                            "moderate",
+                            "severe",
+                            "extreme"
+                        ],
+                        "type": "string",
+                        "description": "Threat",
+                        "name": "threat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Creature ids",
+                        "name": "creature_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of suggestions",
+                        "name": "suggestions",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trait of suggested creatures",
+                        "name": "trait",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "encounter budget",
+                        "schema": {
+                            "$ref": "#/definitions/model.EncounterBudgetExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Party is empty",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Creature doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/feat": {
+            "get": {
+                "description": "Permissions for auth users, filters by category, class, ancestry (race), archetype, highest level and trait name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feat"
+                ],
+                "summary": "Returns Feats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feat category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Character class id",
+                        "name": "class_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Race id of ancestry feats",
+                        "name": "ancestry_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Archetype id",
+                        "name": "archetype_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Highest feat level",
+                        "name": "max_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trait name",
+                        "name": "trait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feat details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.FeatExternal"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong filter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feat"
+                ],
+                "summary": "Create and returns Feat or nil",
+                "parameters": [
+                    {
+                        "description": "Feat data",
+                        "name": "feat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateFeat"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Feat details",
+                        "schema": {
+                            "$ref": "#/definitions/model.FeatExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong feat data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/feat/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feat"
+                ],
+                "summary": "Returns Feat by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Feat id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feat details",
+                        "schema": {
+                            "$ref": "#/definitions/model.FeatExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feat"
+                ],
+                "summary": "Deletes Feat by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Feat id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feat"
+                ],
+                "summary": "Updates Feat by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Feat id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Feat data",
+                        "name": "Feat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateFeat"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feat details",
+                        "schema": {
+                            "$ref": "#/definitions/model.FeatExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Feat doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/god": {
+            "get": {
+                "description": "Return all gods and their domains",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "God"
+                ],
+                "summary": "Returns all gods",
+                "responses": {
+                    "200": {
+                        "description": "Character details",
+                        "schema": {
+                            "$ref": "#/definitions/model.God"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new God",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "God"
+                ],
+                "summary": "Create and returns new God or nil",
+                "parameters": [
+                    {
+                        "description": "God data",
+                        "name": "god",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GodCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "God details",
+                        "schema": {
+                            "$ref": "#/definitions/model.God"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/god/{id}": {
+            "get": {
+                "description": "Retrieve God details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "God"
+                ],
+                "summary": "Returns God by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "god id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "God details",
+                        "schema": {
+                            "$ref": "#/definitions/model.God"
+                        }
+                    },
+                    "404": {
+                        "description": "God not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Auth user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "God"
+                ],
+                "summary": "Deletes God by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "God id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "God doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates God",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "God"
+                ],
+                "summary": "Updates God by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "God id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "God data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GodUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "God details",
+                        "schema": {
+                            "$ref": "#/definitions/model.God"
+                        }
+                    },
+                    "404": {
+                        "description": "God doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item": {
+            "get": {
+                "description": "Return all items",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all items",
+                "responses": {
+                    "200": {
+                        "description": "Item details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Item"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/armor": {
+            "get": {
+                "description": "Return all armors",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all armors",
+                "responses": {
+                    "200": {
+                        "description": "Armor details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Armor"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Create and returns armor or nil",
+                "parameters": [
+                    {
+                        "description": "Armor data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateArmor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Armor details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ArmorExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/armor/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns Armor by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "armor id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Armor details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Armor"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Updates Armor by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Armor id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Armor data",
+                        "name": "armor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateArmor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Armor details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Armor"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Armor doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/consumable": {
+            "get": {
+                "description": "Return all consumables, wands and staves",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all consumables",
+                "responses": {
+                    "200": {
+                        "description": "Consumable details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ConsumableExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin, a consumable is used up after its uses, a wand or staff has charges per day,\nscrolls, wands and staves cast the linked spell at the spell rank",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Create and returns Consumable or nil",
+                "parameters": [
+                    {
+                        "description": "Consumable data",
+                        "name": "consumable",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateConsumable"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Consumable details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConsumableExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong consumable data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/consumable/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns Consumable by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Consumable id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Consumable details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConsumableExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Consumable doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin, omitted values are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Updates Consumable by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Consumable id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Consumable data",
+                        "name": "consumable",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateConsumable"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Consumable details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConsumableExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong consumable data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Consumable doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/gear": {
+            "get": {
+                "description": "Return all gears",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all gears",
+                "responses": {
+                    "200": {
+                        "description": "Gear details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Gear"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Create and returns Gear or nil",
+                "parameters": [
+                    {
+                        "description": "Gear data",
+                        "name": "gear",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateGear"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Gear details",
+                        "schema": {
+                            "$ref": "#/definitions/model.GearExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/gear/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns Gear by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gear id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gear details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Gear"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Updates Gear by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gear id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gear data",
+                        "name": "Gear",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateGear"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gear details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Gear"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Gear doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/shield": {
+            "get": {
+                "description": "Return all shields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all shields",
+                "responses": {
+                    "200": {
+                        "description": "Shield details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ShieldExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin, the AC bonus applies while the shield is raised",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Create and returns Shield or nil",
+                "parameters": [
+                    {
+                        "description": "Shield data",
+                        "name": "shield",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateShield"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Shield details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ShieldExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong shield data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/shield/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns Shield by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shield id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shield details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ShieldExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Shield doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin, omitted values are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Updates Shield by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shield id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shield data",
+                        "name": "shield",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateShield"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shield details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ShieldExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong shield data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Shield doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/weapon": {
+            "get": {
+                "description": "Return all weapons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns all weapons",
+                "responses": {
+                    "200": {
+                        "description": "Weapon details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Weapon"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Create and returns weapon or nil",
+                "parameters": [
+                    {
+                        "description": "Weapon data",
+                        "name": "weapon",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateWeapon"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Weapon details",
+                        "schema": {
+                            "$ref": "#/definitions/model.WeaponExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/weapon/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns weapon by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Weapon id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Weapon details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Weapon"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Updates weapon by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Weapon id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Weapon data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateWeapon"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Weapon details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Weapon"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Weapon doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/item/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Returns Item by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ItemExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Deletes Item by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Item doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/race": {
+            "get": {
+                "description": "Return all Races",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Race"
+                ],
+                "summary": "Returns all Races",
+                "responses": {
+                    "200": {
+                        "description": "Race details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Race"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Race"
+                ],
+                "summary": "Create and returns Race or nil",
+                "parameters": [
+                    {
+                        "description": "Feat data",
+                        "name": "race",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RaceCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Feat details",
+                        "schema": {
+                            "$ref": "#/definitions/model.RaceExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/race/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Race"
+                ],
+                "summary": "Returns Race by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Race id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Race details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Race"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Race"
+                ],
+                "summary": "Deletes Race by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Race id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Race"
+                ],
+                "summary": "Updates Race by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Race id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Race data",
+                        "name": "race",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RaceUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Race details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Race"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Race doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/roll": {
+            "post": {
+                "description": "Notation like 2d8+4, 4d6kh3 or 1d20+12, with a DC the degree of success is returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roll"
+                ],
+                "summary": "Rolls dice notation",
+                "parameters": [
+                    {
+                        "description": "Roll data",
+                        "name": "roll",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RollDice"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "roll result",
+                        "schema": {
+                            "$ref": "#/definitions/model.RollExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong dice notation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/rune": {
+            "get": {
+                "description": "Return all Runes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rune"
+                ],
+                "summary": "Returns all Runes",
+                "responses": {
+                    "200": {
+                        "description": "Rune details",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.RuneExternal"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin, property runes with damage dice add extra damage to strikes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rune"
+                ],
+                "summary": "Create and returns Rune or nil",
+                "parameters": [
+                    {
+                        "description": "Rune data",
+                        "name": "rune",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateRune"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Rune details",
+                        "schema": {
+                            "$ref": "#/definitions/model.RuneExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong rune data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/rune/{id}": {
+            "get": {
+                "description": "Retrieve Rune details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rune"
+                ],
+                "summary": "Returns Rune by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rune id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rune details",
+                        "schema": {
+                            "$ref": "#/definitions/model.RuneExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Rune not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin, the rune is removed from the items it was etched on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rune"
+                ],
+                "summary": "Deletes Rune by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rune id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Rune doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rune"
+                ],
+                "summary": "Updates Rune by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rune id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rune data",
+                        "name": "rune",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateRune"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rune details",
+                        "schema": {
+                            "$ref": "#/definitions/model.RuneExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Wrong rune data",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Rune doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill": {
+            "get": {
+                "description": "Return all Skills",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skill"
+                ],
+                "summary": "Returns all Skills",
+                "responses": {
+                    "200": {
+                        "description": "Skill details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skill"
+                ],
+                "summary": "Create and returns Skill or nil",
+                "parameters": [
+                    {
+                        "description": "Skill data",
+                        "name": "Skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Skill details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill-feature/{id}": {
+            "get": {
+                "description": "Retrieve Class Feature for certain level details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Class Feature"
+                ],
+                "summary": "Returns Class Feature for certain level by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Class Feature id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Class Feature details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillFeature"
+                        }
+                    },
+                    "404": {
+                        "description": "Class Feature not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill/{id}": {
+            "get": {
+                "description": "Retrieve Skill details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skill"
+                ],
+                "summary": "Returns Skill by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Skill details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Skill not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skill"
+                ],
+                "summary": "Deletes Skill by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Skill doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skill"
+                ],
+                "summary": "Updates Skill by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Skill details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SkillExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Skill doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/slot/{id}": {
+            "get": {
+                "description": "Permissions for auth user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Returns slot by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "slot id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "slot details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SlotExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Slot not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Character's User or Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Updates Slot by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character data",
+                        "name": "slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SlotUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Character details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SlotExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Slot doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/slot/{id}/raise-shield": {
+            "post": {
+                "description": "Permissions for Character's User or Admin, a raised shield adds its circumstance bonus to AC\nand can Shield Block, a broken or destroyed shield can't be raised",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Raises or lowers the shield in the slot",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Raise or lower",
+                        "name": "shield",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RaiseShield"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Slot details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SlotExternal"
+                        }
+                    },
+                    "400": {
+                        "description": "Shield is broken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Slot doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell": {
+            "get": {
+                "description": "Return all Spells",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell"
+                ],
+                "summary": "Returns all Spells",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Spell details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell"
+                ],
+                "summary": "Create and returns Spell or nil",
+                "parameters": [
+                    {
+                        "description": "spell data",
+                        "name": "Spell",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "spell details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell/{id}": {
+            "get": {
+                "description": "Permissions for auth users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell"
+                ],
+                "summary": "Returns Spell by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spell id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Spell details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell"
+                ],
+                "summary": "Deletes Spell by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spell id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "spell doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell"
+                ],
+                "summary": "Updates Spell by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spell id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spell data",
+                        "name": "Spell",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Spell details",
+                        "schema": {
+                            "$ref": "#/definitions/model.SpellExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Spell doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tradition": {
+            "get": {
+                "description": "Return all Traditions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tradition"
+                ],
+                "summary": "Returns all Traditions",
+                "responses": {
+                    "200": {
+                        "description": "Tradition details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tradition"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tradition"
+                ],
+                "summary": "Create and returns Tradition or nil",
+                "parameters": [
+                    {
+                        "description": "Tradition data",
+                        "name": "tradition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTradition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tradition details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraditionExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tradition/{id}": {
+            "get": {
+                "description": "Retrieve Tradition details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tradition"
+                ],
+                "summary": "Returns Tradition by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tradition id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tradition details",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tradition"
+                        }
+                    },
+                    "404": {
+                        "description": "Tradition not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tradition"
+                ],
+                "summary": "Deletes Tradition by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tradition id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Tradition doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tradition"
+                ],
+                "summary": "Updates Tradition by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tradition id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tradition data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateTradition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tradition details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraditionExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Tradition doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/trait": {
+            "get": {
+                "description": "Return all Traits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trait"
+                ],
+                "summary": "Returns all Traits",
+                "responses": {
+                    "200": {
+                        "description": "Trait details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraitExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trait"
+                ],
+                "summary": "Create and returns Trait or nil",
+                "parameters": [
+                    {
+                        "description": "Trait data",
+                        "name": "trait",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTrait"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Trait details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraitExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/trait/{id}": {
+            "get": {
+                "description": "Retrieve Trait details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trait"
+                ],
+                "summary": "Returns Trait by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trait id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trait details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraitExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "Trait not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trait"
+                ],
+                "summary": "Deletes Trait by ID or returns nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trait id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Trait doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trait"
+                ],
+                "summary": "Updates Trait by ID or nil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trait id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Trait data",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateTrait"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trait details",
+                        "schema": {
+                            "$ref": "#/definitions/model.TraitExternal"
+                        }
+                    },
+                    "403": {
+                        "description": "You can't access for this API",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Trait doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "get": {
+                "description": "Returns all users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Returns all users",
+                "responses": {
+                    "200": {
+                        "description": "User list",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserExternal"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Create and returns user or nil",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "user details",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "User already exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/current": {
+            "get": {
+                "description": "Returns current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Returns current user",
+                "responses": {
+                    "200": {
+                        "description": "User current",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserExternal"
+                        }
+                    },
+                    "401": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/user/password": {
+            "patch": {
+                "description": "Permissions: Current User",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Changes User's password",
+                "parameters": [
+                    {
+                        "description": "User password data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserPasswordUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "User doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "You need to provide a valid access token or user credentials to access this api",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/verification": {
+            "post": {
+                "description": "Verification user after email with code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Verification user after email with code",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserCodeVerification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "user details",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserExternal"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "description": "Retrieve User details using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "returns User by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "user details",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserExternal"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete User",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Returns and delete User by ID if you're admin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "User doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "You need to provide a valid access token or user credentials to access this api",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Permissions for Admin and current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserUpdateExternal"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "User doesn't exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "You need to provide a valid access token or user credentials to access this api",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
         "model.Ability": {
             "type": "string",
             "enum": [
-                "Strength",
-                "Dexterity",
-                "Constitution",
-                "Intelligence",
-                "Wisdom",
-                "Charisma"
+                "Strength",
+                "Dexterity",
+                "Constitution",
+                "Intelligence",
+                "Wisdom",
+                "Charisma"
+            ],
+            "x-enum-varnames": [
+                "Strength",
+                "Dexterity",
+                "Constitution",
+                "Intelligence",
+                "Wisdom",
+                "Charisma"
+            ]
+        },
+        "model.ActionExternal": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ActivationExternal": {
+            "type": "object",
+            "properties": {
+                "character_item": {
+                    "$ref": "#/definitions/model.CharacterItemExternal"
+                },
+                "consumed": {
+                    "type": "boolean"
+                },
+                "spell_id": {
+                    "type": "integer"
+                },
+                "spell_name": {
+                    "type": "string"
+                },
+                "spell_rank": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AdjustCampaignCharacter": {
+            "type": "object",
+            "properties": {
+                "experience": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "hit_point": {
+                    "type": "integer",
+                    "example": -5
+                }
+            }
+        },
+        "model.Ancestry": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "raceID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AncestryCreate": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "race_id"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "race_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AncestryExternal": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "race_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AncestryUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "race_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ArchetypeCreate": {
+            "type": "object",
+            "required": [
+                "dedication_feat_id",
+                "name"
+            ],
+            "properties": {
+                "character_class_id": {
+                    "type": "integer"
+                },
+                "dedication_feat_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "feat_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ArchetypeProficiencyCreate"
+                    }
+                },
+                "spellcasting": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ArchetypeExternal": {
+            "type": "object",
+            "properties": {
+                "character_class_id": {
+                    "type": "integer"
+                },
+                "dedication_feat_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "feats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FeatExternal"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ArchetypeProficiencyExternal"
+                    }
+                },
+                "spellcasting": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ArchetypeProficiencyCreate": {
+            "type": "object",
+            "required": [
+                "mastery",
+                "target"
+            ],
+            "properties": {
+                "mastery": {
+                    "enum": [
+                        "Trained",
+                        "Expert",
+                        "Master",
+                        "Legendary"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Trained"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "martial_weapon"
+                }
+            }
+        },
+        "model.ArchetypeProficiencyExternal": {
+            "type": "object",
+            "properties": {
+                "mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Armor": {
+            "type": "object",
+            "properties": {
+                "armorClass": {
+                    "type": "integer"
+                },
+                "armorGroup": {
+                    "type": "string"
+                },
+                "category": {
+                    "$ref": "#/definitions/model.ArmorCategory"
+                },
+                "checkPenalty": {
+                    "type": "integer"
+                },
+                "dexCap": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item": {
+                    "$ref": "#/definitions/model.Item"
+                },
+                "speedPenalty": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Trait"
+                    }
+                }
+            }
+        },
+        "model.ArmorCategory": {
+            "type": "string",
+            "enum": [
+                "Unarmored",
+                "Light",
+                "Medium",
+                "Heavy"
+            ],
+            "x-enum-varnames": [
+                "UnarmoredDefense",
+                "LightArmor",
+                "MediumArmor",
+                "HeavyArmor"
+            ]
+        },
+        "model.ArmorExternal": {
+            "type": "object",
+            "required": [
+                "name",
+                "price"
+            ],
+            "properties": {
+                "armor_class": {
+                    "type": "integer"
+                },
+                "bulk": {
+                    "type": "number"
+                },
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ArmorCategory"
+                        }
+                    ],
+                    "example": "Medium"
+                },
+                "check_penalty": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dex_cap": {
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "speed_penalty": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.Attribute": {
+            "type": "object",
+            "properties": {
+                "characterID": {
+                    "type": "integer"
+                },
+                "charisma": {
+                    "type": "integer"
+                },
+                "constitution": {
+                    "type": "integer"
+                },
+                "dexterity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "intelligence": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "wisdom": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AttributeBoostExternal": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "$ref": "#/definitions/model.Ability"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/model.BoostSource"
+                }
+            }
+        },
+        "model.AttributeExternal": {
+            "type": "object",
+            "properties": {
+                "characterID": {
+                    "type": "integer"
+                },
+                "charisma": {
+                    "type": "integer"
+                },
+                "constitution": {
+                    "type": "integer"
+                },
+                "dexterity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "intelligence": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "wisdom": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AwardExperience": {
+            "type": "object",
+            "required": [
+                "amount",
+                "kind"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "maximum": 32767,
+                    "example": 80
+                },
+                "character_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "encounter",
+                        "accomplishment",
+                        "hazard"
+                    ],
+                    "example": "encounter"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Defeated the goblin ambush"
+                }
+            }
+        },
+        "model.AwardMilestone": {
+            "type": "object",
+            "properties": {
+                "character_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Cleared the first floor of the vaults"
+                }
+            }
+        },
+        "model.Background": {
+            "type": "object",
+            "properties": {
+                "character": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Character"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "featID": {
+                    "type": "integer"
+                },
+                "firstSkillID": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "loreSkill": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secondSkillID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BackgroundCreate": {
+            "type": "object",
+            "required": [
+                "description",
+                "feat_id",
+                "first_skill_id",
+                "name",
+                "second_skill_id"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "feat_id": {
+                    "type": "integer"
+                },
+                "first_skill_id": {
+                    "type": "integer"
+                },
+                "lore_skill": {
+                    "type": "string",
+                    "example": "Sailing Lore"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "second_skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BackgroundExternal": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "feat_id": {
+                    "type": "integer"
+                },
+                "first_skill_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lore_skill": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "second_skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BackgroundUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "feat_id": {
+                    "type": "integer"
+                },
+                "first_skill_id": {
+                    "type": "integer"
+                },
+                "lore_skill": {
+                    "type": "string",
+                    "example": "Sailing Lore"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "second_skill_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BoostSource": {
+            "type": "string",
+            "enum": [
+                "ancestry",
+                "background",
+                "class",
+                "free",
+                "level"
             ],
             "x-enum-varnames": [
-                "Strength",
-                "Dexterity",
-                "Constitution",
-                "Intelligence",
-                "Wisdom",
-                "Charisma"
+                "AncestryBoostSource",
+                "BackgroundBoostSource",
+                "ClassBoostSource",
+                "FreeBoostSource",
+                "LevelBoostSource"
             ]
         },
-        "model.ActionExternal": {
+        "model.BuyItem": {
+            "type": "object",
+            "required": [
+                "item_id"
+            ],
+            "properties": {
+                "container_id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "placement": {
+                    "enum": [
+                        "Worn",
+                        "Carried",
+                        "Stowed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ItemPlacement"
+                        }
+                    ],
+                    "example": "Carried"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "example": 1
+                }
+            }
+        },
+        "model.CampaignCharacterExternal": {
+            "type": "object",
+            "properties": {
+                "experience": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ready_to_level_up": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CampaignExternal": {
+            "type": "object",
+            "properties": {
+                "characters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CampaignCharacterExternal"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "game_master_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "milestone": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CampaignInviteExternal": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "boolean"
+                },
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "campaign_name": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CastSpell": {
+            "type": "object",
+            "required": [
+                "spell_id"
+            ],
+            "properties": {
+                "rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "spell_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Character": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "ancestry": {
+                    "$ref": "#/definitions/model.Ancestry"
+                },
+                "ancestryID": {
+                    "type": "integer"
+                },
+                "attribute": {
+                    "$ref": "#/definitions/model.Attribute"
+                },
+                "background": {
+                    "$ref": "#/definitions/model.Background"
+                },
+                "backgroundID": {
+                    "type": "integer"
+                },
+                "boost": {
+                    "$ref": "#/definitions/model.CharacterBoost"
+                },
+                "campaignID": {
+                    "type": "integer"
+                },
+                "characterClass": {
+                    "$ref": "#/definitions/model.CharacterClass"
+                },
+                "characterClassID": {
+                    "type": "integer"
+                },
+                "characterCondition": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterCondition"
+                    }
+                },
+                "characterDefence": {
+                    "$ref": "#/definitions/model.CharacterDefence"
+                },
+                "characterFeat": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterFeat"
+                    }
+                },
+                "characterInfo": {
+                    "$ref": "#/definitions/model.CharacterInfo"
+                },
+                "characterItem": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterItem"
+                    }
+                },
+                "characterSkill": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterSkill"
+                    }
+                },
+                "characterSpell": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterSpell"
+                    }
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "focusPoint": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "levelReady": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "preparedSpell": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterPreparedSpell"
+                    }
+                },
+                "purse": {
+                    "$ref": "#/definitions/model.Purse"
+                },
+                "race": {
+                    "$ref": "#/definitions/model.Race"
+                },
+                "raceID": {
+                    "type": "integer"
+                },
+                "slot": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "spellSlot": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterSpellSlot"
+                    }
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CharacterBoost": {
+            "type": "object",
+            "properties": {
+                "ancestryBoost": {
+                    "type": "integer"
+                },
+                "backgroundBoost": {
+                    "type": "boolean"
+                },
+                "characterID": {
+                    "type": "integer"
+                },
+                "classBoost": {
+                    "type": "boolean"
+                },
+                "freeBoost": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CharacterBoostExternal": {
+            "type": "object",
+            "properties": {
+                "ancestry_boost": {
+                    "type": "integer"
+                },
+                "attribute": {
+                    "$ref": "#/definitions/model.AttributeExternal"
+                },
+                "background_boost": {
+                    "type": "boolean"
+                },
+                "boosts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AttributeBoostExternal"
+                    }
+                },
+                "character_id": {
+                    "type": "integer"
+                },
+                "class_boost": {
+                    "type": "boolean"
+                },
+                "free_boost": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CharacterClass": {
+            "type": "object",
+            "properties": {
+                "commonWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "fortitude": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "heavyArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "hitPoint": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "keyAbility": {
+                    "$ref": "#/definitions/model.Ability"
+                },
+                "lightArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "martialWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "mediumArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "perception": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "reflex": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "spellSlots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SpellCharacterClass"
+                    }
+                },
+                "spellcasting": {
+                    "$ref": "#/definitions/model.Spellcasting"
+                },
+                "traditionID": {
+                    "type": "integer"
+                },
+                "trainedSkills": {
+                    "type": "integer"
+                },
+                "unArmedWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "unarmedArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "will": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                }
+            }
+        },
+        "model.CharacterClassCreate": {
+            "type": "object",
+            "properties": {
+                "common_weapon": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "fortitude": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "health": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "heavy_armor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "key_ability": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Ability"
+                        }
+                    ],
+                    "example": "Strength"
+                },
+                "light_armor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "martial_weapon": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "medium_armor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Fighter"
+                },
+                "perception": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "reflex": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "spellcasting": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Spellcasting"
+                        }
+                    ],
+                    "example": "Prepared"
+                },
+                "tradition_id": {
+                    "type": "integer"
+                },
+                "trained_skills": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "un_armed_weapon": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "unarmed_armor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                },
+                "will": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Train"
+                }
+            }
+        },
+        "model.CharacterCondition": {
+            "type": "object",
+            "properties": {
+                "characterID": {
+                    "type": "integer"
+                },
+                "condition": {
+                    "$ref": "#/definitions/model.Condition"
+                },
+                "conditionID": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CharacterConditionExternal": {
+            "type": "object",
+            "properties": {
+                "condition_id": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CharacterDefence": {
             "type": "object",
             "properties": {
+                "armorClass": {
+                    "type": "integer"
+                },
+                "characterID": {
+                    "type": "integer"
+                },
+                "commonWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "dying": {
+                    "type": "integer"
+                },
+                "fortitude": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "heavyArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "hitPoint": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "lightArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "martialWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "maxHitPoint": {
+                    "type": "integer"
+                },
+                "mediumArmor": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "perception": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "reflex": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "temporaryHitPoint": {
+                    "type": "integer"
+                },
+                "unArmedWeapon": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "unarmed": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "will": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "wounded": {
+                    "type": "integer"
                 }
             }
         },
-        "model.Ancestry": {
+        "model.CharacterExperienceExternal": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "id": {
+                "character_id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "experience": {
+                    "type": "integer"
                 },
-                "raceID": {
+                "level": {
                     "type": "integer"
+                },
+                "log": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExperienceLogExternal"
+                    }
+                },
+                "ready_to_level_up": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.AncestryCreate": {
+        "model.CharacterExternal": {
             "type": "object",
             "required": [
-                "description",
-                "name",
-                "race_id"
+                "name"
             ],
             "properties": {
-                "description": {
+                "alias": {
+                    "type": "string"
+                },
+                "ancestry_id": {
+                    "type": "integer"
+                },
+                "ancestry_name": {
+                    "type": "string"
+                },
+                "attribute": {
+                    "$ref": "#/definitions/model.Attribute"
+                },
+                "background_id": {
+                    "type": "integer"
+                },
+                "background_name": {
+                    "type": "string"
+                },
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "character_boost": {
+                    "$ref": "#/definitions/model.CharacterBoost"
+                },
+                "character_class_id": {
+                    "type": "integer"
+                },
+                "character_class_name": {
+                    "type": "string"
+                },
+                "character_defence": {
+                    "$ref": "#/definitions/model.CharacterDefence"
+                },
+                "character_feat": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterFeat"
+                    }
+                },
+                "character_info": {
+                    "$ref": "#/definitions/model.CharacterInfo"
+                },
+                "character_item": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterItem"
+                    }
+                },
+                "character_skill": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterSkill"
+                    }
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_name": {
                     "type": "string"
                 },
+                "level": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
                 "race_id": {
                     "type": "integer"
+                },
+                "race_name": {
+                    "type": "string"
+                },
+                "ready_to_level_up": {
+                    "type": "boolean"
+                },
+                "slot": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "model.AncestryExternal": {
+        "model.CharacterFeat": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "characterID": {
+                    "type": "integer"
+                },
+                "featID": {
+                    "type": "integer"
                 },
                 "id": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.CharacterFeatExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "feat_id": {
+                    "type": "integer"
                 },
-                "race_id": {
+                "id": {
                     "type": "integer"
                 }
             }
         },
-        "model.AncestryUpdate": {
+        "model.CharacterInfo": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "bulk": {
+                    "type": "number"
                 },
-                "name": {
-                    "type": "string"
+                "characterID": {
+                    "type": "integer"
                 },
-                "race_id": {
+                "classDC": {
+                    "type": "integer"
+                },
+                "heroPoint": {
                     "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "maxBulk": {
+                    "type": "number"
                 }
             }
         },
-        "model.Armor": {
+        "model.CharacterItem": {
             "type": "object",
             "properties": {
-                "armorClass": {
+                "armor": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "character": {
+                    "$ref": "#/definitions/model.Character"
+                },
+                "characterID": {
+                    "type": "integer"
+                },
+                "charges": {
+                    "type": "integer"
+                },
+                "containerID": {
+                    "type": "integer"
+                },
+                "firstWeapon": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "hitPoint": {
                     "type": "integer"
                 },
                 "id": {
@@ -4474,167 +10587,303 @@ const docTemplate = `{
                 },
                 "item": {
                     "$ref": "#/definitions/model.Item"
+                },
+                "itemID": {
+                    "type": "integer"
+                },
+                "placement": {
+                    "$ref": "#/definitions/model.ItemPlacement"
+                },
+                "potency": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "resilient": {
+                    "type": "integer"
+                },
+                "runes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Rune"
+                    }
+                },
+                "secondWeapon": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "shield": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "striking": {
+                    "type": "integer"
                 }
             }
         },
-        "model.ArmorExternal": {
+        "model.CharacterItemExternal": {
             "type": "object",
-            "required": [
-                "name",
-                "price"
-            ],
             "properties": {
-                "armor_class": {
-                    "type": "integer"
-                },
                 "bulk": {
                     "type": "number"
                 },
-                "description": {
+                "character_id": {
+                    "type": "integer"
+                },
+                "character_name": {
                     "type": "string"
                 },
+                "charges": {
+                    "type": "integer"
+                },
+                "container_id": {
+                    "type": "integer"
+                },
+                "hit_point": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "item_id": {
+                "itemID": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "item_type": {
+                    "type": "string"
+                },
+                "placement": {
+                    "$ref": "#/definitions/model.ItemPlacement"
+                },
+                "potency": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "resilient": {
                     "type": "integer"
                 },
-                "level": {
+                "runes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "striking": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.CharacterLevelChangeExternal": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
                 },
-                "name": {
+                "new_value": {
                     "type": "string"
                 },
-                "price": {
+                "old_value": {
+                    "type": "string"
+                },
+                "target": {
                     "type": "string"
                 }
             }
         },
-        "model.Attribute": {
+        "model.CharacterLevelExternal": {
             "type": "object",
             "properties": {
-                "characterID": {
-                    "type": "integer"
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterLevelChangeExternal"
+                    }
                 },
-                "charisma": {
+                "character_id": {
                     "type": "integer"
                 },
-                "constitution": {
+                "experience": {
                     "type": "integer"
                 },
-                "dexterity": {
+                "hit_point": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "intelligence": {
-                    "type": "integer"
-                },
-                "strength": {
+                "level": {
                     "type": "integer"
                 },
-                "wisdom": {
-                    "type": "integer"
+                "milestone": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.AttributeExternal": {
+        "model.CharacterPreparedSpell": {
             "type": "object",
             "properties": {
                 "characterID": {
                     "type": "integer"
                 },
-                "charisma": {
+                "expended": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "constitution": {
+                "rank": {
                     "type": "integer"
                 },
-                "dexterity": {
+                "spell": {
+                    "$ref": "#/definitions/model.Spell"
+                },
+                "spellID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CharacterSkill": {
+            "type": "object",
+            "properties": {
+                "characterID": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "intelligence": {
-                    "type": "integer"
+                "lore": {
+                    "type": "boolean"
                 },
-                "strength": {
-                    "type": "integer"
+                "mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
                 },
-                "wisdom": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "model.Background": {
+        "model.CharacterSkillCreate": {
             "type": "object",
+            "required": [
+                "character_id",
+                "name"
+            ],
             "properties": {
-                "character": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Character"
-                    }
+                "character_id": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "mastery": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "None"
                 },
-                "featID": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "example": "Sailing Lore"
                 },
-                "firstSkillID": {
+                "override": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CharacterSkillExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "lore": {
+                    "type": "boolean"
+                },
+                "mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
                 "name": {
                     "type": "string"
-                },
-                "secondSkillID": {
-                    "type": "integer"
                 }
             }
         },
-        "model.BackgroundCreate": {
+        "model.CharacterSkillUpdate": {
             "type": "object",
             "required": [
-                "description",
-                "feat_id",
-                "first_skill_id",
-                "name",
-                "second_skill_id"
+                "mastery"
             ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "mastery": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MasteryLevel"
+                        }
+                    ],
+                    "example": "Expert"
                 },
-                "feat_id": {
+                "override": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CharacterSpell": {
+            "type": "object",
+            "properties": {
+                "characterID": {
                     "type": "integer"
                 },
-                "first_skill_id": {
+                "focus": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "rank": {
+                    "type": "integer"
                 },
-                "second_skill_id": {
+                "spell": {
+                    "$ref": "#/definitions/model.Spell"
+                },
+                "spellID": {
                     "type": "integer"
                 }
             }
         },
-        "model.BackgroundExternal": {
+        "model.CharacterSpellCreate": {
             "type": "object",
+            "required": [
+                "spell_id"
+            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "focus": {
+                    "type": "boolean"
                 },
-                "feat_id": {
-                    "type": "integer"
+                "rank": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "first_skill_id": {
+                "spell_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.CharacterSpellExternal": {
+            "type": "object",
+            "properties": {
+                "focus": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
@@ -4642,910 +10891,1328 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "second_skill_id": {
+                "rank": {
+                    "type": "integer"
+                },
+                "spell_id": {
                     "type": "integer"
                 }
             }
         },
-        "model.BackgroundUpdate": {
+        "model.CharacterSpellSlot": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "feat_id": {
+                "characterID": {
                     "type": "integer"
                 },
-                "first_skill_id": {
+                "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "rank": {
+                    "type": "integer"
                 },
-                "second_skill_id": {
+                "used": {
                     "type": "integer"
                 }
             }
         },
-        "model.Character": {
+        "model.CharacterStatsExternal": {
             "type": "object",
             "properties": {
-                "alias": {
-                    "type": "string"
-                },
-                "ancestry": {
-                    "$ref": "#/definitions/model.Ancestry"
+                "armor_class": {
+                    "$ref": "#/definitions/model.Statistic"
                 },
-                "ancestryID": {
+                "character_id": {
                     "type": "integer"
                 },
-                "attribute": {
-                    "$ref": "#/definitions/model.Attribute"
+                "class_dc": {
+                    "$ref": "#/definitions/model.Statistic"
                 },
-                "background": {
-                    "$ref": "#/definitions/model.Background"
+                "fortitude": {
+                    "$ref": "#/definitions/model.Statistic"
                 },
-                "backgroundID": {
+                "level": {
                     "type": "integer"
                 },
-                "boost": {
-                    "$ref": "#/definitions/model.CharacterBoost"
-                },
-                "characterClass": {
-                    "$ref": "#/definitions/model.CharacterClass"
-                },
-                "characterClassID": {
-                    "type": "integer"
+                "perception": {
+                    "$ref": "#/definitions/model.Statistic"
                 },
-                "characterDefence": {
-                    "$ref": "#/definitions/model.CharacterDefence"
+                "reflex": {
+                    "$ref": "#/definitions/model.Statistic"
                 },
-                "characterFeat": {
+                "skills": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.CharacterFeat"
+                        "$ref": "#/definitions/model.Statistic"
                     }
                 },
-                "characterInfo": {
-                    "$ref": "#/definitions/model.CharacterInfo"
+                "will": {
+                    "$ref": "#/definitions/model.Statistic"
+                }
+            }
+        },
+        "model.CharacterStrikesExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "integer"
                 },
-                "characterItem": {
+                "strikes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.CharacterItem"
+                        "$ref": "#/definitions/model.StrikeExternal"
                     }
+                }
+            }
+        },
+        "model.CharacterUpdate": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
                 },
-                "characterSkill": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CharacterSkill"
-                    }
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ClassFeature": {
+            "type": "object",
+            "properties": {
+                "armorMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "characterClass": {
+                    "$ref": "#/definitions/model.CharacterClass"
+                },
+                "characterClassID": {
+                    "type": "integer"
                 },
-                "characterSpell": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CharacterSpell"
-                    }
+                "fortitudeMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "last_name": {
-                    "type": "string"
+                "isAncestryFeat": {
+                    "type": "boolean"
                 },
-                "level": {
-                    "type": "integer"
+                "isCharacterBoost": {
+                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "isClassFeat": {
+                    "type": "boolean"
                 },
-                "race": {
-                    "$ref": "#/definitions/model.Race"
+                "isGeneralFeat": {
+                    "type": "boolean"
                 },
-                "raceID": {
+                "isSkillFeat": {
+                    "type": "boolean"
+                },
+                "isSkillIncrease": {
+                    "type": "boolean"
+                },
+                "level": {
                     "type": "integer"
                 },
-                "slot": {
+                "perceptionMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "reflexMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "skillFeatures": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Slot"
+                        "$ref": "#/definitions/model.SkillFeature"
                     }
                 },
-                "userID": {
-                    "type": "integer"
+                "weaponMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "willMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
                 }
             }
         },
-        "model.CharacterBoost": {
+        "model.ClassFeatureExternal": {
             "type": "object",
             "properties": {
-                "ancestryBoost": {
+                "armor_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "character_class_id": {
                     "type": "integer"
                 },
-                "backgroundBoost": {
-                    "type": "boolean"
+                "class_skill_feature": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SkillFeatureExternal"
+                    }
                 },
-                "characterID": {
+                "fortitude_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "classBoost": {
+                "is_ancestry_feat": {
                     "type": "boolean"
                 },
-                "freeBoost": {
-                    "type": "integer"
+                "is_character_boost": {
+                    "type": "boolean"
                 },
-                "id": {
+                "is_class_feat": {
+                    "type": "boolean"
+                },
+                "is_general_feat": {
+                    "type": "boolean"
+                },
+                "is_skill_feat": {
+                    "type": "boolean"
+                },
+                "is_skill_increase": {
+                    "type": "boolean"
+                },
+                "level": {
                     "type": "integer"
+                },
+                "perception_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "reflex_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "weapon_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "will_mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
                 }
             }
         },
-        "model.CharacterBoostExternal": {
+        "model.CombatantExternal": {
             "type": "object",
             "properties": {
-                "characterID": {
+                "armor_class": {
                     "type": "integer"
                 },
-                "id": {
+                "character_id": {
                     "type": "integer"
-                }
-            }
-        },
-        "model.CharacterClass": {
-            "type": "object",
-            "properties": {
-                "commonWeapon": {
-                    "$ref": "#/definitions/model.MasteryLevel"
                 },
-                "fortitude": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterConditionExternal"
+                    }
                 },
-                "heavyArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "creature_id": {
+                    "type": "integer"
                 },
-                "hitPoint": {
+                "current": {
+                    "type": "boolean"
+                },
+                "hit_point": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "lightArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "martialWeapon": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "initiative": {
+                    "type": "integer"
                 },
-                "mediumArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "max_hit_point": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "perception": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "persistent_damage": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PersistentDamageExternal"
+                    }
                 },
-                "reflex": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "reaction_used": {
+                    "type": "boolean"
                 },
-                "traditionID": {
+                "temporary_hit_point": {
                     "type": "integer"
-                },
-                "unArmedWeapon": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "unarmedArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "will": {
-                    "$ref": "#/definitions/model.MasteryLevel"
                 }
             }
         },
-        "model.CharacterClassCreate": {
+        "model.Condition": {
             "type": "object",
             "properties": {
-                "common_weapon": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
-                },
-                "fortitude": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
-                },
-                "health": {
-                    "type": "integer",
-                    "example": 6
-                },
-                "heavy_armor": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
-                },
-                "light_armor": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ConditionAbility"
+                    }
                 },
-                "martial_weapon": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "allChecks": {
+                    "type": "boolean"
                 },
-                "medium_armor": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "armorClass": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Fighter"
+                "characterCondition": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterCondition"
+                    }
                 },
-                "perception": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "decrement": {
+                    "type": "boolean"
                 },
-                "reflex": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "description": {
+                    "type": "string"
                 },
-                "tradition_id": {
+                "id": {
                     "type": "integer"
                 },
-                "un_armed_weapon": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
-                },
-                "unarmed_armor": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "name": {
+                    "type": "string"
                 },
-                "will": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
-                    ],
-                    "example": "Train"
+                "penaltyType": {
+                    "type": "string"
+                },
+                "valued": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.CharacterDefence": {
+        "model.ConditionAbility": {
             "type": "object",
             "properties": {
-                "armorClass": {
-                    "type": "integer"
+                "ability": {
+                    "$ref": "#/definitions/model.Ability"
                 },
-                "characterID": {
+                "conditionID": {
                     "type": "integer"
                 },
-                "dying": {
+                "id": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.ConditionExternal": {
+            "type": "object",
+            "properties": {
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Ability"
+                    }
                 },
-                "fortitude": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "heavyArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "all_checks": {
+                    "type": "boolean"
                 },
-                "hitPoint": {
+                "armor_class": {
                     "type": "integer"
                 },
+                "decrement": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "lightArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "name": {
+                    "type": "string"
                 },
-                "maxHitPoint": {
+                "penalty_type": {
+                    "type": "string"
+                },
+                "valued": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ConsumableExternal": {
+            "type": "object",
+            "properties": {
+                "bulk": {
+                    "type": "number"
+                },
+                "charges": {
                     "type": "integer"
                 },
-                "mediumArmor": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "description": {
+                    "type": "string"
                 },
-                "perception": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "id": {
+                    "type": "integer"
                 },
-                "reflex": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "item_id": {
+                    "type": "integer"
                 },
-                "speed": {
+                "kind": {
+                    "$ref": "#/definitions/model.ConsumableKind"
+                },
+                "level": {
                     "type": "integer"
                 },
-                "temporaryHitPoint": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "spell_id": {
                     "type": "integer"
                 },
-                "unarmed": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "spell_name": {
+                    "type": "string"
                 },
-                "will": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "spell_rank": {
+                    "type": "integer"
                 },
-                "wounded": {
-                    "type": "boolean"
+                "uses": {
+                    "type": "integer"
                 }
             }
         },
-        "model.CharacterExternal": {
+        "model.ConsumableKind": {
+            "type": "string",
+            "enum": [
+                "Consumable",
+                "Wand",
+                "Staff"
+            ],
+            "x-enum-varnames": [
+                "ConsumableItem",
+                "WandItem",
+                "StaffItem"
+            ]
+        },
+        "model.CreateAction": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "alias": {
+                "name": {
                     "type": "string"
-                },
-                "ancestry_id": {
+                }
+            }
+        },
+        "model.CreateArmor": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "price"
+            ],
+            "properties": {
+                "armor_class": {
                     "type": "integer"
                 },
-                "ancestry_name": {
-                    "type": "string"
+                "bulk": {
+                    "type": "number"
                 },
-                "attribute": {
-                    "$ref": "#/definitions/model.Attribute"
+                "category": {
+                    "enum": [
+                        "Unarmored",
+                        "Light",
+                        "Medium",
+                        "Heavy"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ArmorCategory"
+                        }
+                    ],
+                    "example": "Medium"
                 },
-                "background_id": {
-                    "type": "integer"
+                "check_penalty": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "background_name": {
+                "description": {
                     "type": "string"
                 },
-                "character_boost": {
-                    "$ref": "#/definitions/model.CharacterBoost"
+                "dex_cap": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "character_class_id": {
+                "group": {
+                    "type": "string",
+                    "example": "chain"
+                },
+                "level": {
                     "type": "integer"
                 },
-                "character_class_name": {
+                "name": {
                     "type": "string"
                 },
-                "character_defence": {
-                    "$ref": "#/definitions/model.CharacterDefence"
+                "price": {
+                    "type": "string"
                 },
-                "character_feat": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CharacterFeat"
-                    }
+                "speed_penalty": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "character_info": {
-                    "$ref": "#/definitions/model.CharacterInfo"
+                "strength": {
+                    "type": "integer",
+                    "example": 16
                 },
-                "character_item": {
+                "traits_id": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.CharacterItem"
+                        "type": "integer"
                     }
+                }
+            }
+        },
+        "model.CreateCampaign": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
                 },
-                "character_skill": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CharacterSkill"
-                    }
+                "milestone": {
+                    "type": "boolean"
                 },
-                "id": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCampaignInvite": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCharacter": {
+            "type": "object",
+            "required": [
+                "ancestry_id",
+                "background_id",
+                "name"
+            ],
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "ancestry_id": {
                     "type": "integer"
                 },
-                "last_name": {
-                    "type": "string"
+                "background_id": {
+                    "type": "integer"
                 },
-                "level": {
+                "character_class_id": {
                     "type": "integer"
                 },
+                "last_name": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "race_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.CreateCharacterCondition": {
+            "type": "object",
+            "required": [
+                "condition_id"
+            ],
+            "properties": {
+                "condition_id": {
+                    "type": "integer"
                 },
-                "race_name": {
-                    "type": "string"
+                "count": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "model.CreateCharacterFeat": {
+            "type": "object",
+            "required": [
+                "character_id",
+                "feat_id"
+            ],
+            "properties": {
+                "character_id": {
+                    "type": "integer"
                 },
-                "slot": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Slot"
-                    }
+                "feat_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CreateCharacterItem": {
+            "type": "object",
+            "required": [
+                "character_id",
+                "item_id"
+            ],
+            "properties": {
+                "character_id": {
+                    "type": "integer"
                 },
-                "user_id": {
+                "container_id": {
                     "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "placement": {
+                    "enum": [
+                        "Worn",
+                        "Carried",
+                        "Stowed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ItemPlacement"
+                        }
+                    ],
+                    "example": "Carried"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "model.CharacterFeat": {
+        "model.CreateCombatant": {
             "type": "object",
             "properties": {
-                "characterID": {
-                    "type": "integer"
+                "armor_class": {
+                    "type": "integer",
+                    "example": 16
                 },
-                "featID": {
+                "character_id": {
                     "type": "integer"
                 },
-                "id": {
+                "creature_id": {
                     "type": "integer"
+                },
+                "hit_point": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "initiative_modifier": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Goblin Warrior"
                 }
             }
         },
-        "model.CharacterFeatExternal": {
+        "model.CreateCondition": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "character_id": {
-                    "type": "integer"
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Ability"
+                    },
+                    "example": [
+                        "Intelligence",
+                        "Wisdom",
+                        "Charisma"
+                    ]
                 },
-                "feat_id": {
-                    "type": "integer"
+                "all_checks": {
+                    "type": "boolean"
                 },
-                "id": {
+                "armor_class": {
                     "type": "integer"
+                },
+                "decrement": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Frightened"
+                },
+                "penalty_type": {
+                    "type": "string",
+                    "example": "status"
+                },
+                "valued": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.CharacterInfo": {
+        "model.CreateConsumable": {
             "type": "object",
+            "required": [
+                "description",
+                "name",
+                "price"
+            ],
             "properties": {
                 "bulk": {
-                    "type": "number"
+                    "type": "number",
+                    "example": 0.1
                 },
-                "characterID": {
-                    "type": "integer"
+                "charges": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "classDC": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "heroPoint": {
+                "kind": {
+                    "enum": [
+                        "Consumable",
+                        "Wand",
+                        "Staff"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ConsumableKind"
+                        }
+                    ],
+                    "example": "Wand"
+                },
+                "level": {
                     "type": "integer"
                 },
-                "id": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "spell_id": {
                     "type": "integer"
                 },
-                "maxBulk": {
-                    "type": "number"
+                "spell_rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "model.CharacterItem": {
+        "model.CreateDomain": {
             "type": "object",
+            "required": [
+                "description",
+                "name"
+            ],
             "properties": {
-                "armor": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Slot"
-                    }
+                "description": {
+                    "type": "string"
                 },
-                "character": {
-                    "$ref": "#/definitions/model.Character"
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateEncounter": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Goblin ambush"
+                }
+            }
+        },
+        "model.CreateFeat": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "category": {
+                    "enum": [
+                        "Ancestry",
+                        "Class",
+                        "Skill",
+                        "General",
+                        "Archetype"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.FeatCategory"
+                        }
+                    ]
                 },
-                "characterID": {
+                "character_class_id": {
                     "type": "integer"
                 },
-                "firstWeapon": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Slot"
-                    }
+                "description": {
+                    "type": "string"
                 },
-                "id": {
+                "level": {
                     "type": "integer"
                 },
-                "item": {
-                    "$ref": "#/definitions/model.Item"
-                },
-                "itemID": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "prerequisiteMastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
                 },
-                "secondWeapon": {
+                "prerequisite_feat_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Slot"
+                        "type": "integer"
                     }
+                },
+                "prerequisite_skill_id": {
+                    "type": "integer"
+                },
+                "race_id": {
+                    "type": "integer"
                 }
             }
         },
-        "model.CharacterItemExternal": {
+        "model.CreateGear": {
             "type": "object",
+            "required": [
+                "bulk",
+                "description",
+                "name",
+                "price"
+            ],
             "properties": {
                 "bulk": {
-                    "type": "number"
+                    "type": "number",
+                    "example": 1
                 },
-                "character_id": {
-                    "type": "integer"
+                "bulk_reduction": {
+                    "type": "number",
+                    "example": 2
                 },
-                "character_name": {
-                    "type": "string"
+                "capacity": {
+                    "type": "number",
+                    "example": 4
                 },
-                "id": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "itemID": {
+                "level": {
                     "type": "integer"
                 },
-                "item_name": {
+                "name": {
                     "type": "string"
                 },
-                "item_type": {
+                "price": {
                     "type": "string"
-                },
-                "quantity": {
-                    "type": "integer",
-                    "example": 1
                 }
             }
         },
-        "model.CharacterSkill": {
+        "model.CreatePersistentDamage": {
             "type": "object",
+            "required": [
+                "notation"
+            ],
             "properties": {
-                "characterID": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "damage_type": {
+                    "type": "string",
+                    "example": "fire"
                 },
-                "name": {
-                    "type": "string"
+                "notation": {
+                    "type": "string",
+                    "maxLength": 63,
+                    "example": "1d6"
                 }
             }
         },
-        "model.CharacterSkillCreate": {
+        "model.CreateRune": {
             "type": "object",
             "required": [
-                "character_id",
-                "mastery"
+                "name",
+                "target"
             ],
             "properties": {
-                "character_id": {
-                    "type": "integer"
-                },
-                "mastery": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.MasteryLevel"
-                        }
+                "damage_dice": {
+                    "type": "integer",
+                    "enum": [
+                        4,
+                        6,
+                        8,
+                        10,
+                        12
                     ],
-                    "example": "None"
+                    "example": 6
                 },
-                "name": {
+                "damage_type": {
+                    "type": "string",
+                    "example": "fire"
+                },
+                "description": {
                     "type": "string"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Flaming"
+                },
+                "price": {
+                    "type": "string",
+                    "example": "500 gp"
+                },
+                "target": {
+                    "type": "string",
+                    "enum": [
+                        "weapons",
+                        "armors"
+                    ],
+                    "example": "weapons"
                 }
             }
         },
-        "model.CharacterSkillExternal": {
+        "model.CreateShield": {
             "type": "object",
+            "required": [
+                "description",
+                "hit_point",
+                "name",
+                "price"
+            ],
             "properties": {
-                "character_id": {
-                    "type": "integer"
+                "armor_class": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "broken_threshold": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "bulk": {
+                    "type": "number",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hardness": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "id": {
-                    "type": "integer"
+                "hit_point": {
+                    "type": "integer",
+                    "example": 20
                 },
-                "mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "level": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
+                },
+                "price": {
+                    "type": "string"
                 }
             }
         },
-        "model.CharacterSkillUpdate": {
+        "model.CreateTradition": {
             "type": "object",
+            "required": [
+                "description",
+                "name"
+            ],
             "properties": {
-                "mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "model.CharacterSpell": {
+        "model.CreateTrait": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "characterID": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "spellID": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "model.CharacterUpdate": {
+        "model.CreateUser": {
             "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
             "properties": {
-                "alias": {
-                    "type": "string"
-                },
-                "last_name": {
+                "email": {
                     "type": "string"
                 },
-                "level": {
-                    "type": "integer"
-                },
-                "name": {
+                "password": {
                     "type": "string"
                 }
             }
         },
-        "model.ClassFeature": {
+        "model.CreateWeapon": {
             "type": "object",
+            "required": [
+                "bulk",
+                "damage_type",
+                "description",
+                "dice",
+                "diceQuantity",
+                "name",
+                "price"
+            ],
             "properties": {
-                "armorMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "characterClass": {
-                    "$ref": "#/definitions/model.CharacterClass"
+                "agile": {
+                    "type": "boolean"
                 },
-                "characterClassID": {
-                    "type": "integer"
+                "bulk": {
+                    "type": "number",
+                    "example": 0.1
                 },
-                "fortitudeMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.WeaponCategory"
+                        }
+                    ],
+                    "example": "Martial"
                 },
-                "id": {
+                "damage": {
                     "type": "integer"
                 },
-                "isAncestryFeat": {
-                    "type": "boolean"
-                },
-                "isCharacterBoost": {
-                    "type": "boolean"
+                "damage_type": {
+                    "type": "string"
                 },
-                "isClassFeat": {
-                    "type": "boolean"
+                "description": {
+                    "type": "string"
                 },
-                "isGeneralFeat": {
-                    "type": "boolean"
+                "dice": {
+                    "type": "integer",
+                    "example": 4
                 },
-                "isSkillFeat": {
-                    "type": "boolean"
+                "diceQuantity": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "isSkillIncrease": {
+                "finesse": {
                     "type": "boolean"
                 },
                 "level": {
                     "type": "integer"
                 },
-                "perceptionMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "name": {
+                    "type": "string"
                 },
-                "reflexMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "price": {
+                    "type": "string"
                 },
-                "skillFeatures": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.SkillFeature"
-                    }
+                "propulsive": {
+                    "type": "boolean"
                 },
-                "weaponMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "range": {
+                    "type": "integer",
+                    "example": 0
                 },
-                "willMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "ranged": {
+                    "type": "boolean"
+                },
+                "thrown": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.ClassFeatureExternal": {
+        "model.CreatureCreate": {
             "type": "object",
+            "required": [
+                "armor_class",
+                "hit_points",
+                "name"
+            ],
             "properties": {
-                "armor_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "armor_class": {
+                    "type": "integer"
                 },
-                "character_class_id": {
+                "burrow_speed": {
                     "type": "integer"
                 },
-                "class_skill_feature": {
+                "climb_speed": {
+                    "type": "integer"
+                },
+                "defences": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.SkillFeatureExternal"
+                        "$ref": "#/definitions/model.CreatureDefenceCreate"
                     }
                 },
-                "fortitude_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "description": {
+                    "type": "string"
                 },
-                "id": {
+                "fly_speed": {
                     "type": "integer"
                 },
-                "is_ancestry_feat": {
-                    "type": "boolean"
+                "fortitude": {
+                    "type": "integer"
                 },
-                "is_character_boost": {
-                    "type": "boolean"
+                "hit_points": {
+                    "type": "integer"
                 },
-                "is_class_feat": {
-                    "type": "boolean"
+                "language": {
+                    "type": "string"
                 },
-                "is_general_feat": {
-                    "type": "boolean"
+                "level": {
+                    "type": "integer"
                 },
-                "is_skill_feat": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "is_skill_increase": {
-                    "type": "boolean"
+                "perception": {
+                    "type": "integer"
                 },
-                "level": {
+                "rarity": {
+                    "$ref": "#/definitions/model.Rarity"
+                },
+                "reflex": {
                     "type": "integer"
                 },
-                "perception_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "size": {
+                    "$ref": "#/definitions/model.SquareSize"
                 },
-                "reflex_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "speed": {
+                    "type": "integer"
                 },
-                "weapon_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "spells_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "will_mastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
+                "strikes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CreatureStrikeCreate"
+                    }
+                },
+                "swim_speed": {
+                    "type": "integer"
+                },
+                "traits_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "will": {
+                    "type": "integer"
                 }
             }
         },
-        "model.CreateAction": {
+        "model.CreatureDefenceCreate": {
             "type": "object",
             "required": [
+                "kind",
                 "name"
             ],
             "properties": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "immunity",
+                        "resistance",
+                        "weakness"
+                    ],
+                    "example": "weakness"
+                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "fire"
+                },
+                "value": {
+                    "type": "integer",
+                    "example": 5
                 }
             }
         },
-        "model.CreateArmor": {
+        "model.CreatureDefenceExternal": {
             "type": "object",
-            "required": [
-                "description",
-                "name",
-                "price"
-            ],
             "properties": {
-                "armor_class": {
-                    "type": "integer"
-                },
-                "bulk": {
-                    "type": "number"
-                },
-                "description": {
+                "kind": {
                     "type": "string"
                 },
-                "level": {
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "string"
+                "value": {
+                    "type": "integer"
                 }
             }
         },
-        "model.CreateCharacter": {
+        "model.CreatureExternal": {
             "type": "object",
-            "required": [
-                "ancestry_id",
-                "background_id",
-                "name"
-            ],
             "properties": {
-                "alias": {
+                "armor_class": {
+                    "type": "integer"
+                },
+                "burrow_speed": {
+                    "type": "integer"
+                },
+                "climb_speed": {
+                    "type": "integer"
+                },
+                "defences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CreatureDefenceExternal"
+                    }
+                },
+                "description": {
                     "type": "string"
                 },
-                "ancestry_id": {
+                "fly_speed": {
+                    "type": "integer"
+                },
+                "fortitude": {
                     "type": "integer"
                 },
-                "background_id": {
+                "hit_points": {
                     "type": "integer"
                 },
-                "character_class_id": {
+                "id": {
                     "type": "integer"
                 },
-                "last_name": {
+                "language": {
                     "type": "string"
                 },
+                "level": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "race_id": {
+                "perception": {
                     "type": "integer"
-                }
-            }
-        },
-        "model.CreateCharacterFeat": {
-            "type": "object",
-            "required": [
-                "character_id",
-                "feat_id"
-            ],
-            "properties": {
-                "character_id": {
+                },
+                "rarity": {
+                    "$ref": "#/definitions/model.Rarity"
+                },
+                "reflex": {
                     "type": "integer"
                 },
-                "feat_id": {
+                "size": {
+                    "$ref": "#/definitions/model.SquareSize"
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "strikes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CreatureStrikeExternal"
+                    }
+                },
+                "swim_speed": {
+                    "type": "integer"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "will": {
                     "type": "integer"
                 }
             }
         },
-        "model.CreateCharacterItem": {
+        "model.CreatureStrikeCreate": {
             "type": "object",
             "required": [
-                "character_id",
-                "item_id"
+                "name"
             ],
             "properties": {
-                "character_id": {
-                    "type": "integer"
+                "attack_bonus": {
+                    "type": "integer",
+                    "example": 8
                 },
-                "item_id": {
-                    "type": "integer"
+                "damage": {
+                    "type": "string",
+                    "example": "1d6 slashing"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 1
+                "name": {
+                    "type": "string",
+                    "example": "Dogslicer"
+                },
+                "ranged": {
+                    "type": "boolean"
+                },
+                "traits": {
+                    "type": "string",
+                    "example": "agile, backstabber, finesse"
                 }
             }
         },
-        "model.CreateDomain": {
+        "model.CreatureStrikeExternal": {
             "type": "object",
-            "required": [
-                "description",
-                "name"
-            ],
             "properties": {
-                "description": {
+                "attack_bonus": {
+                    "type": "integer"
+                },
+                "damage": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "ranged": {
+                    "type": "boolean"
+                },
+                "traits": {
+                    "type": "string"
                 }
             }
         },
-        "model.CreateFeat": {
+        "model.CreatureXPExternal": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
+                },
+                "creature_id": {
+                    "type": "integer"
                 },
                 "level": {
                     "type": "integer"
@@ -5553,191 +12220,248 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "prerequisiteMastery": {
-                    "$ref": "#/definitions/model.MasteryLevel"
-                },
-                "prerequisite_skill_id": {
+                "xp": {
                     "type": "integer"
                 }
             }
         },
-        "model.CreateGear": {
+        "model.DamageCharacter": {
             "type": "object",
             "required": [
-                "bulk",
-                "description",
-                "name",
-                "price"
+                "amount"
             ],
             "properties": {
-                "bulk": {
-                    "type": "number",
-                    "example": 1
+                "amount": {
+                    "type": "integer",
+                    "example": 12
                 },
+                "critical": {
+                    "type": "boolean"
+                },
+                "shield_block": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.Domain": {
+            "type": "object",
+            "properties": {
                 "description": {
                     "type": "string"
                 },
-                "level": {
+                "gods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.God"
+                    }
+                },
+                "id": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
-                },
-                "price": {
-                    "type": "string"
                 }
             }
         },
-        "model.CreateTradition": {
+        "model.DomainExternal": {
             "type": "object",
             "required": [
                 "description",
+                "id",
                 "name"
             ],
             "properties": {
                 "description": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 }
             }
         },
-        "model.CreateTrait": {
+        "model.DomainID": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 }
             }
         },
-        "model.CreateUser": {
+        "model.EligibleFeatExternal": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "feats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FeatExternal"
+                    }
                 },
-                "password": {
-                    "type": "string"
+                "free_slots": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "model.CreateWeapon": {
+        "model.EncounterBudgetExternal": {
             "type": "object",
-            "required": [
-                "bulk",
-                "damage_type",
-                "description",
-                "dice",
-                "diceQuantity",
-                "name",
-                "price"
-            ],
             "properties": {
-                "bulk": {
-                    "type": "number",
-                    "example": 0.1
+                "actual_threat": {
+                    "$ref": "#/definitions/model.Threat"
                 },
-                "damage": {
+                "budget": {
                     "type": "integer"
                 },
-                "damage_type": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
+                "creatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CreatureXPExternal"
+                    }
                 },
-                "dice": {
-                    "type": "integer",
-                    "example": 4
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "diceQuantity": {
-                    "type": "integer",
-                    "example": 1
+                "party_level": {
+                    "type": "integer"
                 },
-                "level": {
+                "party_size": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EncounterSuggestionExternal"
+                    }
                 },
-                "price": {
-                    "type": "string"
+                "threat": {
+                    "$ref": "#/definitions/model.Threat"
+                },
+                "valid": {
+                    "type": "boolean"
+                },
+                "xp": {
+                    "type": "integer"
                 }
             }
         },
-        "model.Domain": {
+        "model.EncounterExternal": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "campaign_id": {
+                    "type": "integer"
                 },
-                "gods": {
+                "combatants": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.God"
+                        "$ref": "#/definitions/model.CombatantExternal"
                     }
                 },
+                "current_combatant_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "log": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
+                },
+                "round": {
+                    "type": "integer"
                 }
             }
         },
-        "model.DomainExternal": {
+        "model.EncounterSuggestionExternal": {
             "type": "object",
-            "required": [
-                "description",
-                "id",
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "creatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CreatureXPExternal"
+                    }
                 },
-                "id": {
-                    "type": "integer"
+                "threat": {
+                    "$ref": "#/definitions/model.Threat"
                 },
-                "name": {
-                    "type": "string"
+                "xp": {
+                    "type": "integer"
                 }
             }
         },
-        "model.DomainID": {
+        "model.ExperienceLogExternal": {
             "type": "object",
             "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "awarded_by_id": {
+                    "type": "integer"
+                },
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "character_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "experience": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
                 }
             }
         },
         "model.Feat": {
             "type": "object",
             "properties": {
+                "archetypeID": {
+                    "type": "integer"
+                },
                 "background": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/model.Background"
                     }
                 },
+                "category": {
+                    "$ref": "#/definitions/model.FeatCategory"
+                },
+                "characterClass": {
+                    "$ref": "#/definitions/model.CharacterClass"
+                },
+                "characterClassID": {
+                    "type": "integer"
+                },
                 "characterFeat": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/model.CharacterFeat"
                     }
                 },
+                "dedication": {
+                    "type": "boolean"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -5753,12 +12477,24 @@ const docTemplate = `{
                 "prerequisiteFeat": {
                     "type": "string"
                 },
+                "prerequisiteFeats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Feat"
+                    }
+                },
                 "prerequisiteMastery": {
                     "$ref": "#/definitions/model.MasteryLevel"
                 },
                 "prerequisiteSkillID": {
                     "type": "integer"
                 },
+                "race": {
+                    "$ref": "#/definitions/model.Race"
+                },
+                "raceID": {
+                    "type": "integer"
+                },
                 "rarity": {
                     "$ref": "#/definitions/model.Rarity"
                 },
@@ -5770,9 +12506,38 @@ const docTemplate = `{
                 }
             }
         },
+        "model.FeatCategory": {
+            "type": "string",
+            "enum": [
+                "Ancestry",
+                "Class",
+                "Skill",
+                "General",
+                "Archetype"
+            ],
+            "x-enum-varnames": [
+                "AncestryFeat",
+                "ClassFeat",
+                "SkillFeat",
+                "GeneralFeat",
+                "ArchetypeFeat"
+            ]
+        },
         "model.FeatExternal": {
             "type": "object",
             "properties": {
+                "archetype_id": {
+                    "type": "integer"
+                },
+                "category": {
+                    "$ref": "#/definitions/model.FeatCategory"
+                },
+                "character_class_id": {
+                    "type": "integer"
+                },
+                "dedication": {
+                    "type": "boolean"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -5791,9 +12556,18 @@ const docTemplate = `{
                 "prerequisite_feat": {
                     "type": "string"
                 },
+                "prerequisite_feat_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "prerequisite_skill_id": {
                     "type": "integer"
                 },
+                "race_id": {
+                    "type": "integer"
+                },
                 "traits": {
                     "type": "array",
                     "items": {
@@ -5820,6 +12594,14 @@ const docTemplate = `{
                     "type": "number",
                     "example": 1
                 },
+                "bulk_reduction": {
+                    "type": "number",
+                    "example": 2
+                },
+                "capacity": {
+                    "type": "number",
+                    "example": 4
+                },
                 "description": {
                     "type": "string"
                 },
@@ -5858,234 +12640,564 @@ const docTemplate = `{
                 "chosenWeapon": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "description": {
+                    "type": "string"
+                },
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Domain"
+                    }
+                },
+                "edict": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sacredAnimals": {
+                    "type": "string"
+                },
+                "sacredColors": {
+                    "type": "string"
+                },
+                "temples": {
+                    "type": "string"
+                },
+                "worships": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GodCreate": {
+            "type": "object",
+            "required": [
+                "alias",
+                "alignment",
+                "anathema",
+                "areas_of_interest",
+                "chosen_weapon",
+                "domains",
+                "edict",
+                "name",
+                "sacred_animals",
+                "sacred_colors",
+                "temples",
+                "worships"
+            ],
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "The Song of the Spheres"
+                },
+                "alignment": {
+                    "type": "string",
+                    "example": "CG"
+                },
+                "anathema": {
+                    "type": "string"
+                },
+                "areas_of_interest": {
+                    "type": "string"
+                },
+                "chosen_weapon": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DomainID"
+                    }
+                },
+                "edict": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Desna"
+                },
+                "sacred_animals": {
+                    "type": "string"
+                },
+                "sacred_colors": {
+                    "type": "string"
+                },
+                "temples": {
+                    "type": "string"
+                },
+                "worships": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GodUpdate": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "alignment": {
+                    "type": "string"
+                },
+                "anathema": {
+                    "type": "string"
+                },
+                "areas_of_interest": {
+                    "type": "string"
+                },
+                "chosen_weapon": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DomainID"
+                    }
+                },
+                "edict": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sacred_animals": {
+                    "type": "string"
+                },
+                "sacred_colors": {
+                    "type": "string"
+                },
+                "temples": {
+                    "type": "string"
+                },
+                "worships": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HealCharacter": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "model.HealthLogExternal": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "blocked": {
+                    "type": "integer"
+                },
+                "character_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "critical": {
+                    "type": "boolean"
+                },
+                "dead": {
+                    "type": "boolean"
+                },
+                "dying": {
+                    "type": "integer"
+                },
+                "hit_point": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "roll": {
+                    "type": "integer"
+                },
+                "shield_broken": {
+                    "type": "boolean"
+                },
+                "shield_destroyed": {
+                    "type": "boolean"
+                },
+                "shield_hit_point": {
+                    "type": "integer"
+                },
+                "shield_item_id": {
+                    "type": "integer"
+                },
+                "temporary_hit_point": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "wounded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Item": {
+            "type": "object",
+            "properties": {
+                "bulk": {
+                    "type": "number"
+                },
+                "bulkReduction": {
+                    "type": "number"
+                },
+                "capacity": {
+                    "type": "number"
                 },
-                "domains": {
+                "characterItem": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Domain"
+                        "$ref": "#/definitions/model.CharacterItem"
                     }
                 },
-                "edict": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "level": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "sacredAnimals": {
-                    "type": "string"
+                "ownerID": {
+                    "type": "integer"
                 },
-                "sacredColors": {
+                "ownerType": {
                     "type": "string"
                 },
-                "temples": {
+                "price": {
                     "type": "string"
                 },
-                "worships": {
-                    "type": "string"
+                "priceCopper": {
+                    "type": "integer"
                 }
             }
         },
-        "model.GodCreate": {
+        "model.ItemExternal": {
             "type": "object",
             "required": [
-                "alias",
-                "alignment",
-                "anathema",
-                "areas_of_interest",
-                "chosen_weapon",
-                "domains",
-                "edict",
                 "name",
-                "sacred_animals",
-                "sacred_colors",
-                "temples",
-                "worships"
+                "price"
             ],
             "properties": {
-                "alias": {
-                    "type": "string",
-                    "example": "The Song of the Spheres"
-                },
-                "alignment": {
-                    "type": "string",
-                    "example": "CG"
-                },
-                "anathema": {
-                    "type": "string"
+                "bulk": {
+                    "type": "number"
                 },
-                "areas_of_interest": {
-                    "type": "string"
+                "bulk_reduction": {
+                    "type": "number"
                 },
-                "chosen_weapon": {
-                    "type": "string"
+                "capacity": {
+                    "type": "number"
                 },
                 "description": {
                     "type": "string"
                 },
-                "domains": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.DomainID"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "edict": {
-                    "type": "string"
+                "level": {
+                    "type": "integer"
                 },
                 "name": {
-                    "type": "string",
-                    "example": "Desna"
-                },
-                "sacred_animals": {
                     "type": "string"
                 },
-                "sacred_colors": {
-                    "type": "string"
+                "owner_id": {
+                    "type": "integer"
                 },
-                "temples": {
+                "owner_type": {
                     "type": "string"
                 },
-                "worships": {
+                "price": {
                     "type": "string"
+                },
+                "price_copper": {
+                    "type": "integer"
                 }
             }
         },
-        "model.GodUpdate": {
+        "model.ItemPlacement": {
+            "type": "string",
+            "enum": [
+                "Worn",
+                "Carried",
+                "Stowed"
+            ],
+            "x-enum-varnames": [
+                "WornItem",
+                "CarriedItem",
+                "StowedItem"
+            ]
+        },
+        "model.JoinCampaign": {
             "type": "object",
+            "required": [
+                "character_id"
+            ],
             "properties": {
-                "alias": {
-                    "type": "string"
+                "character_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.LevelUpChoices": {
+            "type": "object",
+            "properties": {
+                "ancestry_feat": {
+                    "type": "boolean"
                 },
-                "alignment": {
-                    "type": "string"
+                "attribute_boosts": {
+                    "type": "integer"
                 },
-                "anathema": {
-                    "type": "string"
+                "character_id": {
+                    "type": "integer"
                 },
-                "areas_of_interest": {
-                    "type": "string"
+                "class_feat": {
+                    "type": "boolean"
                 },
-                "chosen_weapon": {
-                    "type": "string"
+                "feat_options": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model.FeatExternal"
+                        }
+                    }
                 },
-                "description": {
-                    "type": "string"
+                "general_feat": {
+                    "type": "boolean"
                 },
-                "domains": {
+                "hit_point": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "mastery_upgrades": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.DomainID"
+                        "$ref": "#/definitions/model.MasteryUpgrade"
                     }
                 },
-                "edict": {
-                    "type": "string"
+                "skill_feat": {
+                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "skill_increase": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.LevelUpCreate": {
+            "type": "object",
+            "properties": {
+                "ancestry_feat_id": {
+                    "type": "integer"
                 },
-                "sacred_animals": {
+                "attribute_boosts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Ability"
+                    }
+                },
+                "class_feat_id": {
+                    "type": "integer"
+                },
+                "general_feat_id": {
+                    "type": "integer"
+                },
+                "skill_feat_id": {
+                    "type": "integer"
+                },
+                "skill_increase": {
+                    "type": "string",
+                    "example": "Athletics"
+                }
+            }
+        },
+        "model.MasteryLevel": {
+            "type": "string",
+            "enum": [
+                "None",
+                "Trained",
+                "Expert",
+                "Master",
+                "Legendary"
+            ],
+            "x-enum-varnames": [
+                "None",
+                "Train",
+                "Expert",
+                "Master",
+                "Legendary"
+            ]
+        },
+        "model.MasteryUpgrade": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "target": {
                     "type": "string"
                 },
-                "sacred_colors": {
+                "to": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                }
+            }
+        },
+        "model.Modifier": {
+            "type": "object",
+            "properties": {
+                "source": {
                     "type": "string"
                 },
-                "temples": {
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PersistentDamageExternal": {
+            "type": "object",
+            "properties": {
+                "damage_type": {
                     "type": "string"
                 },
-                "worships": {
+                "id": {
+                    "type": "integer"
+                },
+                "notation": {
                     "type": "string"
                 }
             }
         },
-        "model.Item": {
+        "model.PrepareSpells": {
             "type": "object",
             "properties": {
-                "bulk": {
-                    "type": "number"
-                },
-                "characterItem": {
+                "spells": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.CharacterItem"
+                        "$ref": "#/definitions/model.PreparedSpellCreate"
                     }
+                }
+            }
+        },
+        "model.PreparedSpellCreate": {
+            "type": "object",
+            "required": [
+                "spell_id"
+            ],
+            "properties": {
+                "rank": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
+                "spell_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.PreparedSpellExternal": {
+            "type": "object",
+            "properties": {
+                "expended": {
+                    "type": "boolean"
                 },
-                "level": {
+                "id": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "ownerID": {
+                "rank": {
                     "type": "integer"
                 },
-                "ownerType": {
-                    "type": "string"
+                "spell_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PriceMigrationExternal": {
+            "type": "object",
+            "properties": {
+                "converted": {
+                    "type": "integer"
                 },
-                "price": {
-                    "type": "string"
+                "unparseable": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UnparseablePriceExternal"
+                    }
                 }
             }
         },
-        "model.ItemExternal": {
+        "model.Purse": {
             "type": "object",
-            "required": [
-                "name",
-                "price"
-            ],
             "properties": {
-                "bulk": {
-                    "type": "number"
+                "copper": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "gold": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "platinum": {
+                    "type": "integer"
+                },
+                "silver": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PurseExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "integer"
                 },
-                "id": {
+                "copper": {
                     "type": "integer"
                 },
-                "level": {
+                "gold": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "platinum": {
+                    "type": "integer"
                 },
-                "owner_id": {
+                "silver": {
                     "type": "integer"
                 },
-                "owner_type": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 },
-                "price": {
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "model.MasteryLevel": {
-            "type": "string",
-            "enum": [
-                "None",
-                "Trained",
-                "Expert",
-                "Master",
-                "Legendary"
-            ],
-            "x-enum-varnames": [
-                "None",
-                "Train",
-                "Expert",
-                "Master",
-                "Legendary"
-            ]
-        },
         "model.Race": {
             "type": "object",
             "properties": {
@@ -6221,44 +13333,292 @@ const docTemplate = `{
                 }
             }
         },
-        "model.Rarity": {
-            "type": "string",
-            "enum": [
-                "Common",
-                "Uncommon",
-                "Rare",
-                "Mythic"
-            ],
-            "x-enum-varnames": [
-                "Common",
-                "Uncommon",
-                "Rare",
-                "Mythic"
-            ]
-        },
-        "model.School": {
-            "type": "string",
-            "enum": [
-                "Abjuration",
-                "Conjuration",
-                "Divination",
-                "Enchantment",
-                "Evocation",
-                "Illusion",
-                "Necromancy",
-                "Transmutation"
-            ],
-            "x-enum-varnames": [
-                "Abjuration",
-                "Conjuration",
-                "Divination",
-                "Enchantment",
-                "Evocation",
-                "Illusion",
-                "Necromancy",
-                "Transmutation"
-            ]
-        },
+        "model.RaiseShield": {
+            "type": "object",
+            "required": [
+                "raised"
+            ],
+            "properties": {
+                "raised": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "model.Rarity": {
+            "type": "string",
+            "enum": [
+                "Common",
+                "Uncommon",
+                "Rare",
+                "Mythic"
+            ],
+            "x-enum-varnames": [
+                "Common",
+                "Uncommon",
+                "Rare",
+                "Mythic"
+            ]
+        },
+        "model.RollCheck": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "dc": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "fortune": {
+                    "type": "boolean"
+                },
+                "misfortune": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Athletics"
+                }
+            }
+        },
+        "model.RollDice": {
+            "type": "object",
+            "required": [
+                "notation"
+            ],
+            "properties": {
+                "dc": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "fortune": {
+                    "type": "boolean"
+                },
+                "misfortune": {
+                    "type": "boolean"
+                },
+                "notation": {
+                    "type": "string",
+                    "maxLength": 127,
+                    "example": "2d8+4"
+                }
+            }
+        },
+        "model.RollExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dc": {
+                    "type": "integer"
+                },
+                "degree": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notation": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RollInitiative": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.RollStrikeCreate": {
+            "type": "object",
+            "properties": {
+                "attack": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "character_item_id": {
+                    "type": "integer"
+                },
+                "dc": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "fortune": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "melee"
+                },
+                "misfortune": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.Rune": {
+            "type": "object",
+            "properties": {
+                "characterItem": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterItem"
+                    }
+                },
+                "damageDice": {
+                    "type": "integer"
+                },
+                "damageType": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RuneExternal": {
+            "type": "object",
+            "properties": {
+                "damage_dice": {
+                    "type": "integer"
+                },
+                "damage_type": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.School": {
+            "type": "string",
+            "enum": [
+                "Abjuration",
+                "Conjuration",
+                "Divination",
+                "Enchantment",
+                "Evocation",
+                "Illusion",
+                "Necromancy",
+                "Transmutation"
+            ],
+            "x-enum-varnames": [
+                "Abjuration",
+                "Conjuration",
+                "Divination",
+                "Enchantment",
+                "Evocation",
+                "Illusion",
+                "Necromancy",
+                "Transmutation"
+            ]
+        },
+        "model.SellItem": {
+            "type": "object",
+            "required": [
+                "character_item_id"
+            ],
+            "properties": {
+                "character_item_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "model.ShieldExternal": {
+            "type": "object",
+            "properties": {
+                "armor_class": {
+                    "type": "integer"
+                },
+                "broken_threshold": {
+                    "type": "integer"
+                },
+                "bulk": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hardness": {
+                    "type": "integer"
+                },
+                "hit_point": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                }
+            }
+        },
         "model.SkillCreate": {
             "type": "object",
             "properties": {
@@ -6367,6 +13727,15 @@ const docTemplate = `{
                 },
                 "secondWeaponID": {
                     "type": "integer"
+                },
+                "shield": {
+                    "$ref": "#/definitions/model.CharacterItem"
+                },
+                "shieldID": {
+                    "type": "integer"
+                },
+                "shieldRaised": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6387,6 +13756,12 @@ const docTemplate = `{
                 },
                 "second_weapon_id": {
                     "type": "integer"
+                },
+                "shield_id": {
+                    "type": "integer"
+                },
+                "shield_raised": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6396,14 +13771,14 @@ const docTemplate = `{
                 "armor_id": {
                     "type": "integer"
                 },
-                "character_id": {
-                    "type": "integer"
-                },
                 "first_weapon_id": {
                     "type": "integer"
                 },
                 "second_weapon_id": {
                     "type": "integer"
+                },
+                "shield_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -6466,6 +13841,26 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SpellCharacterClass": {
+            "type": "object",
+            "properties": {
+                "characterClassID": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "spellCount": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.SpellCreate": {
             "type": "object",
             "properties": {
@@ -6569,6 +13964,57 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SpellSlotCreate": {
+            "type": "object",
+            "required": [
+                "level"
+            ],
+            "properties": {
+                "level": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "rank": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "example": 1
+                },
+                "spell_count": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "model.SpellSlotExternal": {
+            "type": "object",
+            "properties": {
+                "rank": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.SpellSlotTableExternal": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "spell_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.SpellUpdate": {
             "type": "object",
             "properties": {
@@ -6619,6 +14065,55 @@ const docTemplate = `{
                 }
             }
         },
+        "model.Spellcasting": {
+            "type": "string",
+            "enum": [
+                "Prepared",
+                "Spontaneous"
+            ],
+            "x-enum-varnames": [
+                "Prepared",
+                "Spontaneous"
+            ]
+        },
+        "model.SpellcastingExternal": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "integer"
+                },
+                "focus_point": {
+                    "type": "integer"
+                },
+                "max_focus_point": {
+                    "type": "integer"
+                },
+                "prepared": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PreparedSpellExternal"
+                    }
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SpellSlotExternal"
+                    }
+                },
+                "spellcasting": {
+                    "$ref": "#/definitions/model.Spellcasting"
+                },
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CharacterSpellExternal"
+                    }
+                },
+                "tradition_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.SquareSize": {
             "type": "string",
             "enum": [
@@ -6638,6 +14133,112 @@ const docTemplate = `{
                 "Gargantuan"
             ]
         },
+        "model.Statistic": {
+            "type": "object",
+            "properties": {
+                "mastery": {
+                    "$ref": "#/definitions/model.MasteryLevel"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Modifier"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StrikeExternal": {
+            "type": "object",
+            "properties": {
+                "attacks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Statistic"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/model.WeaponCategory"
+                },
+                "character_item_id": {
+                    "type": "integer"
+                },
+                "damage": {
+                    "type": "string"
+                },
+                "damage_modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Modifier"
+                    }
+                },
+                "extra_damage": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.StrikeRollExternal": {
+            "type": "object",
+            "properties": {
+                "attack": {
+                    "$ref": "#/definitions/model.RollExternal"
+                },
+                "damage": {
+                    "$ref": "#/definitions/model.RollExternal"
+                }
+            }
+        },
+        "model.Threat": {
+            "type": "string",
+            "enum": [
+                "trivial",
+                "low",
+                "moderate",
+                "severe",
+                "extreme"
+            ],
+            "x-enum-varnames": [
+                "ThreatTrivial",
+                "ThreatLow",
+                "ThreatModerate",
+                "ThreatSevere",
+                "ThreatExtreme"
+            ]
+        },
+        "model.TradeExternal": {
+            "type": "object",
+            "properties": {
+                "character_item": {
+                    "$ref": "#/definitions/model.CharacterItemExternal"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "purse": {
+                    "$ref": "#/definitions/model.PurseExternal"
+                }
+            }
+        },
         "model.Tradition": {
             "type": "object",
             "properties": {
@@ -6678,6 +14279,12 @@ const docTemplate = `{
         "model.Trait": {
             "type": "object",
             "properties": {
+                "armors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Armor"
+                    }
+                },
                 "description": {
                     "type": "string"
                 },
@@ -6696,22 +14303,65 @@ const docTemplate = `{
                 "spells": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Spell"
+                        "$ref": "#/definitions/model.Spell"
+                    }
+                }
+            }
+        },
+        "model.TraitExternal": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TransferRunes": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "potency": {
+                    "type": "boolean"
+                },
+                "resilient": {
+                    "type": "boolean"
+                },
+                "runes_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
                     }
+                },
+                "striking": {
+                    "type": "boolean"
+                },
+                "target_id": {
+                    "type": "integer"
                 }
             }
         },
-        "model.TraitExternal": {
+        "model.UnparseablePriceExternal": {
             "type": "object",
             "properties": {
-                "description": {
+                "error": {
                     "type": "string"
                 },
-                "id": {
+                "item_id": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
+                },
+                "price": {
+                    "type": "string"
                 }
             }
         },
@@ -6732,9 +14382,35 @@ const docTemplate = `{
                 "bulk": {
                     "type": "number"
                 },
+                "category": {
+                    "enum": [
+                        "Unarmored",
+                        "Light",
+                        "Medium",
+                        "Heavy"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ArmorCategory"
+                        }
+                    ],
+                    "example": "Medium"
+                },
+                "check_penalty": {
+                    "type": "integer",
+                    "example": 2
+                },
                 "description": {
                     "type": "string"
                 },
+                "dex_cap": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "group": {
+                    "type": "string",
+                    "example": "chain"
+                },
                 "level": {
                     "type": "integer"
                 },
@@ -6743,40 +14419,44 @@ const docTemplate = `{
                 },
                 "price": {
                     "type": "string"
-                }
-            }
-        },
-        "model.UpdateAttribute": {
-            "type": "object",
-            "properties": {
-                "charisma": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "constitution": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "dexterity": {
-                    "type": "integer",
-                    "example": 10
                 },
-                "intelligence": {
+                "speed_penalty": {
                     "type": "integer",
-                    "example": 10
+                    "example": 5
                 },
                 "strength": {
                     "type": "integer",
-                    "example": 10
+                    "example": 16
                 },
-                "wisdom": {
-                    "type": "integer",
-                    "example": 10
+                "traits_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
         "model.UpdateCharacterBoost": {
-            "type": "object"
+            "type": "object",
+            "required": [
+                "source"
+            ],
+            "properties": {
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Ability"
+                    }
+                },
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.BoostSource"
+                        }
+                    ],
+                    "example": "ancestry"
+                }
+            }
         },
         "model.UpdateCharacterItem": {
             "type": "object",
@@ -6784,15 +14464,123 @@ const docTemplate = `{
                 "quantity"
             ],
             "properties": {
+                "container_id": {
+                    "type": "integer"
+                },
                 "item_id": {
                     "type": "integer"
                 },
+                "placement": {
+                    "enum": [
+                        "Worn",
+                        "Carried",
+                        "Stowed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ItemPlacement"
+                        }
+                    ],
+                    "example": "Stowed"
+                },
                 "quantity": {
                     "type": "integer",
                     "example": 1
                 }
             }
         },
+        "model.UpdateCharacterItemRunes": {
+            "type": "object",
+            "properties": {
+                "potency": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "example": 1
+                },
+                "resilient": {
+                    "type": "integer",
+                    "maximum": 3
+                },
+                "runes_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "striking": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "example": 1
+                }
+            }
+        },
+        "model.UpdateCombatant": {
+            "type": "object",
+            "properties": {
+                "hit_point": {
+                    "type": "integer",
+                    "example": -5
+                },
+                "initiative": {
+                    "type": "integer"
+                },
+                "reaction_used": {
+                    "type": "boolean"
+                },
+                "temporary_hit_point": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.UpdateConsumable": {
+            "type": "object",
+            "properties": {
+                "bulk": {
+                    "type": "number",
+                    "example": 0.1
+                },
+                "charges": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string"
+                },
+                "kind": {
+                    "enum": [
+                        "Consumable",
+                        "Wand",
+                        "Staff"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ConsumableKind"
+                        }
+                    ],
+                    "example": "Wand"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "spell_id": {
+                    "type": "integer"
+                },
+                "spell_rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
         "model.UpdateDomain": {
             "type": "object",
             "required": [
@@ -6811,6 +14599,23 @@ const docTemplate = `{
         "model.UpdateFeat": {
             "type": "object",
             "properties": {
+                "category": {
+                    "enum": [
+                        "Ancestry",
+                        "Class",
+                        "Skill",
+                        "General",
+                        "Archetype"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.FeatCategory"
+                        }
+                    ]
+                },
+                "character_class_id": {
+                    "type": "integer"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -6823,14 +14628,60 @@ const docTemplate = `{
                 "prerequisiteMastery": {
                     "$ref": "#/definitions/model.MasteryLevel"
                 },
+                "prerequisite_feat_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "prerequisite_skill_id": {
                     "type": "integer"
+                },
+                "race_id": {
+                    "type": "integer"
                 }
             }
         },
         "model.UpdateGear": {
             "type": "object",
             "properties": {
+                "bulk": {
+                    "type": "number",
+                    "example": 1
+                },
+                "bulk_reduction": {
+                    "type": "number",
+                    "example": 2
+                },
+                "capacity": {
+                    "type": "number",
+                    "example": 4
+                },
+                "description": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateShield": {
+            "type": "object",
+            "properties": {
+                "armor_class": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "broken_threshold": {
+                    "type": "integer",
+                    "example": 10
+                },
                 "bulk": {
                     "type": "number",
                     "example": 1
@@ -6838,6 +14689,14 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "hardness": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "hit_point": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "level": {
                     "type": "integer"
                 },
@@ -6849,6 +14708,17 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateSpellSlots": {
+            "type": "object",
+            "properties": {
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SpellSlotCreate"
+                    }
+                }
+            }
+        },
         "model.UpdateTradition": {
             "type": "object",
             "properties": {
@@ -6874,9 +14744,20 @@ const docTemplate = `{
         "model.UpdateWeapon": {
             "type": "object",
             "properties": {
+                "agile": {
+                    "type": "boolean"
+                },
                 "bulk": {
                     "type": "number"
                 },
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.WeaponCategory"
+                        }
+                    ],
+                    "example": "Martial"
+                },
                 "damage": {
                     "type": "integer"
                 },
@@ -6892,6 +14773,9 @@ const docTemplate = `{
                 "diceQuantity": {
                     "type": "integer"
                 },
+                "finesse": {
+                    "type": "boolean"
+                },
                 "level": {
                     "type": "integer"
                 },
@@ -6900,6 +14784,19 @@ const docTemplate = `{
                 },
                 "price": {
                     "type": "string"
+                },
+                "propulsive": {
+                    "type": "boolean"
+                },
+                "range": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ranged": {
+                    "type": "boolean"
+                },
+                "thrown": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6987,6 +14884,12 @@ const docTemplate = `{
         "model.Weapon": {
             "type": "object",
             "properties": {
+                "agile": {
+                    "type": "boolean"
+                },
+                "category": {
+                    "$ref": "#/definitions/model.WeaponCategory"
+                },
                 "damage": {
                     "type": "integer"
                 },
@@ -6999,14 +14902,44 @@ const docTemplate = `{
                 "diceQuantity": {
                     "type": "integer"
                 },
+                "finesse": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
                 "item": {
                     "$ref": "#/definitions/model.Item"
+                },
+                "propulsive": {
+                    "type": "boolean"
+                },
+                "range": {
+                    "type": "integer"
+                },
+                "ranged": {
+                    "type": "boolean"
+                },
+                "thrown": {
+                    "type": "boolean"
                 }
             }
         },
+        "model.WeaponCategory": {
+            "type": "string",
+            "enum": [
+                "Unarmed",
+                "Simple",
+                "Martial",
+                "Advanced"
+            ],
+            "x-enum-varnames": [
+                "UnarmedWeapon",
+                "SimpleWeapon",
+                "MartialWeapon",
+                "AdvancedWeapon"
+            ]
+        },
         "model.WeaponExternal": {
             "type": "object",
             "required": [
@@ -7014,9 +14947,20 @@ const docTemplate = `{
                 "price"
             ],
             "properties": {
+                "agile": {
+                    "type": "boolean"
+                },
                 "bulk": {
                     "type": "number"
                 },
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.WeaponCategory"
+                        }
+                    ],
+                    "example": "Martial"
+                },
                 "damage": {
                     "type": "integer",
                     "example": 1
@@ -7035,6 +14979,9 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "finesse": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -7050,6 +14997,19 @@ const docTemplate = `{
                 },
                 "price": {
                     "type": "string"
+                },
+                "propulsive": {
+                    "type": "boolean"
+                },
+                "range": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ranged": {
+                    "type": "boolean"
+                },
+                "thrown": {
+                    "type": "boolean"
                 }
             }
         }
@@ -0,0 +1,51 @@
+package model
+
+type Archetype struct {
+	ID               uint                   `gorm:"primary_key;AUTO_INCREMENT"`
+	Name             string                 `gorm:"type:varchar(127);unique;not null"`
+	Description      string                 `gorm:"type:text"`
+	CharacterClassID *uint                  `gorm:"index"`
+	CharacterClass   *CharacterClass        `gorm:"foreignKey:CharacterClassID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Spellcasting     bool                   `gorm:"default:false"`
+	Feats            []Feat                 `gorm:"foreignKey:ArchetypeID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Proficiencies    []ArchetypeProficiency `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// ArchetypeProficiency is granted by the dedication, target is a defence column or a skill name
+type ArchetypeProficiency struct {
+	ID          uint         `gorm:"primary_key;AUTO_INCREMENT"`
+	ArchetypeID uint         `gorm:"index"`
+	Target      string       `gorm:"type:varchar(127);not null"`
+	Mastery     MasteryLevel `gorm:"type:mastery_level;default:Trained"`
+}
+
+type ArchetypeProficiencyCreate struct {
+	Target  string       `json:"target" binding:"required" example:"martial_weapon"`
+	Mastery MasteryLevel `json:"mastery" binding:"required,oneof=Trained Expert Master Legendary" example:"Trained"`
+}
+
+type ArchetypeCreate struct {
+	Name             string                       `json:"name" binding:"required"`
+	Description      string                       `json:"description"`
+	CharacterClassID *uint                        `json:"character_class_id"`
+	Spellcasting     bool                         `json:"spellcasting"`
+	DedicationFeatID uint                         `json:"dedication_feat_id" binding:"required"`
+	FeatIDs          []uint                       `json:"feat_ids"`
+	Proficiencies    []ArchetypeProficiencyCreate `json:"proficiencies" binding:"dive"`
+}
+
+type ArchetypeProficiencyExternal struct {
+	Target  string       `json:"target"`
+	Mastery MasteryLevel `json:"mastery"`
+}
+
+type ArchetypeExternal struct {
+	ID               uint                           `json:"id"`
+	Name             string                         `json:"name"`
+	Description      string                         `json:"description"`
+	CharacterClassID *uint                          `json:"character_class_id"`
+	Spellcasting     bool                           `json:"spellcasting"`
+	DedicationFeatID *uint                          `json:"dedication_feat_id"`
+	Feats            []*FeatExternal                `json:"feats"`
+	Proficiencies    []ArchetypeProficiencyExternal `json:"proficiencies"`
+}
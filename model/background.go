@@ -7,6 +7,7 @@ type Background struct {
 	FeatID        *uint
 	FirstSkillID  *uint
 	SecondSkillID *uint
+	LoreSkill     string      `gorm:"type:varchar(127)"`
 	Character     []Character `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 }
 
@@ -16,6 +17,7 @@ type BackgroundCreate struct {
 	FeatID        uint   `json:"feat_id" binding:"required"`
 	FirstSkillID  uint   `json:"first_skill_id" binding:"required"`
 	SecondSkillID uint   `json:"second_skill_id" binding:"required"`
+	LoreSkill     string `json:"lore_skill" example:"Sailing Lore"`
 }
 
 type BackgroundUpdate struct {
@@ -24,6 +26,7 @@ type BackgroundUpdate struct {
 	FeatID        uint   `json:"feat_id"`
 	FirstSkillID  uint   `json:"first_skill_id"`
 	SecondSkillID uint   `json:"second_skill_id"`
+	LoreSkill     string `json:"lore_skill" example:"Sailing Lore"`
 }
 
 type BackgroundExternal struct {
@@ -33,4 +36,5 @@ type BackgroundExternal struct {
 	FeatID        *uint  `json:"feat_id"`
 	FirstSkillID  *uint  `json:"first_skill_id"`
 	SecondSkillID *uint  `json:"second_skill_id"`
+	LoreSkill     string `json:"lore_skill"`
 }
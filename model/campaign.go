@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+type Campaign struct {
+	ID           uint             `gorm:"primary_key;AUTO_INCREMENT"`
+	Name         string           `gorm:"not null;type:varchar(120)"`
+	Description  string           `gorm:"type:text"`
+	GameMasterID uint             `gorm:"not null;index"`
+	Milestone    bool             `gorm:"default:false"`
+	GameMaster   User             `gorm:"foreignKey:GameMasterID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Characters   []Character      `gorm:"foreignKey:CampaignID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Invites      []CampaignInvite `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CampaignInvite struct {
+	ID         uint      `gorm:"primary_key;AUTO_INCREMENT"`
+	CampaignID uint      `gorm:"not null;uniqueIndex:idx_campaign_invite"`
+	Email      string    `gorm:"not null;type:varchar(100);uniqueIndex:idx_campaign_invite"`
+	Accepted   bool      `gorm:"default:false"`
+	CreatedAt  time.Time `gorm:"<-:create"`
+}
+
+type CreateCampaign struct {
+	Name        string `json:"name" query:"name" form:"name" binding:"required"`
+	Description string `json:"description" query:"description" form:"description"`
+	Milestone   bool   `json:"milestone" query:"milestone" form:"milestone"`
+}
+
+type CreateCampaignInvite struct {
+	Email string `json:"email" query:"email" form:"email" binding:"required,email"`
+}
+
+type JoinCampaign struct {
+	CharacterID uint `json:"character_id" query:"character_id" form:"character_id" binding:"required"`
+}
+
+type AdjustCampaignCharacter struct {
+	HitPoint   int16 `json:"hit_point" query:"hit_point" form:"hit_point" example:"-5"`
+	Experience int16 `json:"experience" query:"experience" form:"experience" example:"80"`
+}
+
+type CampaignInviteExternal struct {
+	ID           uint   `json:"id"`
+	CampaignID   uint   `json:"campaign_id"`
+	CampaignName string `json:"campaign_name"`
+	Email        string `json:"email"`
+	Accepted     bool   `json:"accepted"`
+}
+
+type CampaignCharacterExternal struct {
+	ID             uint   `json:"id"`
+	UserID         uint   `json:"user_id"`
+	Name           string `json:"name"`
+	Level          int8   `json:"level"`
+	Experience     uint16 `json:"experience"`
+	ReadyToLevelUp bool   `json:"ready_to_level_up"`
+}
+
+type CampaignExternal struct {
+	ID           uint                        `json:"id"`
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description"`
+	GameMasterID uint                        `json:"game_master_id"`
+	Milestone    bool                        `json:"milestone"`
+	Characters   []CampaignCharacterExternal `json:"characters"`
+}
@@ -1,29 +1,37 @@
 package model
 
 type Character struct {
-	ID               uint           `gorm:"primaryKey"`
-	Name             string         `gorm:"not null;type:varchar(120)"`
-	Alias            string         `gorm:"type:varchar(120)"`
-	LastName         string         `gorm:"type:varchar(120)" json:"last_name"`
-	Level            int8           `gorm:"default:1"`
-	Race             Race           `gorm:"foreignKey:RaceID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Ancestry         Ancestry       `gorm:"foreignKey:AncestryID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Background       Background     `gorm:"foreignKey:BackgroundID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CharacterClass   CharacterClass `gorm:"foreignKey:CharacterClassID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	UserID           uint
-	RaceID           uint
-	AncestryID       uint
-	BackgroundID     uint
-	CharacterClassID uint
-	Attribute        Attribute        `gorm:"foreignKey:CharacterID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CharacterSpell   []CharacterSpell `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CharacterItem    []CharacterItem  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Slot             []Slot           `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Boost            CharacterBoost   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CharacterDefence CharacterDefence `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CharacterFeat    []CharacterFeat  `gorm:"constraint:OnUpdate:CASCADE,onDelete:CASCADE;"`
-	CharacterSkill   []CharacterSkill `gorm:"constraint:OnUpdate:CASCADE,onDelete:CASCADE;"`
-	CharacterInfo    CharacterInfo    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	ID                 uint           `gorm:"primaryKey"`
+	Name               string         `gorm:"not null;type:varchar(120)"`
+	Alias              string         `gorm:"type:varchar(120)"`
+	LastName           string         `gorm:"type:varchar(120)" json:"last_name"`
+	Level              int8           `gorm:"default:1"`
+	Race               Race           `gorm:"foreignKey:RaceID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Ancestry           Ancestry       `gorm:"foreignKey:AncestryID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Background         Background     `gorm:"foreignKey:BackgroundID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterClass     CharacterClass `gorm:"foreignKey:CharacterClassID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	UserID             uint
+	RaceID             uint
+	AncestryID         uint
+	BackgroundID       uint
+	CharacterClassID   uint
+	CampaignID         *uint                    `gorm:"index"`
+	Experience         uint16                   `gorm:"default:0"`
+	LevelReady         bool                     `gorm:"default:false"`
+	FocusPoint         uint8                    `gorm:"default:0"`
+	Purse              Purse                    `gorm:"embedded"`
+	Attribute          Attribute                `gorm:"foreignKey:CharacterID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterSpell     []CharacterSpell         `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	PreparedSpell      []CharacterPreparedSpell `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	SpellSlot          []CharacterSpellSlot     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterItem      []CharacterItem          `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Slot               []Slot                   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Boost              CharacterBoost           `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterDefence   CharacterDefence         `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterFeat      []CharacterFeat          `gorm:"constraint:OnUpdate:CASCADE,onDelete:CASCADE;"`
+	CharacterSkill     []CharacterSkill         `gorm:"constraint:OnUpdate:CASCADE,onDelete:CASCADE;"`
+	CharacterCondition []CharacterCondition     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CharacterInfo      CharacterInfo            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 type CreateCharacter struct {
@@ -40,7 +48,6 @@ type CharacterUpdate struct {
 	Name     string `json:"name" query:"name" form:"name"`
 	Alias    string `json:"alias" query:"alias" form:"alias"`
 	LastName string `json:"last_name" query:"last_name" form:"last_name"`
-	Level    int8   `json:"level" query:"level" form:"level"`
 }
 
 type CharacterExternal struct {
@@ -50,6 +57,9 @@ type CharacterExternal struct {
 	Alias              string           `json:"alias" query:"alias" form:"alias"`
 	LastName           string           `json:"last_name" query:"last_name" form:"last_name"`
 	Level              int8             `json:"level" query:"level" form:"level"`
+	Experience         uint16           `json:"experience" query:"experience" form:"experience"`
+	ReadyToLevelUp     bool             `json:"ready_to_level_up"`
+	CampaignID         *uint            `json:"campaign_id" query:"campaign_id" form:"campaign_id"`
 	RaceID             uint             `json:"race_id" query:"race_id" form:"race_id"`
 	RaceName           string           `json:"race_name" query:"race_name" form:"race_name"`
 	AncestryID         uint             `json:"ancestry_id" query:"ancestry_id" form:"ancestry_id"`
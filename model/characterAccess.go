@@ -0,0 +1,11 @@
+package model
+
+// OwnedResource names a resource that belongs to a Character
+type OwnedResource string
+
+const (
+	CharacterResource      OwnedResource = "character"
+	SlotResource           OwnedResource = "slot"
+	CharacterItemResource  OwnedResource = "character_item"
+	CharacterSkillResource OwnedResource = "character_skill"
+)
@@ -1,5 +1,15 @@
 package model
 
+type BoostSource string
+
+const (
+	AncestryBoostSource   BoostSource = "ancestry"
+	BackgroundBoostSource BoostSource = "background"
+	ClassBoostSource      BoostSource = "class"
+	FreeBoostSource       BoostSource = "free"
+	LevelBoostSource      BoostSource = "level"
+)
+
 type CharacterBoost struct {
 	ID              uint  `gorm:"primary_key;AUTO_INCREMENT"`
 	AncestryBoost   uint8 `gorm:"default:2"`
@@ -9,14 +19,36 @@ type CharacterBoost struct {
 	CharacterID     uint  `gorm:"unique"`
 }
 
+type AttributeBoost struct {
+	ID          uint        `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint        `gorm:"not null;index"`
+	Source      BoostSource `gorm:"type:varchar(31);not null"`
+	Level       int8        `gorm:"default:1;not null"`
+	Ability     Ability     `gorm:"type:ability;not null"`
+}
+
 type CreateCharacterBoost struct {
 	CharacterID uint
 }
 
 type UpdateCharacterBoost struct {
+	Source    BoostSource `json:"source" query:"source" binding:"required" example:"ancestry"`
+	Abilities []Ability   `json:"abilities" query:"abilities"`
+}
+
+type AttributeBoostExternal struct {
+	Source  BoostSource `json:"source"`
+	Level   int8        `json:"level"`
+	Ability Ability     `json:"ability"`
 }
 
 type CharacterBoostExternal struct {
-	ID          uint `json:"id" query:"id" form:"id"`
-	CharacterID uint
+	ID              uint                     `json:"id" query:"id" form:"id"`
+	CharacterID     uint                     `json:"character_id"`
+	AncestryBoost   uint8                    `json:"ancestry_boost"`
+	BackgroundBoost bool                     `json:"background_boost"`
+	ClassBoost      bool                     `json:"class_boost"`
+	FreeBoost       uint8                    `json:"free_boost"`
+	Boosts          []AttributeBoostExternal `json:"boosts"`
+	Attribute       *AttributeExternal       `json:"attribute,omitempty"`
 }
@@ -4,6 +4,7 @@ type CharacterClass struct {
 	ID            uint         `gorm:"primary_key;AUTO_INCREMENT"`
 	Name          string       `gorm:"type:varchar(127);unique;not null"`
 	HitPoint      uint16       `gorm:"not null;default:6"`
+	TrainedSkills uint8        `gorm:"not null;default:2"`
 	Perception    MasteryLevel `gorm:"type:mastery_level;default:None"`
 	Fortitude     MasteryLevel `gorm:"type:mastery_level;default:None"`
 	Reflex        MasteryLevel `gorm:"type:mastery_level;default:None"`
@@ -15,57 +16,69 @@ type CharacterClass struct {
 	UnArmedWeapon MasteryLevel `gorm:"type:mastery_level;default:None"`
 	CommonWeapon  MasteryLevel `gorm:"type:mastery_level;default:None"`
 	MartialWeapon MasteryLevel `gorm:"type:mastery_level;default:None"`
+	KeyAbility    *Ability     `gorm:"type:ability"`
 	TraditionID   *uint
+	Spellcasting  *Spellcasting         `gorm:"type:spellcasting"`
+	SpellSlots    []SpellCharacterClass `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 type CharacterClassCreate struct {
-	Name          string       `json:"name" query:"name" form:"name" example:"Fighter"`
-	HitPoint      uint16       `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
-	Perception    MasteryLevel `json:"perception" query:"perception" form:"perception" example:"Train"`
-	Fortitude     MasteryLevel `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
-	Reflex        MasteryLevel `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
-	Will          MasteryLevel `json:"will" query:"will" form:"will" example:"Train"`
-	UnarmedArmor  MasteryLevel `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
-	LightArmor    MasteryLevel `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
-	MediumArmor   MasteryLevel `json:"medium_armor" query:"medium_armor" example:"Train"`
-	HeavyArmor    MasteryLevel `json:"heavy_armor" query:"heavy_armor" example:"Train"`
-	UnArmedWeapon MasteryLevel `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
-	CommonWeapon  MasteryLevel `json:"common_weapon" query:"common_weapon" example:"Train"`
-	MartialWeapon MasteryLevel `json:"martial_weapon" query:"martial_weapon" example:"Train"`
-	TraditionID   uint         `json:"tradition_id" query:"tradition_id"`
+	Name          string        `json:"name" query:"name" form:"name" example:"Fighter"`
+	HitPoint      uint16        `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
+	TrainedSkills uint8         `json:"trained_skills" query:"trained_skills" form:"trained_skills" example:"3"`
+	Perception    MasteryLevel  `json:"perception" query:"perception" form:"perception" example:"Train"`
+	Fortitude     MasteryLevel  `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
+	Reflex        MasteryLevel  `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
+	Will          MasteryLevel  `json:"will" query:"will" form:"will" example:"Train"`
+	UnarmedArmor  MasteryLevel  `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
+	LightArmor    MasteryLevel  `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
+	MediumArmor   MasteryLevel  `json:"medium_armor" query:"medium_armor" example:"Train"`
+	HeavyArmor    MasteryLevel  `json:"heavy_armor" query:"heavy_armor" example:"Train"`
+	UnArmedWeapon MasteryLevel  `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
+	CommonWeapon  MasteryLevel  `json:"common_weapon" query:"common_weapon" example:"Train"`
+	MartialWeapon MasteryLevel  `json:"martial_weapon" query:"martial_weapon" example:"Train"`
+	KeyAbility    *Ability      `json:"key_ability" query:"key_ability" example:"Strength"`
+	TraditionID   uint          `json:"tradition_id" query:"tradition_id"`
+	Spellcasting  *Spellcasting `json:"spellcasting" query:"spellcasting" example:"Prepared"`
 }
 
 type CharacterClassUpdate struct {
-	Name          string       `json:"name" query:"name" form:"name" example:"Fighter"`
-	HitPoint      uint16       `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
-	Perception    MasteryLevel `json:"perception" query:"perception" form:"perception" example:"Train"`
-	Fortitude     MasteryLevel `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
-	Reflex        MasteryLevel `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
-	Will          MasteryLevel `json:"will" query:"will" form:"will" example:"Train"`
-	UnarmedArmor  MasteryLevel `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
-	LightArmor    MasteryLevel `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
-	MediumArmor   MasteryLevel `json:"medium_armor" query:"medium_armor" example:"Train"`
-	HeavyArmor    MasteryLevel `json:"heavy_armor" query:"heavy_armor" example:"Train"`
-	UnArmedWeapon MasteryLevel `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
-	CommonWeapon  MasteryLevel `json:"common_weapon" query:"common_weapon" example:"Train"`
-	MartialWeapon MasteryLevel `json:"martial_weapon" query:"martial_weapon" example:"Train"`
-	TraditionID   uint         `json:"tradition_id" query:"tradition_id"`
+	Name          string        `json:"name" query:"name" form:"name" example:"Fighter"`
+	HitPoint      uint16        `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
+	TrainedSkills uint8         `json:"trained_skills" query:"trained_skills" form:"trained_skills" example:"3"`
+	Perception    MasteryLevel  `json:"perception" query:"perception" form:"perception" example:"Train"`
+	Fortitude     MasteryLevel  `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
+	Reflex        MasteryLevel  `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
+	Will          MasteryLevel  `json:"will" query:"will" form:"will" example:"Train"`
+	UnarmedArmor  MasteryLevel  `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
+	LightArmor    MasteryLevel  `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
+	MediumArmor   MasteryLevel  `json:"medium_armor" query:"medium_armor" example:"Train"`
+	HeavyArmor    MasteryLevel  `json:"heavy_armor" query:"heavy_armor" example:"Train"`
+	UnArmedWeapon MasteryLevel  `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
+	CommonWeapon  MasteryLevel  `json:"common_weapon" query:"common_weapon" example:"Train"`
+	MartialWeapon MasteryLevel  `json:"martial_weapon" query:"martial_weapon" example:"Train"`
+	KeyAbility    *Ability      `json:"key_ability" query:"key_ability" example:"Strength"`
+	TraditionID   uint          `json:"tradition_id" query:"tradition_id"`
+	Spellcasting  *Spellcasting `json:"spellcasting" query:"spellcasting" example:"Prepared"`
 }
 
 type CharacterClassExternal struct {
-	ID            uint         `json:"id" query:"id" form:"id"`
-	Name          string       `json:"name" query:"name" form:"name" example:"Fighter"`
-	HitPoint      uint16       `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
-	Perception    MasteryLevel `json:"perception" query:"perception" form:"perception" example:"Train"`
-	Fortitude     MasteryLevel `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
-	Reflex        MasteryLevel `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
-	Will          MasteryLevel `json:"will" query:"will" form:"will" example:"Train"`
-	UnarmedArmor  MasteryLevel `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
-	LightArmor    MasteryLevel `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
-	MediumArmor   MasteryLevel `json:"medium_armor" query:"medium_armor" example:"Train"`
-	HeavyArmor    MasteryLevel `json:"heavy_armor" query:"heavy_armor" example:"Train"`
-	UnArmedWeapon MasteryLevel `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
-	CommonWeapon  MasteryLevel `json:"common_weapon" query:"common_weapon" example:"Train"`
-	MartialWeapon MasteryLevel `json:"martial_weapon" query:"martial_weapon" example:"Train"`
-	TraditionID   *uint        `json:"tradition_id" query:"tradition_id"`
+	ID            uint          `json:"id" query:"id" form:"id"`
+	Name          string        `json:"name" query:"name" form:"name" example:"Fighter"`
+	HitPoint      uint16        `json:"health" query:"health" form:"health" example:"6" enum:"6,8,10,12"`
+	TrainedSkills uint8         `json:"trained_skills" query:"trained_skills" form:"trained_skills" example:"3"`
+	Perception    MasteryLevel  `json:"perception" query:"perception" form:"perception" example:"Train"`
+	Fortitude     MasteryLevel  `json:"fortitude" query:"fortitude" form:"fortitude" example:"Train"`
+	Reflex        MasteryLevel  `json:"reflex" query:"reflex" form:"reflex" example:"Train"`
+	Will          MasteryLevel  `json:"will" query:"will" form:"will" example:"Train"`
+	UnarmedArmor  MasteryLevel  `json:"unarmed_armor" query:"unarmed_armor" form:"unarmed_armor" example:"Train"`
+	LightArmor    MasteryLevel  `json:"light_armor" query:"light_armor" form:"light_armor" example:"Train"`
+	MediumArmor   MasteryLevel  `json:"medium_armor" query:"medium_armor" example:"Train"`
+	HeavyArmor    MasteryLevel  `json:"heavy_armor" query:"heavy_armor" example:"Train"`
+	UnArmedWeapon MasteryLevel  `json:"un_armed_weapon" query:"un_armed_weapon" example:"Train"`
+	CommonWeapon  MasteryLevel  `json:"common_weapon" query:"common_weapon" example:"Train"`
+	MartialWeapon MasteryLevel  `json:"martial_weapon" query:"martial_weapon" example:"Train"`
+	KeyAbility    *Ability      `json:"key_ability" query:"key_ability" example:"Strength"`
+	TraditionID   *uint         `json:"tradition_id" query:"tradition_id"`
+	Spellcasting  *Spellcasting `json:"spellcasting" query:"spellcasting" example:"Prepared"`
 }
@@ -1,7 +1,20 @@
 package model
 
 type CharacterCondition struct {
-	ID          uint `gorm:"primary_key"`
-	ConditionID uint
-	Count       int8
+	ID          uint      `gorm:"primary_key"`
+	CharacterID uint      `gorm:"not null;uniqueIndex:idx_character_condition"`
+	ConditionID uint      `gorm:"not null;uniqueIndex:idx_character_condition"`
+	Count       int8      `gorm:"default:1"`
+	Condition   Condition `gorm:"foreignKey:ConditionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CreateCharacterCondition struct {
+	ConditionID uint `json:"condition_id" query:"condition_id" form:"condition_id" binding:"required"`
+	Count       int8 `json:"count" query:"count" form:"count" example:"1"`
+}
+
+type CharacterConditionExternal struct {
+	ConditionID uint   `json:"condition_id"`
+	Name        string `json:"name"`
+	Count       int8   `json:"count"`
 }
@@ -11,11 +11,14 @@ type CharacterDefence struct {
 	Reflex            MasteryLevel `gorm:"type:mastery_level;default:None"`
 	Will              MasteryLevel `gorm:"type:mastery_level;default:None"`
 	Perception        MasteryLevel `gorm:"type:mastery_level;default:None"`
+	UnArmedWeapon     MasteryLevel `gorm:"type:mastery_level;default:None"`
+	CommonWeapon      MasteryLevel `gorm:"type:mastery_level;default:None"`
+	MartialWeapon     MasteryLevel `gorm:"type:mastery_level;default:None"`
 	MaxHitPoint       uint16       `gorm:"default:6"`
 	HitPoint          uint16       `gorm:"default:6"`
 	TemporaryHitPoint uint16       `gorm:"default:0"`
 	Dying             uint8        `gorm:"default:0"`
-	Wounded           bool         `gorm:"default:false"`
+	Wounded           uint8        `gorm:"default:0"`
 	Speed             uint8        `gorm:"default:0"`
 	CharacterID       uint
 }
@@ -33,7 +36,7 @@ type CharacterDefenceUpdate struct {
 	HitPoint          *uint16      `json:"hit_point"`
 	TemporaryHitPoint uint16       `json:"temporary_hit_point"`
 	Dying             uint8        `json:"dying"`
-	Wounded           bool         `json:"wounded"`
+	Wounded           uint8        `json:"wounded"`
 	Speed             uint8        `json:"speed"`
 }
 
@@ -48,11 +51,14 @@ type CharacterDefenceExternal struct {
 	Reflex            MasteryLevel `json:"reflex"`
 	Will              MasteryLevel `json:"will"`
 	Perception        MasteryLevel `json:"perception"`
+	UnArmedWeapon     MasteryLevel `json:"un_armed_weapon"`
+	CommonWeapon      MasteryLevel `json:"common_weapon"`
+	MartialWeapon     MasteryLevel `json:"martial_weapon"`
 	MaxHitPoint       uint16       `json:"max_hit_point"`
 	HitPoint          uint16       `json:"hit_point"`
 	TemporaryHitPoint uint16       `json:"temporary_hit_point"`
 	Dying             uint8        `json:"dying"`
-	Wounded           bool         `json:"wounded"`
+	Wounded           uint8        `json:"wounded"`
 	Speed             uint8        `json:"speed"`
 	CharacterID       uint         `json:"character_id"`
 }
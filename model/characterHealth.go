@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+const (
+	HealthChangeDamage   = "damage"
+	HealthChangeHeal     = "heal"
+	HealthChangeRecovery = "recovery"
+)
+
+type HealthLog struct {
+	ID                uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID       uint   `gorm:"not null;index"`
+	UserID            uint   `gorm:"not null"`
+	Kind              string `gorm:"type:varchar(31);not null"`
+	Amount            uint16 `gorm:"default:0"`
+	Critical          bool   `gorm:"default:false"`
+	Roll              *uint8
+	HitPoint          uint16 `gorm:"default:0"`
+	TemporaryHitPoint uint16 `gorm:"default:0"`
+	Dying             uint8  `gorm:"default:0"`
+	Wounded           uint8  `gorm:"default:0"`
+	Dead              bool   `gorm:"default:false"`
+	Blocked           uint16 `gorm:"default:0"`
+	ShieldBroken      bool   `gorm:"default:false"`
+	ShieldItemID      *uint
+	ShieldHitPoint    *uint16
+	CreatedAt         time.Time
+}
+
+type DamageCharacter struct {
+	Amount      uint16 `json:"amount" query:"amount" form:"amount" binding:"required" example:"12"`
+	Critical    bool   `json:"critical" query:"critical" form:"critical"`
+	ShieldBlock bool   `json:"shield_block" query:"shield_block" form:"shield_block"`
+}
+
+type HealCharacter struct {
+	Amount uint16 `json:"amount" query:"amount" form:"amount" binding:"required" example:"8"`
+}
+
+type HealthLogExternal struct {
+	ID                uint      `json:"id"`
+	CharacterID       uint      `json:"character_id"`
+	UserID            uint      `json:"user_id"`
+	Kind              string    `json:"kind"`
+	Amount            uint16    `json:"amount"`
+	Critical          bool      `json:"critical"`
+	Roll              *uint8    `json:"roll"`
+	HitPoint          uint16    `json:"hit_point"`
+	TemporaryHitPoint uint16    `json:"temporary_hit_point"`
+	Dying             uint8     `json:"dying"`
+	Wounded           uint8     `json:"wounded"`
+	Dead              bool      `json:"dead"`
+	Blocked           uint16    `json:"blocked"`
+	ShieldItemID      *uint     `json:"shield_item_id"`
+	ShieldHitPoint    *uint16   `json:"shield_hit_point"`
+	ShieldBroken      bool      `json:"shield_broken"`
+	ShieldDestroyed   bool      `json:"shield_destroyed"`
+	CreatedAt         time.Time `json:"created_at"`
+}
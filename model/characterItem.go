@@ -1,36 +1,79 @@
 package model
 
+// CharacterItem is an instance or a stack of an item, runes, charges and hit points are per row so one character
+// can own a plain and a runed copy of the same item
 type CharacterItem struct {
-	ID           uint   `gorm:"primary_key;AUTO_INCREMENT"`
-	CharacterID  uint   `gorm:"not null;uniqueIndex:idx_character_item"`
-	ItemID       uint   `gorm:"not null;uniqueIndex:idx_character_item"`
-	Quantity     uint   `gorm:"not null;default=1"`
-	Armor        []Slot `gorm:"foreignKey:ArmorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	FirstWeapon  []Slot `gorm:"foreignKey:FirstWeaponID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	SecondWeapon []Slot `gorm:"foreignKey:SecondWeaponID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	ID           uint          `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID  uint          `gorm:"not null;index:idx_character_item"`
+	ItemID       uint          `gorm:"not null;index:idx_character_item"`
+	Quantity     uint          `gorm:"not null;default=1"`
+	Placement    ItemPlacement `gorm:"type:item_placement;default:Carried"`
+	ContainerID  *uint         `gorm:"index"`
+	HitPoint     *uint16       `gorm:"default:null"`
+	Charges      *uint8        `gorm:"default:null"`
+	Potency      uint8         `gorm:"default:0"`
+	Striking     uint8         `gorm:"default:0"`
+	Resilient    uint8         `gorm:"default:0"`
+	Runes        []Rune        `gorm:"many2many:character_item_runes;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Armor        []Slot        `gorm:"foreignKey:ArmorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	FirstWeapon  []Slot        `gorm:"foreignKey:FirstWeaponID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	SecondWeapon []Slot        `gorm:"foreignKey:SecondWeaponID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Shield       []Slot        `gorm:"foreignKey:ShieldID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 
 	Character Character `gorm:"foreignKey:CharacterID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Item      Item      `gorm:"foreignKey:ItemID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
 type CreateCharacterItem struct {
-	CharacterID uint `json:"character_id" query:"character_id" binding:"required" form:"character_id"`
-	ItemID      uint `json:"item_id" query:"item_id" binding:"required" form:"item_id"`
-	Quantity    uint `json:"quantity" query:"quantity" form:"quantity" example:"1"`
+	CharacterID uint          `json:"character_id" query:"character_id" binding:"required" form:"character_id"`
+	ItemID      uint          `json:"item_id" query:"item_id" binding:"required" form:"item_id"`
+	Quantity    uint          `json:"quantity" query:"quantity" form:"quantity" example:"1"`
+	Placement   ItemPlacement `json:"placement" query:"placement" form:"placement" binding:"omitempty,oneof=Worn Carried Stowed" example:"Carried"`
+	ContainerID *uint         `json:"container_id" query:"container_id" form:"container_id"`
 }
 
 type UpdateCharacterItem struct {
-	ItemID   uint `json:"item_id" query:"item_id" form:"item_id"`
-	Quantity uint `json:"quantity" query:"quantity" binding:"required" form:"quantity" example:"1"`
+	ItemID      uint          `json:"item_id" query:"item_id" form:"item_id"`
+	Quantity    uint          `json:"quantity" query:"quantity" binding:"required" form:"quantity" example:"1"`
+	Placement   ItemPlacement `json:"placement" query:"placement" form:"placement" binding:"omitempty,oneof=Worn Carried Stowed" example:"Stowed"`
+	ContainerID *uint         `json:"container_id" query:"container_id" form:"container_id"`
 }
 
 type CharacterItemExternal struct {
-	ID            uint    `json:"id" query:"id" form:"id"`
-	CharacterID   uint    `json:"character_id" query:"character_id" form:"character_id"`
-	CharacterName string  `json:"character_name" query:"character_name" form:"character_name"`
-	Quantity      uint    `json:"quantity" query:"quantity" form:"quantity" example:"1"`
-	ItemID        uint    `json:"itemID" query:"item_id" form:"item_id"`
-	ItemName      string  `json:"item_name" query:"item_name" form:"item_name"`
-	ItemType      string  `json:"item_type" query:"item_type" form:"item_type"`
-	Bulk          float64 `json:"bulk" query:"bulk" form:"bulk"`
+	ID            uint          `json:"id" query:"id" form:"id"`
+	CharacterID   uint          `json:"character_id" query:"character_id" form:"character_id"`
+	CharacterName string        `json:"character_name" query:"character_name" form:"character_name"`
+	Quantity      uint          `json:"quantity" query:"quantity" form:"quantity" example:"1"`
+	ItemID        uint          `json:"itemID" query:"item_id" form:"item_id"`
+	ItemName      string        `json:"item_name" query:"item_name" form:"item_name"`
+	ItemType      string        `json:"item_type" query:"item_type" form:"item_type"`
+	Bulk          float64       `json:"bulk" query:"bulk" form:"bulk"`
+	Placement     ItemPlacement `json:"placement" query:"placement" form:"placement"`
+	ContainerID   *uint         `json:"container_id" query:"container_id" form:"container_id"`
+	HitPoint      *uint16       `json:"hit_point" query:"hit_point" form:"hit_point"`
+	Charges       *uint8        `json:"charges" query:"charges" form:"charges"`
+	Potency       uint8         `json:"potency" query:"potency" form:"potency"`
+	Striking      uint8         `json:"striking" query:"striking" form:"striking"`
+	Resilient     uint8         `json:"resilient" query:"resilient" form:"resilient"`
+	Runes         []string      `json:"runes" query:"runes" form:"runes"`
+}
+
+type CharacterBulkExternal struct {
+	CharacterID  uint    `json:"character_id"`
+	Bulk         int     `json:"bulk"`
+	Light        int     `json:"light"`
+	EncumberedAt int     `json:"encumbered_at"`
+	MaxBulk      int     `json:"max_bulk"`
+	Encumbered   bool    `json:"encumbered"`
+	Overloaded   bool    `json:"overloaded"`
+	Total        float64 `json:"total"`
+}
+
+// ActivationExternal is the item left after an activation and the spell it casts, CharacterItem is nil once used up
+type ActivationExternal struct {
+	CharacterItem *CharacterItemExternal `json:"character_item"`
+	Consumed      bool                   `json:"consumed"`
+	SpellID       *uint                  `json:"spell_id"`
+	SpellName     string                 `json:"spell_name"`
+	SpellRank     uint8                  `json:"spell_rank"`
 }
@@ -0,0 +1,74 @@
+package model
+
+const (
+	LevelChangeDefence   = "defence"
+	LevelChangeAttribute = "attribute"
+	LevelChangeSkill     = "skill"
+	LevelChangeFeat      = "feat"
+)
+
+type CharacterLevel struct {
+	ID          uint                   `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint                   `gorm:"not null;uniqueIndex:idx_character_level"`
+	Level       int8                   `gorm:"not null;uniqueIndex:idx_character_level"`
+	HitPoint    uint16                 `gorm:"default:0"`
+	Experience  uint16                 `gorm:"default:0"`
+	Milestone   bool                   `gorm:"default:false"`
+	Changes     []CharacterLevelChange `gorm:"foreignKey:CharacterLevelID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CharacterLevelChange struct {
+	ID               uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterLevelID uint   `gorm:"not null"`
+	Kind             string `gorm:"type:varchar(31);not null"`
+	Target           string `gorm:"type:varchar(127);not null"`
+	OldValue         string `gorm:"type:varchar(127)"`
+	NewValue         string `gorm:"type:varchar(127)"`
+	CharacterFeatID  *uint  `gorm:"default:null"`
+}
+
+type MasteryUpgrade struct {
+	Target string       `json:"target"`
+	From   MasteryLevel `json:"from"`
+	To     MasteryLevel `json:"to"`
+}
+
+type LevelUpChoices struct {
+	CharacterID     uint                             `json:"character_id"`
+	Level           int8                             `json:"level"`
+	HitPoint        uint16                           `json:"hit_point"`
+	ClassFeat       bool                             `json:"class_feat"`
+	SkillFeat       bool                             `json:"skill_feat"`
+	GeneralFeat     bool                             `json:"general_feat"`
+	AncestryFeat    bool                             `json:"ancestry_feat"`
+	SkillIncrease   bool                             `json:"skill_increase"`
+	AttributeBoosts uint8                            `json:"attribute_boosts"`
+	MasteryUpgrades []MasteryUpgrade                 `json:"mastery_upgrades"`
+	FeatOptions     map[FeatCategory][]*FeatExternal `json:"feat_options"`
+}
+
+type LevelUpCreate struct {
+	ClassFeatID     *uint     `json:"class_feat_id" query:"class_feat_id"`
+	SkillFeatID     *uint     `json:"skill_feat_id" query:"skill_feat_id"`
+	GeneralFeatID   *uint     `json:"general_feat_id" query:"general_feat_id"`
+	AncestryFeatID  *uint     `json:"ancestry_feat_id" query:"ancestry_feat_id"`
+	SkillIncrease   *string   `json:"skill_increase" query:"skill_increase" example:"Athletics"`
+	AttributeBoosts []Ability `json:"attribute_boosts" query:"attribute_boosts"`
+}
+
+type CharacterLevelChangeExternal struct {
+	Kind     string `json:"kind"`
+	Target   string `json:"target"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+type CharacterLevelExternal struct {
+	ID          uint                           `json:"id"`
+	CharacterID uint                           `json:"character_id"`
+	Level       int8                           `json:"level"`
+	HitPoint    uint16                         `json:"hit_point"`
+	Experience  uint16                         `json:"experience"`
+	Milestone   bool                           `json:"milestone"`
+	Changes     []CharacterLevelChangeExternal `json:"changes"`
+}
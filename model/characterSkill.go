@@ -5,16 +5,19 @@ type CharacterSkill struct {
 	CharacterID uint         `gorm:"not null;uniqueIndex:idx_character_skill"`
 	Name        string       `gorm:"not null;uniqueIndex:idx_character_skill"`
 	Mastery     MasteryLevel `gorm:"type:mastery_level;default:None"`
+	Lore        bool         `gorm:"default:false"`
 }
 
 type CharacterSkillCreate struct {
 	CharacterID uint         `json:"character_id" query:"character_id" binding:"required"`
-	Name        string       `json:"name" query:"name" binding:"name"`
-	Mastery     MasteryLevel `json:"mastery" query:"mastery" binding:"required" example:"None"`
+	Name        string       `json:"name" query:"name" binding:"required" example:"Sailing Lore"`
+	Mastery     MasteryLevel `json:"mastery" query:"mastery" example:"None"`
+	Override    bool         `json:"override" query:"override"`
 }
 
 type CharacterSkillUpdate struct {
-	Mastery MasteryLevel `json:"mastery" query:"mastery"`
+	Mastery  MasteryLevel `json:"mastery" query:"mastery" binding:"required" example:"Expert"`
+	Override bool         `json:"override" query:"override"`
 }
 
 type CharacterSkillExternal struct {
@@ -22,4 +25,5 @@ type CharacterSkillExternal struct {
 	CharacterID uint         `json:"character_id" query:"character_id"`
 	Name        string       `json:"name" query:"name"`
 	Mastery     MasteryLevel `json:"mastery" query:"mastery"`
+	Lore        bool         `json:"lore" query:"lore"`
 }
@@ -6,18 +6,21 @@ type Slot struct {
 	ArmorID        *uint
 	FirstWeaponID  *uint
 	SecondWeaponID *uint
+	ShieldID       *uint
+	ShieldRaised   bool `gorm:"default:false"`
 
 	Character    Character     `gorm:"foreignKey:CharacterID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Armor        CharacterItem `gorm:"foreignKey:ArmorID;references:ID;constraint:OnUpdate:CASCADE"`
 	FirstWeapon  CharacterItem `gorm:"foreignKey:FirstWeaponID;references:ID;constraint:OnUpdate:CASCADE"`
 	SecondWeapon CharacterItem `gorm:"foreignKey:SecondWeaponID;references:ID;constraint:OnUpdate:CASCADE"`
+	Shield       CharacterItem `gorm:"foreignKey:ShieldID;references:ID;constraint:OnUpdate:CASCADE"`
 }
 
 type SlotUpdate struct {
-	CharacterID    uint  `json:"character_id" query:"character_id" form:"character_id"`
 	ArmorID        *uint `json:"armor_id" query:"armor_id" form:"armor_id"`
 	FirstWeaponID  *uint `json:"first_weapon_id" query:"first_weapon_id" form:"first_weapon_id"`
 	SecondWeaponID *uint `json:"second_weapon_id" query:"second_weapon_id" form:"second_weapon_id"`
+	ShieldID       *uint `json:"shield_id" query:"shield_id" form:"shield_id"`
 }
 
 type SlotExternal struct {
@@ -26,4 +29,10 @@ type SlotExternal struct {
 	ArmorID        *uint `json:"armor_id" query:"armor_id" form:"armor_id"`
 	FirstWeaponID  *uint `json:"first_weapon_id" query:"first_weapon_id" form:"first_weapon_id"`
 	SecondWeaponID *uint `json:"second_weapon_id" query:"second_weapon_id" form:"second_weapon_id"`
+	ShieldID       *uint `json:"shield_id" query:"shield_id" form:"shield_id"`
+	ShieldRaised   bool  `json:"shield_raised" query:"shield_raised" form:"shield_raised"`
+}
+
+type RaiseShield struct {
+	Raised *bool `json:"raised" query:"raised" form:"raised" binding:"required" example:"true"`
 }
@@ -1,21 +1,83 @@
 package model
 
+// CharacterSpell is a spell in the repertoire of a spontaneous caster or the spellbook
+// of a prepared caster, rank 0 is a cantrip, focus spells are known outside of the tradition
 type CharacterSpell struct {
-	ID          uint `gorm:"primary_key;AUTO_INCREMENT"`
-	CharacterID uint `gorm:"foreignKey:CharacterID;references:ID"`
-	SpellID     uint `gorm:"foreignKey:SpellID;references:ID"`
+	ID          uint  `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint  `gorm:"not null;uniqueIndex:idx_character_spell"`
+	SpellID     uint  `gorm:"not null;uniqueIndex:idx_character_spell"`
+	Rank        uint8 `gorm:"default:0;uniqueIndex:idx_character_spell"`
+	Focus       bool  `gorm:"default:false"`
+	Spell       Spell
+}
+
+// CharacterPreparedSpell is a spell prepared for the day in a slot of the rank
+type CharacterPreparedSpell struct {
+	ID          uint  `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint  `gorm:"not null;index"`
+	SpellID     uint  `gorm:"not null"`
+	Rank        uint8 `gorm:"default:0"`
+	Expended    bool  `gorm:"default:false"`
+	Spell       Spell `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// CharacterSpellSlot counts the slots of the rank a spontaneous caster used since the last rest
+type CharacterSpellSlot struct {
+	ID          uint  `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint  `gorm:"not null;uniqueIndex:idx_character_spell_slot"`
+	Rank        uint8 `gorm:"not null;uniqueIndex:idx_character_spell_slot"`
+	Used        uint8 `gorm:"default:0"`
 }
 
 type CharacterSpellCreate struct {
-	CharacterID uint `json:"character_id" query:"character_id" form:"character_id" binding:"required"`
-	SpellID     uint `json:"spell_id" query:"spell_id" form:"spell_id" binding:"required"`
+	SpellID uint  `json:"spell_id" query:"spell_id" form:"spell_id" binding:"required"`
+	Rank    uint8 `json:"rank" query:"rank" form:"rank" example:"1"`
+	Focus   bool  `json:"focus" query:"focus" form:"focus"`
+}
+
+type PreparedSpellCreate struct {
+	SpellID uint  `json:"spell_id" query:"spell_id" binding:"required"`
+	Rank    uint8 `json:"rank" query:"rank" example:"1"`
+}
+
+type PrepareSpells struct {
+	Spells []PreparedSpellCreate `json:"spells" query:"spells" binding:"dive"`
 }
 
-type CharacterSpellUpdate struct {
-	SpellID uint `json:"spell_id" query:"spell_id" form:"spell_id"`
+type CastSpell struct {
+	SpellID uint  `json:"spell_id" query:"spell_id" binding:"required"`
+	Rank    uint8 `json:"rank" query:"rank" example:"1"`
 }
 
 type CharacterSpellExternal struct {
-	ID      uint `json:"id" query:"id" form:"id"`
-	SpellID uint `json:"spell_id" query:"spell_id" form:"spell_id"`
+	ID      uint   `json:"id" query:"id" form:"id"`
+	SpellID uint   `json:"spell_id" query:"spell_id" form:"spell_id"`
+	Name    string `json:"name"`
+	Rank    uint8  `json:"rank"`
+	Focus   bool   `json:"focus"`
+}
+
+type PreparedSpellExternal struct {
+	ID       uint   `json:"id"`
+	SpellID  uint   `json:"spell_id"`
+	Name     string `json:"name"`
+	Rank     uint8  `json:"rank"`
+	Expended bool   `json:"expended"`
+}
+
+type SpellSlotExternal struct {
+	Rank  uint8 `json:"rank"`
+	Total uint8 `json:"total"`
+	Used  uint8 `json:"used"`
+}
+
+type SpellcastingExternal struct {
+	CharacterID   uint                     `json:"character_id"`
+	Spellcasting  *Spellcasting            `json:"spellcasting"`
+	TraditionID   *uint                    `json:"tradition_id"`
+	FocusPoint    uint8                    `json:"focus_point"`
+	MaxFocusPoint uint8                    `json:"max_focus_point"`
+	Slots         []SpellSlotExternal      `json:"slots"`
+	Spells        []CharacterSpellExternal `json:"spells"`
+	Prepared      []PreparedSpellExternal  `json:"prepared"`
 }
@@ -0,0 +1,42 @@
+package model
+
+type Modifier struct {
+	Source string `json:"source"`
+	Value  int    `json:"value"`
+}
+
+type Statistic struct {
+	Name      string       `json:"name"`
+	Value     int          `json:"value"`
+	Mastery   MasteryLevel `json:"mastery,omitempty"`
+	Modifiers []Modifier   `json:"modifiers"`
+}
+
+type CharacterStatsExternal struct {
+	CharacterID uint        `json:"character_id"`
+	Level       int8        `json:"level"`
+	ArmorClass  Statistic   `json:"armor_class"`
+	Fortitude   Statistic   `json:"fortitude"`
+	Reflex      Statistic   `json:"reflex"`
+	Will        Statistic   `json:"will"`
+	Perception  Statistic   `json:"perception"`
+	ClassDC     Statistic   `json:"class_dc"`
+	Skills      []Statistic `json:"skills"`
+}
+
+type StrikeExternal struct {
+	CharacterItemID *uint          `json:"character_item_id"`
+	Name            string         `json:"name"`
+	Kind            string         `json:"kind"`
+	Category        WeaponCategory `json:"category"`
+	Traits          []string       `json:"traits"`
+	Attacks         []Statistic    `json:"attacks"`
+	Damage          string         `json:"damage"`
+	DamageModifiers []Modifier     `json:"damage_modifiers"`
+	ExtraDamage     []string       `json:"extra_damage"`
+}
+
+type CharacterStrikesExternal struct {
+	CharacterID uint             `json:"character_id"`
+	Strikes     []StrikeExternal `json:"strikes"`
+}
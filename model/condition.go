@@ -1,8 +1,45 @@
 package model
 
 type Condition struct {
-	ID                 uint   `gorm:"primary_key;AUTO_INCREMENT"`
-	Name               string `gorm:"unique; not null"`
-	Description        string `gorm:"type:text"`
+	ID                 uint               `gorm:"primary_key;AUTO_INCREMENT"`
+	Name               string             `gorm:"unique; not null"`
+	Description        string             `gorm:"type:text"`
+	Valued             bool               `gorm:"default:false"`
+	Decrement          bool               `gorm:"default:false"`
+	PenaltyType        string             `gorm:"type:varchar(31)"`
+	Abilities          []ConditionAbility `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	AllChecks          bool               `gorm:"default:false"`
+	ArmorClass         uint8              `gorm:"default:0"`
 	CharacterCondition []CharacterCondition
 }
+
+// ConditionAbility is an ability whose checks and DCs take the condition penalty,
+// stupefied hits Intelligence, Wisdom and Charisma
+type ConditionAbility struct {
+	ID          uint    `gorm:"primary_key;AUTO_INCREMENT"`
+	ConditionID uint    `gorm:"not null;uniqueIndex:idx_condition_ability"`
+	Ability     Ability `gorm:"type:ability;not null;uniqueIndex:idx_condition_ability"`
+}
+
+type CreateCondition struct {
+	Name        string    `json:"name" query:"name" form:"name" binding:"required" example:"Frightened"`
+	Description string    `json:"description" query:"description" form:"description"`
+	Valued      bool      `json:"valued" query:"valued" form:"valued"`
+	Decrement   bool      `json:"decrement" query:"decrement" form:"decrement"`
+	PenaltyType string    `json:"penalty_type" query:"penalty_type" form:"penalty_type" example:"status"`
+	Abilities   []Ability `json:"abilities" query:"abilities" form:"abilities" example:"Intelligence,Wisdom,Charisma"`
+	AllChecks   bool      `json:"all_checks" query:"all_checks" form:"all_checks"`
+	ArmorClass  uint8     `json:"armor_class" query:"armor_class" form:"armor_class"`
+}
+
+type ConditionExternal struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Valued      bool      `json:"valued"`
+	Decrement   bool      `json:"decrement"`
+	PenaltyType string    `json:"penalty_type"`
+	Abilities   []Ability `json:"abilities"`
+	AllChecks   bool      `json:"all_checks"`
+	ArmorClass  uint8     `json:"armor_class"`
+}
@@ -6,6 +6,12 @@ type SquareSize string
 type MasteryLevel string
 type Ability string
 type Rarity string
+type Spellcasting string
+type WeaponCategory string
+type FeatCategory string
+type ItemPlacement string
+type ArmorCategory string
+type ConsumableKind string
 
 const (
 	Abjuration    School = "Abjuration"
@@ -57,3 +63,42 @@ const (
 	Rare     Rarity = "Rare"
 	Mythic   Rarity = "Mythic"
 )
+
+const (
+	Prepared    Spellcasting = "Prepared"
+	Spontaneous Spellcasting = "Spontaneous"
+)
+
+const (
+	UnarmedWeapon  WeaponCategory = "Unarmed"
+	SimpleWeapon   WeaponCategory = "Simple"
+	MartialWeapon  WeaponCategory = "Martial"
+	AdvancedWeapon WeaponCategory = "Advanced"
+)
+
+const (
+	AncestryFeat  FeatCategory = "Ancestry"
+	ClassFeat     FeatCategory = "Class"
+	SkillFeat     FeatCategory = "Skill"
+	GeneralFeat   FeatCategory = "General"
+	ArchetypeFeat FeatCategory = "Archetype"
+)
+
+const (
+	WornItem    ItemPlacement = "Worn"
+	CarriedItem ItemPlacement = "Carried"
+	StowedItem  ItemPlacement = "Stowed"
+)
+
+const (
+	UnarmoredDefense ArmorCategory = "Unarmored"
+	LightArmor       ArmorCategory = "Light"
+	MediumArmor      ArmorCategory = "Medium"
+	HeavyArmor       ArmorCategory = "Heavy"
+)
+
+const (
+	ConsumableItem ConsumableKind = "Consumable"
+	WandItem       ConsumableKind = "Wand"
+	StaffItem      ConsumableKind = "Staff"
+)
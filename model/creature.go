@@ -0,0 +1,162 @@
+package model
+
+import (
+	"errors"
+	"gorm.io/gorm"
+)
+
+const (
+	Immunity   = "immunity"
+	Resistance = "resistance"
+	Weakness   = "weakness"
+)
+
+type Creature struct {
+	ID          uint       `gorm:"primary_key;AUTO_INCREMENT"`
+	Name        string     `gorm:"unique;not null"`
+	Description string     `gorm:"type:text"`
+	Level       int8       `gorm:"not null;default:0;index"`
+	Rarity      Rarity     `gorm:"type:rarity;default:Common"`
+	Size        SquareSize `gorm:"type:square_size;default:Medium"`
+	Perception  int16      `gorm:"default:0"`
+	ArmorClass  uint8      `gorm:"default:10"`
+	Fortitude   int16      `gorm:"default:0"`
+	Reflex      int16      `gorm:"default:0"`
+	Will        int16      `gorm:"default:0"`
+	HitPoint    uint16     `gorm:"default:1"`
+	Speed       uint8      `gorm:"default:25"`
+	FlySpeed    uint8      `gorm:"default:0"`
+	SwimSpeed   uint8      `gorm:"default:0"`
+	ClimbSpeed  uint8      `gorm:"default:0"`
+	BurrowSpeed uint8      `gorm:"default:0"`
+	Language    string
+	Traits      []Trait           `gorm:"many2many:creature_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Spells      []Spell           `gorm:"many2many:creature_spells;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Strikes     []CreatureStrike  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Defences    []CreatureDefence `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CreatureStrike struct {
+	ID          uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	CreatureID  uint   `gorm:"not null;index"`
+	Name        string `gorm:"not null;type:varchar(127)"`
+	Ranged      bool   `gorm:"default:false"`
+	AttackBonus int16  `gorm:"default:0"`
+	Damage      string `gorm:"type:varchar(127)"`
+	Traits      string `gorm:"type:varchar(255)"`
+}
+
+// CreatureDefence is an immunity, resistance or weakness of the creature,
+// immunities have no value
+type CreatureDefence struct {
+	ID                           uint                       `gorm:"primary_key;AUTO_INCREMENT"`
+	CreatureID                   uint                       `gorm:"not null;index"`
+	ImmunityResistanceWeaknessID uint                       `gorm:"not null"`
+	Kind                         string                     `gorm:"type:varchar(31);not null"`
+	Value                        uint16                     `gorm:"default:0"`
+	ImmunityResistanceWeakness   ImmunityResistanceWeakness `gorm:"foreignKey:ImmunityResistanceWeaknessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CreatureStrikeCreate struct {
+	Name        string `json:"name" binding:"required" example:"Dogslicer"`
+	Ranged      bool   `json:"ranged"`
+	AttackBonus int16  `json:"attack_bonus" example:"8"`
+	Damage      string `json:"damage" example:"1d6 slashing"`
+	Traits      string `json:"traits" example:"agile, backstabber, finesse"`
+}
+
+type CreatureDefenceCreate struct {
+	Name  string `json:"name" binding:"required" example:"fire"`
+	Kind  string `json:"kind" binding:"required,oneof=immunity resistance weakness" example:"weakness"`
+	Value uint16 `json:"value" example:"5"`
+}
+
+type CreatureCreate struct {
+	Name        string                  `json:"name" binding:"required" query:"name" form:"name"`
+	Description string                  `json:"description" query:"description" form:"description"`
+	Level       int8                    `json:"level" query:"level" form:"level"`
+	Rarity      Rarity                  `json:"rarity" query:"rarity" form:"rarity"`
+	Size        SquareSize              `json:"size" query:"size" form:"size"`
+	Perception  int16                   `json:"perception" query:"perception" form:"perception"`
+	ArmorClass  uint8                   `json:"armor_class" binding:"required" query:"armor_class" form:"armor_class"`
+	Fortitude   int16                   `json:"fortitude" query:"fortitude" form:"fortitude"`
+	Reflex      int16                   `json:"reflex" query:"reflex" form:"reflex"`
+	Will        int16                   `json:"will" query:"will" form:"will"`
+	HitPoint    uint16                  `json:"hit_points" binding:"required" query:"hit_points" form:"hit_points"`
+	Speed       uint8                   `json:"speed" query:"speed" form:"speed"`
+	FlySpeed    uint8                   `json:"fly_speed" query:"fly_speed" form:"fly_speed"`
+	SwimSpeed   uint8                   `json:"swim_speed" query:"swim_speed" form:"swim_speed"`
+	ClimbSpeed  uint8                   `json:"climb_speed" query:"climb_speed" form:"climb_speed"`
+	BurrowSpeed uint8                   `json:"burrow_speed" query:"burrow_speed" form:"burrow_speed"`
+	Language    string                  `json:"language" query:"language" form:"language"`
+	TraitsID    []uint                  `json:"traits_id" query:"traits_id"`
+	SpellsID    []uint                  `json:"spells_id" query:"spells_id"`
+	Strikes     []CreatureStrikeCreate  `json:"strikes"`
+	Defences    []CreatureDefenceCreate `json:"defences"`
+}
+
+type CreatureFilter struct {
+	Level    *int8  `form:"level"`
+	MinLevel *int8  `form:"min_level"`
+	MaxLevel *int8  `form:"max_level"`
+	Trait    string `form:"trait"`
+	Rarity   Rarity `form:"rarity"`
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+}
+
+type CreatureStrikeExternal struct {
+	Name        string `json:"name"`
+	Ranged      bool   `json:"ranged"`
+	AttackBonus int16  `json:"attack_bonus"`
+	Damage      string `json:"damage"`
+	Traits      string `json:"traits"`
+}
+
+type CreatureDefenceExternal struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind"`
+	Value uint16 `json:"value"`
+}
+
+type CreatureExternal struct {
+	ID          uint                      `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Level       int8                      `json:"level"`
+	Rarity      Rarity                    `json:"rarity"`
+	Size        SquareSize                `json:"size"`
+	Perception  int16                     `json:"perception"`
+	ArmorClass  uint8                     `json:"armor_class"`
+	Fortitude   int16                     `json:"fortitude"`
+	Reflex      int16                     `json:"reflex"`
+	Will        int16                     `json:"will"`
+	HitPoint    uint16                    `json:"hit_points"`
+	Speed       uint8                     `json:"speed"`
+	FlySpeed    uint8                     `json:"fly_speed"`
+	SwimSpeed   uint8                     `json:"swim_speed"`
+	ClimbSpeed  uint8                     `json:"climb_speed"`
+	BurrowSpeed uint8                     `json:"burrow_speed"`
+	Language    string                    `json:"language"`
+	Traits      []string                  `json:"traits"`
+	Spells      []string                  `json:"spells"`
+	Strikes     []CreatureStrikeExternal  `json:"strikes"`
+	Defences    []CreatureDefenceExternal `json:"defences"`
+}
+
+func (c *Creature) BeforeSave(tx *gorm.DB) (err error) {
+	if c.Size == "" {
+		c.Size = Medium
+	}
+	if c.Rarity == "" {
+		c.Rarity = Common
+	}
+	if !isValidSquareSize(c.Size) {
+		return errors.New("invalid Square Size vale")
+	}
+	switch c.Rarity {
+	case Common, Uncommon, Rare, Mythic:
+		return
+	}
+	return errors.New("invalid Rarity value")
+}
@@ -0,0 +1,111 @@
+package model
+
+import "time"
+
+type Encounter struct {
+	ID                 uint        `gorm:"primary_key;AUTO_INCREMENT"`
+	CampaignID         uint        `gorm:"not null;index"`
+	Name               string      `gorm:"not null;type:varchar(120)"`
+	Round              uint16      `gorm:"default:0"`
+	CurrentCombatantID *uint       `gorm:"default:null"`
+	Combatants         []Combatant `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Campaign           Campaign    `gorm:"foreignKey:CampaignID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CreatedAt          time.Time   `gorm:"<-:create"`
+}
+
+// Combatant is a party character or an ad-hoc creature in the encounter,
+// hit points and conditions of characters are kept on the character itself
+type Combatant struct {
+	ID                 uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	EncounterID        uint   `gorm:"not null;index"`
+	CharacterID        *uint  `gorm:"index"`
+	CreatureID         *uint  `gorm:"index"`
+	Name               string `gorm:"not null;type:varchar(120)"`
+	Initiative         *int
+	InitiativeModifier int                  `gorm:"default:0"`
+	ArmorClass         uint8                `gorm:"default:10"`
+	MaxHitPoint        uint16               `gorm:"default:0"`
+	HitPoint           uint16               `gorm:"default:0"`
+	TemporaryHitPoint  uint16               `gorm:"default:0"`
+	ReactionUsed       bool                 `gorm:"default:false"`
+	Conditions         []CombatantCondition `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	PersistentDamage   []PersistentDamage   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Character          *Character           `gorm:"foreignKey:CharacterID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Creature           *Creature            `gorm:"foreignKey:CreatureID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+}
+
+type CombatantCondition struct {
+	ID          uint      `gorm:"primary_key;AUTO_INCREMENT"`
+	CombatantID uint      `gorm:"not null;uniqueIndex:idx_combatant_condition"`
+	ConditionID uint      `gorm:"not null;uniqueIndex:idx_combatant_condition"`
+	Count       int8      `gorm:"default:1"`
+	Condition   Condition `gorm:"foreignKey:ConditionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type PersistentDamage struct {
+	ID          uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	CombatantID uint   `gorm:"not null;index"`
+	Notation    string `gorm:"not null;type:varchar(63)"`
+	DamageType  string `gorm:"type:varchar(63)"`
+}
+
+type CreateEncounter struct {
+	Name string `json:"name" query:"name" form:"name" binding:"required" example:"Goblin ambush"`
+}
+
+type CreateCombatant struct {
+	CharacterID        *uint  `json:"character_id" query:"character_id" form:"character_id"`
+	CreatureID         *uint  `json:"creature_id" query:"creature_id" form:"creature_id"`
+	Name               string `json:"name" query:"name" form:"name" example:"Goblin Warrior"`
+	InitiativeModifier int    `json:"initiative_modifier" query:"initiative_modifier" form:"initiative_modifier" example:"2"`
+	ArmorClass         uint8  `json:"armor_class" query:"armor_class" form:"armor_class" example:"16"`
+	HitPoint           uint16 `json:"hit_point" query:"hit_point" form:"hit_point" example:"6"`
+}
+
+type UpdateCombatant struct {
+	HitPoint          int16   `json:"hit_point" query:"hit_point" form:"hit_point" example:"-5"`
+	TemporaryHitPoint *uint16 `json:"temporary_hit_point" query:"temporary_hit_point" form:"temporary_hit_point"`
+	ReactionUsed      *bool   `json:"reaction_used" query:"reaction_used" form:"reaction_used"`
+	Initiative        *int    `json:"initiative" query:"initiative" form:"initiative"`
+}
+
+type RollInitiative struct {
+	Skills map[uint]string `json:"skills" query:"skills"`
+}
+
+type CreatePersistentDamage struct {
+	Notation   string `json:"notation" query:"notation" form:"notation" binding:"required,max=63" example:"1d6"`
+	DamageType string `json:"damage_type" query:"damage_type" form:"damage_type" example:"fire"`
+}
+
+type PersistentDamageExternal struct {
+	ID         uint   `json:"id"`
+	Notation   string `json:"notation"`
+	DamageType string `json:"damage_type"`
+}
+
+type CombatantExternal struct {
+	ID                uint                         `json:"id"`
+	CharacterID       *uint                        `json:"character_id"`
+	CreatureID        *uint                        `json:"creature_id"`
+	Name              string                       `json:"name"`
+	Initiative        *int                         `json:"initiative"`
+	ArmorClass        int                          `json:"armor_class"`
+	MaxHitPoint       uint16                       `json:"max_hit_point"`
+	HitPoint          uint16                       `json:"hit_point"`
+	TemporaryHitPoint uint16                       `json:"temporary_hit_point"`
+	ReactionUsed      bool                         `json:"reaction_used"`
+	Current           bool                         `json:"current"`
+	Conditions        []CharacterConditionExternal `json:"conditions"`
+	PersistentDamage  []PersistentDamageExternal   `json:"persistent_damage"`
+}
+
+type EncounterExternal struct {
+	ID                 uint                `json:"id"`
+	CampaignID         uint                `json:"campaign_id"`
+	Name               string              `json:"name"`
+	Round              uint16              `json:"round"`
+	CurrentCombatantID *uint               `json:"current_combatant_id"`
+	Combatants         []CombatantExternal `json:"combatants"`
+	Log                []string            `json:"log,omitempty"`
+}
@@ -0,0 +1,47 @@
+package model
+
+type Threat string
+
+const (
+	ThreatTrivial  Threat = "trivial"
+	ThreatLow      Threat = "low"
+	ThreatModerate Threat = "moderate"
+	ThreatSevere   Threat = "severe"
+	ThreatExtreme  Threat = "extreme"
+)
+
+type EncounterBudgetQuery struct {
+	CampaignID   *uint  `form:"campaign_id"`
+	CharacterIDs []uint `form:"character_ids"`
+	Threat       Threat `form:"threat" binding:"required,oneof=trivial low moderate severe extreme"`
+	CreatureIDs  []uint `form:"creature_ids"`
+	Suggestions  int    `form:"suggestions"`
+	Trait        string `form:"trait"`
+}
+
+type CreatureXPExternal struct {
+	CreatureID uint   `json:"creature_id"`
+	Name       string `json:"name"`
+	Level      int8   `json:"level"`
+	Count      int    `json:"count"`
+	XP         int    `json:"xp"`
+}
+
+type EncounterSuggestionExternal struct {
+	Creatures []CreatureXPExternal `json:"creatures"`
+	XP        int                  `json:"xp"`
+	Threat    Threat               `json:"threat"`
+}
+
+type EncounterBudgetExternal struct {
+	PartyLevel  int8                          `json:"party_level"`
+	PartySize   int                           `json:"party_size"`
+	Threat      Threat                        `json:"threat"`
+	Budget      int                           `json:"budget"`
+	Creatures   []CreatureXPExternal          `json:"creatures"`
+	XP          int                           `json:"xp"`
+	Actual      Threat                        `json:"actual_threat,omitempty"`
+	Valid       bool                          `json:"valid"`
+	Errors      []string                      `json:"errors"`
+	Suggestions []EncounterSuggestionExternal `json:"suggestions"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+const (
+	ExperienceEncounter      = "encounter"
+	ExperienceAccomplishment = "accomplishment"
+	ExperienceHazard         = "hazard"
+	ExperienceAdjustment     = "adjustment"
+	ExperienceMilestone      = "milestone"
+)
+
+type ExperienceLog struct {
+	ID          uint      `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint      `gorm:"not null;index"`
+	CampaignID  *uint     `gorm:"index"`
+	AwardedByID uint      `gorm:"not null"`
+	Kind        string    `gorm:"type:varchar(31);not null"`
+	Amount      int16     `gorm:"default:0"`
+	Reason      string    `gorm:"type:varchar(255)"`
+	Experience  uint16    `gorm:"default:0"`
+	CreatedAt   time.Time `gorm:"<-:create"`
+}
+
+type AwardExperience struct {
+	Kind         string `json:"kind" binding:"required,oneof=encounter accomplishment hazard" example:"encounter"`
+	Amount       uint16 `json:"amount" binding:"required,max=32767" example:"80"`
+	Reason       string `json:"reason" example:"Defeated the goblin ambush"`
+	CharacterIDs []uint `json:"character_ids"`
+}
+
+type AwardMilestone struct {
+	Reason       string `json:"reason" example:"Cleared the first floor of the vaults"`
+	CharacterIDs []uint `json:"character_ids"`
+}
+
+type ExperienceLogExternal struct {
+	ID          uint      `json:"id"`
+	CharacterID uint      `json:"character_id"`
+	CampaignID  *uint     `json:"campaign_id"`
+	AwardedByID uint      `json:"awarded_by_id"`
+	Kind        string    `json:"kind"`
+	Amount      int16     `json:"amount"`
+	Reason      string    `json:"reason"`
+	Experience  uint16    `json:"experience"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CharacterExperienceExternal struct {
+	CharacterID    uint                    `json:"character_id"`
+	Level          int8                    `json:"level"`
+	Experience     uint16                  `json:"experience"`
+	ReadyToLevelUp bool                    `json:"ready_to_level_up"`
+	Log            []ExperienceLogExternal `json:"log"`
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"errors"
+	"gorm.io/gorm"
+)
+
 type Feat struct {
 	ID                  uint   `gorm:"primary_key;AUTO_INCREMENT"`
 	Name                string `gorm:"unique;not null"`
@@ -8,7 +13,15 @@ type Feat struct {
 	PrerequisiteSkillID *uint
 	PrerequisiteMastery MasteryLevel `gorm:"type:mastery_level;default:None"`
 	PrerequisiteFeat    *string
+	PrerequisiteFeats   []Feat          `gorm:"many2many:feat_prerequisites;joinForeignKey:FeatID;joinReferences:PrerequisiteFeatID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Rarity              Rarity          `gorm:"type:rarity;default:Common"`
+	Category            FeatCategory    `gorm:"type:feat_category;default:General;index"`
+	CharacterClassID    *uint           `gorm:"index"`
+	CharacterClass      *CharacterClass `gorm:"foreignKey:CharacterClassID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	RaceID              *uint           `gorm:"index"`
+	Race                *Race           `gorm:"foreignKey:RaceID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	ArchetypeID         *uint           `gorm:"index"`
+	Dedication          bool            `gorm:"default:false"`
 	Traits              []Trait         `gorm:"many2many:feat_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Background          []Background    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	CharacterFeat       []CharacterFeat `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
@@ -20,6 +33,10 @@ type CreateFeat struct {
 	Level               uint8        `json:"level" query:"level"`
 	PrerequisiteSkillID *uint        `json:"prerequisite_skill_id" query:"prerequisite_skill_id"`
 	PrerequisiteMastery MasteryLevel `gorm:"type:mastery_level"`
+	PrerequisiteFeatIDs []uint       `json:"prerequisite_feat_ids" query:"prerequisite_feat_ids"`
+	Category            FeatCategory `json:"category" query:"category" binding:"omitempty,oneof=Ancestry Class Skill General Archetype"`
+	CharacterClassID    *uint        `json:"character_class_id" query:"character_class_id"`
+	RaceID              *uint        `json:"race_id" query:"race_id"`
 }
 
 type UpdateFeat struct {
@@ -28,6 +45,21 @@ type UpdateFeat struct {
 	Level               uint8        `json:"level" query:"level"`
 	PrerequisiteSkillID *uint        `json:"prerequisite_skill_id" query:"prerequisite_skill_id"`
 	PrerequisiteMastery MasteryLevel `gorm:"type:mastery_level"`
+	PrerequisiteFeatIDs []uint       `json:"prerequisite_feat_ids" query:"prerequisite_feat_ids"`
+	Category            FeatCategory `json:"category" query:"category" binding:"omitempty,oneof=Ancestry Class Skill General Archetype"`
+	CharacterClassID    *uint        `json:"character_class_id" query:"character_class_id"`
+	RaceID              *uint        `json:"race_id" query:"race_id"`
+}
+
+type FeatFilter struct {
+	Category    FeatCategory `form:"category"`
+	ClassID     *uint        `form:"class_id"`
+	AncestryID  *uint        `form:"ancestry_id"`
+	ArchetypeID *uint        `form:"archetype_id"`
+	MaxLevel    *uint8       `form:"max_level"`
+	Trait       string       `form:"trait"`
+	Limit       int          `form:"limit"`
+	Offset      int          `form:"offset"`
 }
 
 type FeatExternal struct {
@@ -39,4 +71,27 @@ type FeatExternal struct {
 	PrerequisiteMastery MasteryLevel `gorm:"type:mastery_level"`
 	Traits              []Trait      `json:"traits" query:"traits"`
 	PrerequisiteFeat    *string      `json:"prerequisite_feat" query:"prerequisite_feat"`
+	PrerequisiteFeatIDs []uint       `json:"prerequisite_feat_ids" query:"prerequisite_feat_ids"`
+	Category            FeatCategory `json:"category" query:"category"`
+	CharacterClassID    *uint        `json:"character_class_id" query:"character_class_id"`
+	RaceID              *uint        `json:"race_id" query:"race_id"`
+	ArchetypeID         *uint        `json:"archetype_id" query:"archetype_id"`
+	Dedication          bool         `json:"dedication" query:"dedication"`
+}
+
+type EligibleFeatExternal struct {
+	Feats     []*FeatExternal      `json:"feats"`
+	FreeSlots map[FeatCategory]int `json:"free_slots"`
+}
+
+// BeforeSave defaults the category to General and validates it
+func (f *Feat) BeforeSave(tx *gorm.DB) (err error) {
+	if f.Category == "" {
+		f.Category = GeneralFeat
+	}
+	switch f.Category {
+	case AncestryFeat, ClassFeat, SkillFeat, GeneralFeat, ArchetypeFeat:
+		return
+	}
+	return errors.New("invalid Feat Category value")
 }
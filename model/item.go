@@ -5,37 +5,58 @@ type Item struct {
 	Name          string          `gorm:"unique;type:varchar(127)"`
 	Description   string          `gorm:"type:text"`
 	Bulk          float64         `gorm:"type:decimal(10,3);default:0.001"`
+	Capacity      float64         `gorm:"type:decimal(10,3);default:0"`
+	BulkReduction float64         `gorm:"type:decimal(10,3);default:0"`
 	Level         uint8           `gorm:"default:1;not null"`
 	Price         string          `gorm:"type:varchar(127)"`
+	PriceCopper   *uint           `gorm:"default:null"`
 	OwnerID       uint            `gorm:"uniqueIndex:idx_owner_id_owner_type"`
 	OwnerType     string          `gorm:"uniqueIndex:idx_owner_id_owner_type"`
 	CharacterItem []CharacterItem `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 type ItemExternal struct {
-	ID          uint    `json:"id" query:"id" form:"id"`
-	Name        string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description string  `json:"description" query:"description" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level       uint8   `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" binding:"required" form:"price"`
-	OwnerID     uint    `json:"owner_id" query:"owner_id" form:"owner_id"`
-	OwnerType   string  `json:"owner_type" query:"owner_type" form:"owner_type"`
+	ID            uint    `json:"id" query:"id" form:"id"`
+	Name          string  `json:"name" query:"name" binding:"required" form:"name"`
+	Description   string  `json:"description" query:"description" form:"description"`
+	Bulk          float64 `json:"bulk" query:"bulk" form:"bulk"`
+	Capacity      float64 `json:"capacity" query:"capacity" form:"capacity"`
+	BulkReduction float64 `json:"bulk_reduction" query:"bulk_reduction" form:"bulk_reduction"`
+	Level         uint8   `json:"level" query:"level" form:"level"`
+	Price         string  `json:"price" query:"price" binding:"required" form:"price"`
+	PriceCopper   *uint   `json:"price_copper" query:"price_copper" form:"price_copper"`
+	OwnerID       uint    `json:"owner_id" query:"owner_id" form:"owner_id"`
+	OwnerType     string  `json:"owner_type" query:"owner_type" form:"owner_type"`
 }
 
+// Armor penalties are stored as positive values, Strength is the score that reduces them
 type Armor struct {
-	ID         uint `gorm:"primary_key;AUTO_INCREMENT"`
-	ArmorClass uint8
-	Item       Item `gorm:"polymorphic:Owner;"`
+	ID           uint `gorm:"primary_key;AUTO_INCREMENT"`
+	ArmorClass   uint8
+	Category     ArmorCategory `gorm:"type:armor_category;default:Light"`
+	DexCap       *uint8
+	CheckPenalty uint8   `gorm:"default:0"`
+	SpeedPenalty uint8   `gorm:"default:0"`
+	Strength     uint8   `gorm:"default:0"`
+	ArmorGroup   string  `gorm:"type:varchar(63)"`
+	Traits       []Trait `gorm:"many2many:armor_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Item         Item    `gorm:"polymorphic:Owner;"`
 }
 
 type Weapon struct {
-	ID           uint   `gorm:"primary_key;AUTO_INCREMENT"`
-	DiceQuantity uint8  `gorm:"default:1;not null"`
-	Dice         uint8  `gorm:"default:4;not null"`
-	Damage       uint8  `gorm:"default:1;not null"`
-	Item         Item   `gorm:"polymorphic:Owner;"`
-	DamageType   string `gorm:"type:varchar(127);not null"`
+	ID           uint           `gorm:"primary_key;AUTO_INCREMENT"`
+	DiceQuantity uint8          `gorm:"default:1;not null"`
+	Dice         uint8          `gorm:"default:4;not null"`
+	Damage       uint8          `gorm:"default:1;not null"`
+	Item         Item           `gorm:"polymorphic:Owner;"`
+	DamageType   string         `gorm:"type:varchar(127);not null"`
+	Category     WeaponCategory `gorm:"type:weapon_category;default:Simple"`
+	Ranged       bool           `gorm:"default:false"`
+	Range        uint16         `gorm:"default:0"`
+	Agile        bool           `gorm:"default:false"`
+	Finesse      bool           `gorm:"default:false"`
+	Propulsive   bool           `gorm:"default:false"`
+	Thrown       bool           `gorm:"default:false"`
 }
 
 type Gear struct {
@@ -43,95 +64,246 @@ type Gear struct {
 	Item Item `gorm:"polymorphic:Owner;"`
 }
 
+// Shield hit points are the undamaged maximum, the current ones are tracked on the CharacterItem
+type Shield struct {
+	ID              uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	ArmorClass      uint8  `gorm:"default:2"`
+	Hardness        uint8  `gorm:"default:0"`
+	HitPoint        uint16 `gorm:"default:0"`
+	BrokenThreshold uint16 `gorm:"default:0"`
+	Item            Item   `gorm:"polymorphic:Owner;"`
+}
+
+// Consumable Uses are spent per unit before the Quantity drops, Wand and Staff Charges come back every day,
+// the current ones are tracked on the CharacterItem
+type Consumable struct {
+	ID        uint           `gorm:"primary_key;AUTO_INCREMENT"`
+	Kind      ConsumableKind `gorm:"type:consumable_kind;default:Consumable"`
+	Uses      uint8          `gorm:"default:1"`
+	Charges   uint8          `gorm:"default:0"`
+	SpellID   *uint          `gorm:"default:null"`
+	Spell     *Spell         `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	SpellRank uint8          `gorm:"default:0"`
+	Item      Item           `gorm:"polymorphic:Owner;"`
+}
+
 type CreateArmor struct {
-	Name        string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description string  `json:"description" query:"description" binding:"required" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level       *uint8  `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" binding:"required" form:"price"`
-	ArmorClass  *uint8  `json:"armor_class" query:"armor_class" form:"armor_class"`
+	Name         string        `json:"name" query:"name" binding:"required" form:"name"`
+	Description  string        `json:"description" query:"description" binding:"required" form:"description"`
+	Bulk         float64       `json:"bulk" query:"bulk" form:"bulk"`
+	Level        *uint8        `json:"level" query:"level" form:"level"`
+	Price        string        `json:"price" query:"price" binding:"required" form:"price"`
+	ArmorClass   *uint8        `json:"armor_class" query:"armor_class" form:"armor_class"`
+	Category     ArmorCategory `json:"category" query:"category" form:"category" binding:"omitempty,oneof=Unarmored Light Medium Heavy" example:"Medium"`
+	DexCap       *uint8        `json:"dex_cap" query:"dex_cap" form:"dex_cap" example:"1"`
+	CheckPenalty uint8         `json:"check_penalty" query:"check_penalty" form:"check_penalty" example:"2"`
+	SpeedPenalty uint8         `json:"speed_penalty" query:"speed_penalty" form:"speed_penalty" example:"5"`
+	Strength     uint8         `json:"strength" query:"strength" form:"strength" example:"16"`
+	ArmorGroup   string        `json:"group" query:"group" form:"group" example:"chain"`
+	TraitsID     []uint        `json:"traits_id" query:"traits_id" form:"traits_id"`
 }
 
 type UpdateArmor struct {
-	Name        string  `json:"name" query:"name" form:"name"`
-	Description string  `json:"description" query:"description" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level       *uint8  `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" form:"price"`
-	ArmorClass  *uint8  `json:"armor_class" query:"armor_class" form:"armor_class"`
+	Name         string        `json:"name" query:"name" form:"name"`
+	Description  string        `json:"description" query:"description" form:"description"`
+	Bulk         float64       `json:"bulk" query:"bulk" form:"bulk"`
+	Level        *uint8        `json:"level" query:"level" form:"level"`
+	Price        string        `json:"price" query:"price" form:"price"`
+	ArmorClass   *uint8        `json:"armor_class" query:"armor_class" form:"armor_class"`
+	Category     ArmorCategory `json:"category" query:"category" form:"category" binding:"omitempty,oneof=Unarmored Light Medium Heavy" example:"Medium"`
+	DexCap       *uint8        `json:"dex_cap" query:"dex_cap" form:"dex_cap" example:"1"`
+	CheckPenalty uint8         `json:"check_penalty" query:"check_penalty" form:"check_penalty" example:"2"`
+	SpeedPenalty uint8         `json:"speed_penalty" query:"speed_penalty" form:"speed_penalty" example:"5"`
+	Strength     uint8         `json:"strength" query:"strength" form:"strength" example:"16"`
+	ArmorGroup   string        `json:"group" query:"group" form:"group" example:"chain"`
+	TraitsID     []uint        `json:"traits_id" query:"traits_id" form:"traits_id"`
 }
 
 type ArmorExternal struct {
-	ID          uint    `json:"id" query:"id" form:"id"`
-	Name        string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description string  `json:"description" query:"description" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level       uint8   `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" binding:"required" form:"price"`
-	ArmorClass  uint8   `json:"armor_class" query:"armor_class" form:"armor_class"`
-	ItemID      uint    `json:"item_id" query:"item_id" form:"item_id"`
+	ID           uint          `json:"id" query:"id" form:"id"`
+	Name         string        `json:"name" query:"name" binding:"required" form:"name"`
+	Description  string        `json:"description" query:"description" form:"description"`
+	Bulk         float64       `json:"bulk" query:"bulk" form:"bulk"`
+	Level        uint8         `json:"level" query:"level" form:"level"`
+	Price        string        `json:"price" query:"price" binding:"required" form:"price"`
+	ArmorClass   uint8         `json:"armor_class" query:"armor_class" form:"armor_class"`
+	ItemID       uint          `json:"item_id" query:"item_id" form:"item_id"`
+	Category     ArmorCategory `json:"category" query:"category" form:"category" example:"Medium"`
+	DexCap       *uint8        `json:"dex_cap" query:"dex_cap" form:"dex_cap"`
+	CheckPenalty uint8         `json:"check_penalty" query:"check_penalty" form:"check_penalty"`
+	SpeedPenalty uint8         `json:"speed_penalty" query:"speed_penalty" form:"speed_penalty"`
+	Strength     uint8         `json:"strength" query:"strength" form:"strength"`
+	ArmorGroup   string        `json:"group" query:"group" form:"group"`
+	Traits       []string      `json:"traits" query:"traits"`
 }
 
 type CreateWeapon struct {
-	Name         string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description  string  `json:"description" query:"description" binding:"required" form:"description"`
-	Bulk         float64 `json:"bulk" query:"bulk" binding:"required" form:"bulk" example:"0.1"`
-	Level        *uint8  `json:"level" query:"level" form:"level"`
-	Price        string  `json:"price" query:"price" binding:"required" form:"price"`
-	DiceQuantity uint8   `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity" binding:"required" example:"1"`
-	Dice         uint8   `json:"dice" query:"dice" form:"dice" binding:"required" example:"4"`
-	Damage       *uint8  `json:"damage" query:"damage" form:"damage"`
-	DamageType   string  `json:"damage_type" query:"damage_type" form:"damage_type" binding:"required"`
+	Name         string         `json:"name" query:"name" binding:"required" form:"name"`
+	Description  string         `json:"description" query:"description" binding:"required" form:"description"`
+	Bulk         float64        `json:"bulk" query:"bulk" binding:"required" form:"bulk" example:"0.1"`
+	Level        *uint8         `json:"level" query:"level" form:"level"`
+	Price        string         `json:"price" query:"price" binding:"required" form:"price"`
+	DiceQuantity uint8          `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity" binding:"required" example:"1"`
+	Dice         uint8          `json:"dice" query:"dice" form:"dice" binding:"required" example:"4"`
+	Damage       *uint8         `json:"damage" query:"damage" form:"damage"`
+	DamageType   string         `json:"damage_type" query:"damage_type" form:"damage_type" binding:"required"`
+	Category     WeaponCategory `json:"category" query:"category" form:"category" example:"Martial"`
+	Ranged       bool           `json:"ranged" query:"ranged" form:"ranged"`
+	Range        uint16         `json:"range" query:"range" form:"range" example:"0"`
+	Agile        bool           `json:"agile" query:"agile" form:"agile"`
+	Finesse      bool           `json:"finesse" query:"finesse" form:"finesse"`
+	Propulsive   bool           `json:"propulsive" query:"propulsive" form:"propulsive"`
+	Thrown       bool           `json:"thrown" query:"thrown" form:"thrown"`
 }
 
 type UpdateWeapon struct {
-	Name         string  `json:"name" query:"name" form:"name"`
-	Description  string  `json:"description" query:"description" form:"description"`
-	Bulk         float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level        *uint8  `json:"level" query:"level" form:"level"`
-	Price        string  `json:"price" query:"price" form:"price"`
-	DiceQuantity uint8   `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity"`
-	Dice         uint8   `json:"dice" query:"dice" form:"dice"`
-	Damage       *uint8  `json:"damage" query:"damage" form:"damage"`
-	DamageType   string  `json:"damage_type" query:"damage_type" form:"damage_type"`
+	Name         string         `json:"name" query:"name" form:"name"`
+	Description  string         `json:"description" query:"description" form:"description"`
+	Bulk         float64        `json:"bulk" query:"bulk" form:"bulk"`
+	Level        *uint8         `json:"level" query:"level" form:"level"`
+	Price        string         `json:"price" query:"price" form:"price"`
+	DiceQuantity uint8          `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity"`
+	Dice         uint8          `json:"dice" query:"dice" form:"dice"`
+	Damage       *uint8         `json:"damage" query:"damage" form:"damage"`
+	DamageType   string         `json:"damage_type" query:"damage_type" form:"damage_type"`
+	Category     WeaponCategory `json:"category" query:"category" form:"category" example:"Martial"`
+	Ranged       bool           `json:"ranged" query:"ranged" form:"ranged"`
+	Range        uint16         `json:"range" query:"range" form:"range" example:"0"`
+	Agile        bool           `json:"agile" query:"agile" form:"agile"`
+	Finesse      bool           `json:"finesse" query:"finesse" form:"finesse"`
+	Propulsive   bool           `json:"propulsive" query:"propulsive" form:"propulsive"`
+	Thrown       bool           `json:"thrown" query:"thrown" form:"thrown"`
 }
 
 type WeaponExternal struct {
-	ID           uint    `json:"id" query:"id" form:"id"`
-	Name         string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description  string  `json:"description" query:"description" form:"description"`
-	Bulk         float64 `json:"bulk" query:"bulk" form:"bulk"`
-	Level        uint8   `json:"level" query:"level" form:"level" example:"1"`
-	Price        string  `json:"price" query:"price" binding:"required" form:"price"`
-	DiceQuantity uint8   `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity" example:"1"`
-	Dice         uint8   `json:"dice" query:"dice" form:"dice" example:"1"`
-	Damage       uint8   `json:"damage" query:"damage" form:"damage" example:"1"`
-	DamageType   string  `json:"damage_type" query:"damage_type" form:"damage_type"`
-	ItemID       uint    `json:"item_id" query:"item_id" form:"item_id"`
+	ID           uint           `json:"id" query:"id" form:"id"`
+	Name         string         `json:"name" query:"name" binding:"required" form:"name"`
+	Description  string         `json:"description" query:"description" form:"description"`
+	Bulk         float64        `json:"bulk" query:"bulk" form:"bulk"`
+	Level        uint8          `json:"level" query:"level" form:"level" example:"1"`
+	Price        string         `json:"price" query:"price" binding:"required" form:"price"`
+	DiceQuantity uint8          `json:"diceQuantity" query:"dice_quantity" form:"dice_quantity" example:"1"`
+	Dice         uint8          `json:"dice" query:"dice" form:"dice" example:"1"`
+	Damage       uint8          `json:"damage" query:"damage" form:"damage" example:"1"`
+	DamageType   string         `json:"damage_type" query:"damage_type" form:"damage_type"`
+	ItemID       uint           `json:"item_id" query:"item_id" form:"item_id"`
+	Category     WeaponCategory `json:"category" query:"category" form:"category" example:"Martial"`
+	Ranged       bool           `json:"ranged" query:"ranged" form:"ranged"`
+	Range        uint16         `json:"range" query:"range" form:"range" example:"0"`
+	Agile        bool           `json:"agile" query:"agile" form:"agile"`
+	Finesse      bool           `json:"finesse" query:"finesse" form:"finesse"`
+	Propulsive   bool           `json:"propulsive" query:"propulsive" form:"propulsive"`
+	Thrown       bool           `json:"thrown" query:"thrown" form:"thrown"`
 }
 
 type CreateGear struct {
-	Name        string  `json:"name" query:"name" binding:"required" form:"name"`
-	Description string  `json:"description" query:"description" binding:"required" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" binding:"required" form:"bulk" example:"1"`
-	Level       *uint8  `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" binding:"required" form:"price"`
+	Name          string  `json:"name" query:"name" binding:"required" form:"name"`
+	Description   string  `json:"description" query:"description" binding:"required" form:"description"`
+	Bulk          float64 `json:"bulk" query:"bulk" binding:"required" form:"bulk" example:"1"`
+	Capacity      float64 `json:"capacity" query:"capacity" form:"capacity" example:"4"`
+	BulkReduction float64 `json:"bulk_reduction" query:"bulk_reduction" form:"bulk_reduction" example:"2"`
+	Level         *uint8  `json:"level" query:"level" form:"level"`
+	Price         string  `json:"price" query:"price" binding:"required" form:"price"`
 }
 
 type UpdateGear struct {
-	Name        string  `json:"name" query:"name" form:"name"`
-	Description string  `json:"description" query:"description" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
-	Level       *uint8  `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" form:"price"`
+	Name          string  `json:"name" query:"name" form:"name"`
+	Description   string  `json:"description" query:"description" form:"description"`
+	Bulk          float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
+	Capacity      float64 `json:"capacity" query:"capacity" form:"capacity" example:"4"`
+	BulkReduction float64 `json:"bulk_reduction" query:"bulk_reduction" form:"bulk_reduction" example:"2"`
+	Level         *uint8  `json:"level" query:"level" form:"level"`
+	Price         string  `json:"price" query:"price" form:"price"`
 }
 
 type GearExternal struct {
-	ID          uint    `json:"id" query:"id" form:"id"`
-	Name        string  `json:"name" query:"name" form:"name"`
-	Description string  `json:"description" query:"description" form:"description"`
-	Bulk        float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
-	Level       uint8   `json:"level" query:"level" form:"level"`
-	Price       string  `json:"price" query:"price" form:"price"`
-	ItemID      uint    `json:"item_id" query:"item_id" form:"item_id"`
+	ID            uint    `json:"id" query:"id" form:"id"`
+	Name          string  `json:"name" query:"name" form:"name"`
+	Description   string  `json:"description" query:"description" form:"description"`
+	Bulk          float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
+	Capacity      float64 `json:"capacity" query:"capacity" form:"capacity" example:"4"`
+	BulkReduction float64 `json:"bulk_reduction" query:"bulk_reduction" form:"bulk_reduction" example:"2"`
+	Level         uint8   `json:"level" query:"level" form:"level"`
+	Price         string  `json:"price" query:"price" form:"price"`
+	ItemID        uint    `json:"item_id" query:"item_id" form:"item_id"`
+}
+
+type CreateShield struct {
+	Name            string  `json:"name" query:"name" binding:"required" form:"name"`
+	Description     string  `json:"description" query:"description" binding:"required" form:"description"`
+	Bulk            float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
+	Level           *uint8  `json:"level" query:"level" form:"level"`
+	Price           string  `json:"price" query:"price" binding:"required" form:"price"`
+	ArmorClass      *uint8  `json:"armor_class" query:"armor_class" form:"armor_class" example:"2"`
+	Hardness        uint8   `json:"hardness" query:"hardness" form:"hardness" example:"5"`
+	HitPoint        uint16  `json:"hit_point" query:"hit_point" form:"hit_point" binding:"required" example:"20"`
+	BrokenThreshold uint16  `json:"broken_threshold" query:"broken_threshold" form:"broken_threshold" example:"10"`
+}
+
+type UpdateShield struct {
+	Name            string  `json:"name" query:"name" form:"name"`
+	Description     string  `json:"description" query:"description" form:"description"`
+	Bulk            float64 `json:"bulk" query:"bulk" form:"bulk" example:"1"`
+	Level           *uint8  `json:"level" query:"level" form:"level"`
+	Price           string  `json:"price" query:"price" form:"price"`
+	ArmorClass      *uint8  `json:"armor_class" query:"armor_class" form:"armor_class" example:"2"`
+	Hardness        *uint8  `json:"hardness" query:"hardness" form:"hardness" example:"5"`
+	HitPoint        *uint16 `json:"hit_point" query:"hit_point" form:"hit_point" example:"20"`
+	BrokenThreshold *uint16 `json:"broken_threshold" query:"broken_threshold" form:"broken_threshold" example:"10"`
+}
+
+type ShieldExternal struct {
+	ID              uint    `json:"id" query:"id" form:"id"`
+	Name            string  `json:"name" query:"name" form:"name"`
+	Description     string  `json:"description" query:"description" form:"description"`
+	Bulk            float64 `json:"bulk" query:"bulk" form:"bulk"`
+	Level           uint8   `json:"level" query:"level" form:"level"`
+	Price           string  `json:"price" query:"price" form:"price"`
+	ArmorClass      uint8   `json:"armor_class" query:"armor_class" form:"armor_class"`
+	Hardness        uint8   `json:"hardness" query:"hardness" form:"hardness"`
+	HitPoint        uint16  `json:"hit_point" query:"hit_point" form:"hit_point"`
+	BrokenThreshold uint16  `json:"broken_threshold" query:"broken_threshold" form:"broken_threshold"`
+	ItemID          uint    `json:"item_id" query:"item_id" form:"item_id"`
+}
+
+type CreateConsumable struct {
+	Name        string         `json:"name" query:"name" binding:"required" form:"name"`
+	Description string         `json:"description" query:"description" binding:"required" form:"description"`
+	Bulk        float64        `json:"bulk" query:"bulk" form:"bulk" example:"0.1"`
+	Level       *uint8         `json:"level" query:"level" form:"level"`
+	Price       string         `json:"price" query:"price" binding:"required" form:"price"`
+	Kind        ConsumableKind `json:"kind" query:"kind" form:"kind" binding:"omitempty,oneof=Consumable Wand Staff" example:"Wand"`
+	Uses        *uint8         `json:"uses" query:"uses" form:"uses" example:"1"`
+	Charges     uint8          `json:"charges" query:"charges" form:"charges" example:"1"`
+	SpellID     *uint          `json:"spell_id" query:"spell_id" form:"spell_id"`
+	SpellRank   uint8          `json:"spell_rank" query:"spell_rank" form:"spell_rank" example:"1"`
+}
+
+type UpdateConsumable struct {
+	Name        string         `json:"name" query:"name" form:"name"`
+	Description string         `json:"description" query:"description" form:"description"`
+	Bulk        float64        `json:"bulk" query:"bulk" form:"bulk" example:"0.1"`
+	Level       *uint8         `json:"level" query:"level" form:"level"`
+	Price       string         `json:"price" query:"price" form:"price"`
+	Kind        ConsumableKind `json:"kind" query:"kind" form:"kind" binding:"omitempty,oneof=Consumable Wand Staff" example:"Wand"`
+	Uses        *uint8         `json:"uses" query:"uses" form:"uses" example:"1"`
+	Charges     *uint8         `json:"charges" query:"charges" form:"charges" example:"1"`
+	SpellID     *uint          `json:"spell_id" query:"spell_id" form:"spell_id"`
+	SpellRank   *uint8         `json:"spell_rank" query:"spell_rank" form:"spell_rank" example:"1"`
+}
+
+type ConsumableExternal struct {
+	ID          uint           `json:"id" query:"id" form:"id"`
+	Name        string         `json:"name" query:"name" form:"name"`
+	Description string         `json:"description" query:"description" form:"description"`
+	Bulk        float64        `json:"bulk" query:"bulk" form:"bulk"`
+	Level       uint8          `json:"level" query:"level" form:"level"`
+	Price       string         `json:"price" query:"price" form:"price"`
+	Kind        ConsumableKind `json:"kind" query:"kind" form:"kind"`
+	Uses        uint8          `json:"uses" query:"uses" form:"uses"`
+	Charges     uint8          `json:"charges" query:"charges" form:"charges"`
+	SpellID     *uint          `json:"spell_id" query:"spell_id" form:"spell_id"`
+	SpellName   string         `json:"spell_name" query:"spell_name" form:"spell_name"`
+	SpellRank   uint8          `json:"spell_rank" query:"spell_rank" form:"spell_rank"`
+	ItemID      uint           `json:"item_id" query:"item_id" form:"item_id"`
 }
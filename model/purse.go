@@ -0,0 +1,51 @@
+package model
+
+// Purse is the coins of a character, stored on the Character
+type Purse struct {
+	Copper   uint `gorm:"default:0" json:"copper" query:"copper" form:"copper"`
+	Silver   uint `gorm:"default:0" json:"silver" query:"silver" form:"silver"`
+	Gold     uint `gorm:"default:0" json:"gold" query:"gold" form:"gold" example:"15"`
+	Platinum uint `gorm:"default:0" json:"platinum" query:"platinum" form:"platinum"`
+}
+
+type PurseExternal struct {
+	CharacterID uint   `json:"character_id"`
+	Copper      uint   `json:"copper"`
+	Silver      uint   `json:"silver"`
+	Gold        uint   `json:"gold"`
+	Platinum    uint   `json:"platinum"`
+	Total       uint   `json:"total"`
+	Value       string `json:"value"`
+}
+
+type BuyItem struct {
+	ItemID      uint          `json:"item_id" query:"item_id" form:"item_id" binding:"required"`
+	Quantity    uint          `json:"quantity" query:"quantity" form:"quantity" binding:"max=1000" example:"1"`
+	Placement   ItemPlacement `json:"placement" query:"placement" form:"placement" binding:"omitempty,oneof=Worn Carried Stowed" example:"Carried"`
+	ContainerID *uint         `json:"container_id" query:"container_id" form:"container_id"`
+}
+
+type SellItem struct {
+	CharacterItemID uint `json:"character_item_id" query:"character_item_id" form:"character_item_id" binding:"required"`
+	Quantity        uint `json:"quantity" query:"quantity" form:"quantity" example:"1"`
+}
+
+// TradeExternal is the purse after buying or selling and the item left, CharacterItem is nil once all are sold
+type TradeExternal struct {
+	Price         uint                   `json:"price"`
+	Purse         PurseExternal          `json:"purse"`
+	CharacterItem *CharacterItemExternal `json:"character_item"`
+}
+
+type UnparseablePriceExternal struct {
+	ItemID uint   `json:"item_id"`
+	Name   string `json:"name"`
+	Price  string `json:"price"`
+	Error  string `json:"error"`
+}
+
+// PriceMigrationExternal reports the item prices converted to copper pieces and the ones left as they are
+type PriceMigrationExternal struct {
+	Converted   int                        `json:"converted"`
+	Unparseable []UnparseablePriceExternal `json:"unparseable"`
+}
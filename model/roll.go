@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+const (
+	RollSkill           = "skill"
+	RollSave            = "save"
+	RollStrike          = "strike"
+	RollDamage          = "damage"
+	RollRecallKnowledge = "recall-knowledge"
+)
+
+type CharacterRoll struct {
+	ID          uint   `gorm:"primary_key;AUTO_INCREMENT"`
+	CharacterID uint   `gorm:"not null;index"`
+	UserID      uint   `gorm:"not null"`
+	Kind        string `gorm:"type:varchar(31);not null"`
+	Name        string `gorm:"type:varchar(127)"`
+	Notation    string `gorm:"type:varchar(127);not null"`
+	Detail      string `gorm:"type:varchar(255)"`
+	Total       int
+	DC          *int
+	Degree      string `gorm:"type:varchar(31)"`
+	CreatedAt   time.Time
+}
+
+type RollDice struct {
+	Notation   string `json:"notation" query:"notation" binding:"required,max=127" example:"2d8+4"`
+	Fortune    bool   `json:"fortune" query:"fortune"`
+	Misfortune bool   `json:"misfortune" query:"misfortune"`
+	DC         *int   `json:"dc" query:"dc" example:"15"`
+}
+
+type RollCheck struct {
+	Name       string `json:"name" query:"name" binding:"required" example:"Athletics"`
+	Fortune    bool   `json:"fortune" query:"fortune"`
+	Misfortune bool   `json:"misfortune" query:"misfortune"`
+	DC         *int   `json:"dc" query:"dc" example:"15"`
+}
+
+type RollStrikeCreate struct {
+	CharacterItemID *uint  `json:"character_item_id" query:"character_item_id"`
+	Kind            string `json:"kind" query:"kind" example:"melee"`
+	Attack          uint8  `json:"attack" query:"attack" binding:"omitempty,min=1,max=3" example:"1"`
+	Fortune         bool   `json:"fortune" query:"fortune"`
+	Misfortune      bool   `json:"misfortune" query:"misfortune"`
+	DC              *int   `json:"dc" query:"dc" example:"18"`
+}
+
+type RollExternal struct {
+	ID          uint      `json:"id,omitempty"`
+	CharacterID uint      `json:"character_id,omitempty"`
+	Kind        string    `json:"kind,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Notation    string    `json:"notation"`
+	Detail      string    `json:"detail"`
+	Total       int       `json:"total"`
+	DC          *int      `json:"dc"`
+	Degree      string    `json:"degree,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type StrikeRollExternal struct {
+	Attack RollExternal  `json:"attack"`
+	Damage *RollExternal `json:"damage"`
+}
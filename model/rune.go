@@ -0,0 +1,50 @@
+package model
+
+// Rune is a property rune etched on a weapon or armor, fundamental runes are levels on the CharacterItem
+type Rune struct {
+	ID            uint            `gorm:"primary_key;AUTO_INCREMENT"`
+	Name          string          `gorm:"unique;type:varchar(127);not null"`
+	Description   string          `gorm:"type:text"`
+	Target        string          `gorm:"type:varchar(31);not null"`
+	Level         uint8           `gorm:"default:1"`
+	Price         string          `gorm:"type:varchar(127)"`
+	DamageDice    uint8           `gorm:"default:0"`
+	DamageType    string          `gorm:"type:varchar(127)"`
+	CharacterItem []CharacterItem `gorm:"many2many:character_item_runes;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type CreateRune struct {
+	Name        string `json:"name" query:"name" form:"name" binding:"required" example:"Flaming"`
+	Description string `json:"description" query:"description" form:"description"`
+	Target      string `json:"target" query:"target" form:"target" binding:"required,oneof=weapons armors" example:"weapons"`
+	Level       uint8  `json:"level" query:"level" form:"level" example:"8"`
+	Price       string `json:"price" query:"price" form:"price" example:"500 gp"`
+	DamageDice  uint8  `json:"damage_dice" query:"damage_dice" form:"damage_dice" binding:"omitempty,oneof=4 6 8 10 12" example:"6"`
+	DamageType  string `json:"damage_type" query:"damage_type" form:"damage_type" example:"fire"`
+}
+
+type RuneExternal struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Target      string `json:"target"`
+	Level       uint8  `json:"level"`
+	Price       string `json:"price"`
+	DamageDice  uint8  `json:"damage_dice"`
+	DamageType  string `json:"damage_type"`
+}
+
+type UpdateCharacterItemRunes struct {
+	Potency   uint8  `json:"potency" query:"potency" form:"potency" binding:"max=3" example:"1"`
+	Striking  uint8  `json:"striking" query:"striking" form:"striking" binding:"max=3" example:"1"`
+	Resilient uint8  `json:"resilient" query:"resilient" form:"resilient" binding:"max=3"`
+	RunesID   []uint `json:"runes_id" query:"runes_id" form:"runes_id"`
+}
+
+type TransferRunes struct {
+	TargetID  uint   `json:"target_id" query:"target_id" form:"target_id" binding:"required"`
+	Potency   bool   `json:"potency" query:"potency" form:"potency"`
+	Striking  bool   `json:"striking" query:"striking" form:"striking"`
+	Resilient bool   `json:"resilient" query:"resilient" form:"resilient"`
+	RunesID   []uint `json:"runes_id" query:"runes_id" form:"runes_id"`
+}
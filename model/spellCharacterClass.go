@@ -1,9 +1,27 @@
 package model
 
+// SpellCharacterClass is a row of the class spell slot table, the slots of a rank
+// apply from the character level until a row with a higher level replaces them
 type SpellCharacterClass struct {
 	ID               uint  `gorm:"primary_key;AUTO_INCREMENT"`
-	level            uint8 `gorm:"default:1"`
-	spellCount       uint8 `gorm:"default:1"`
-	CharacterClassID *uint
-	CharacterClass   CharacterClass `gorm:"foreignKey:CharacterClassID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Level            int8  `gorm:"default:1;uniqueIndex:idx_class_spell_slot"`
+	Rank             uint8 `gorm:"default:0;uniqueIndex:idx_class_spell_slot"`
+	SpellCount       uint8 `gorm:"default:1"`
+	CharacterClassID uint  `gorm:"not null;uniqueIndex:idx_class_spell_slot"`
+}
+
+type SpellSlotCreate struct {
+	Level      int8  `json:"level" query:"level" binding:"required,min=1,max=20" example:"1"`
+	Rank       uint8 `json:"rank" query:"rank" binding:"max=10" example:"1"`
+	SpellCount uint8 `json:"spell_count" query:"spell_count" example:"2"`
+}
+
+type UpdateSpellSlots struct {
+	Slots []SpellSlotCreate `json:"slots" query:"slots" binding:"dive"`
+}
+
+type SpellSlotTableExternal struct {
+	Level      int8  `json:"level"`
+	Rank       uint8 `json:"rank"`
+	SpellCount uint8 `json:"spell_count"`
 }
@@ -6,6 +6,7 @@ type Trait struct {
 	Description string  `gorm:"type:text;not null;"`
 	Spells      []Spell `gorm:"many2many:spell_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Feats       []Feat  `gorm:"many2many:feat_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Armors      []Armor `gorm:"many2many:armor_traits;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 type CreateTrait struct {
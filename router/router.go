@@ -10,8 +10,10 @@ import (
 	"kingdom/config"
 	"kingdom/consumer"
 	"kingdom/database"
+	"kingdom/dice"
 	"kingdom/docs"
 	gerror "kingdom/error"
+	"kingdom/model"
 )
 
 func Create(db *database.GormDatabase, conf *config.Configuration, consumer *consumer.RMQConsumer) (*gin.Engine, func()) {
@@ -29,6 +31,7 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 		}
 	})
 	authentication := auth.Auth{DB: db}
+	characterAccess := authentication.RequireCharacterAccess(model.CharacterResource, "id")
 
 	userHandler := api.UserApi{
 		DB:               db,
@@ -37,7 +40,8 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 		Consumer:         consumer,
 	}
 
-	characterHandler := api.CharacterApi{DB: db}
+	roller := dice.NewRoller(dice.CryptoSource{})
+	characterHandler := api.CharacterApi{DB: db, Roller: roller}
 	characterClassHandler := api.CharacterClassApi{DB: db}
 	itemHandler := api.ItemApi{DB: db}
 	characterItemHandler := api.CharacterItemApi{DB: db}
@@ -48,6 +52,8 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	domainHandler := api.DomainApi{DB: db}
 	featHandler := api.FeatAPI{DB: db}
 	raceHandler := api.RaceApi{DB: db}
+	creatureHandler := api.CreatureApi{DB: db}
+	archetypeHandler := api.ArchetypeApi{DB: db}
 	ancestryHandler := api.AncestryApi{DB: db}
 	traditionHandler := api.TraditionApi{DB: db}
 	actionHandler := api.ActionApi{DB: db}
@@ -57,6 +63,11 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	skillHandler := api.SkillApi{DB: db}
 	spellHandler := api.SpellAPI{DB: db}
 	loadCSVHandler := api.LoadCSVApi{DB: db}
+	campaignHandler := api.CampaignApi{DB: db, Consumer: consumer}
+	conditionHandler := api.ConditionApi{DB: db}
+	runeHandler := api.RuneApi{DB: db}
+	diceHandler := api.DiceApi{Roller: roller}
+	encounterHandler := api.EncounterApi{DB: db, Roller: roller}
 
 	authHandler := api.Controller{DB: db}
 
@@ -76,6 +87,7 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	adminGroup := g.Group("/admin").Use(authentication.RequireAdmin)
 	{
 		adminGroup.POST("/csv", loadCSVHandler.LoadCSV)
+		adminGroup.POST("/prices", itemHandler.MigratePrices)
 	}
 
 	userGroup := g.Group("/user").Use(authentication.RequireJWT)
@@ -89,12 +101,98 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	characterGroup := g.Group("/character").Use(authentication.RequireJWT)
 	{
 		characterGroup.POST("/create", characterHandler.CreateCharacter)
-		characterGroup.GET("/:id", characterHandler.GetCharacterByID)
+		characterGroup.GET("/:id", characterAccess, characterHandler.GetCharacterByID)
+		characterGroup.GET("/:id/stats", characterAccess, characterHandler.GetCharacterStats)
+		characterGroup.GET("/:id/strikes", characterAccess, characterHandler.GetCharacterStrikes)
+		characterGroup.GET("/:id/conditions", characterAccess, characterHandler.GetCharacterConditions)
+		characterGroup.POST("/:id/damage", characterAccess, characterHandler.DamageCharacter)
+		characterGroup.POST("/:id/heal", characterAccess, characterHandler.HealCharacter)
+		characterGroup.POST("/:id/recovery-check", characterAccess, characterHandler.RecoveryCheck)
+		characterGroup.GET("/:id/health-log", characterAccess, characterHandler.GetHealthLogs)
+		characterGroup.GET("/:id/experience", characterAccess, characterHandler.GetCharacterExperience)
+		characterGroup.GET("/:id/spells", characterAccess, characterHandler.GetSpellcasting)
+		characterGroup.POST("/:id/spells", characterAccess, characterHandler.LearnSpell)
+		characterGroup.DELETE("/:id/spells/:spell_id", characterAccess, characterHandler.ForgetSpell)
+		characterGroup.POST("/:id/spells/prepare", characterAccess, characterHandler.PrepareSpells)
+		characterGroup.POST("/:id/spells/cast", characterAccess, characterHandler.CastSpell)
+		characterGroup.POST("/:id/rest", characterAccess, characterHandler.Rest)
+		characterGroup.GET("/:id/purse", characterAccess, characterHandler.GetPurse)
+		characterGroup.PUT("/:id/purse", characterAccess, characterHandler.UpdatePurse)
+		characterGroup.POST("/:id/buy", characterAccess, characterHandler.BuyItem)
+		characterGroup.POST("/:id/sell", characterAccess, characterHandler.SellItem)
+		characterGroup.POST("/:id/roll/skill", characterAccess, characterHandler.RollSkill)
+		characterGroup.POST("/:id/roll/save", characterAccess, characterHandler.RollSave)
+		characterGroup.POST("/:id/roll/recall-knowledge", characterAccess, characterHandler.RollRecallKnowledge)
+		characterGroup.POST("/:id/roll/strike", characterAccess, characterHandler.RollStrike)
+		characterGroup.GET("/:id/rolls", characterAccess, characterHandler.GetCharacterRolls)
+		characterGroup.GET("/:id/eligible-feats", characterAccess, characterHandler.GetEligibleFeats)
+		characterGroup.GET("/:id/levels", characterAccess, characterHandler.GetCharacterLevels)
+		characterGroup.GET("/:id/level-up", characterAccess, characterHandler.GetLevelUpChoices)
+		characterGroup.POST("/:id/level-up", characterAccess, characterHandler.LevelUp)
+		characterGroup.POST("/:id/level-down", characterAccess, characterHandler.LevelDown)
 		characterGroup.GET("", characterHandler.GetCharacters)
-		characterGroup.PATCH("/:id", characterHandler.UpdateCharacter)
-		characterGroup.DELETE("/:id", characterHandler.DeleteCharacter)
+		characterGroup.PATCH("/:id", characterAccess, characterHandler.UpdateCharacter)
+		characterGroup.DELETE("/:id", characterAccess, characterHandler.DeleteCharacter)
+	}
+	g.POST("/roll", authentication.RequireJWT, diceHandler.Roll)
+	g.POST("/character_feat", authentication.RequireJWT, characterHandler.AddCharacterFeat)
+
+	gameMaster := authentication.RequireGameMaster("id")
+	campaignGroup := g.Group("/campaign").Use(authentication.RequireJWT)
+	{
+		campaignGroup.POST("", campaignHandler.CreateCampaign)
+		campaignGroup.GET("", campaignHandler.GetCampaigns)
+		campaignGroup.GET("/invite", campaignHandler.GetInvites)
+		campaignGroup.GET("/:id", campaignHandler.GetCampaignByID)
+		campaignGroup.PATCH("/:id", gameMaster, campaignHandler.UpdateCampaign)
+		campaignGroup.DELETE("/:id", gameMaster, campaignHandler.DeleteCampaign)
+		campaignGroup.POST("/:id/invite", gameMaster, campaignHandler.InvitePlayer)
+		campaignGroup.POST("/:id/join", campaignHandler.JoinCampaign)
+		campaignGroup.GET("/:id/party", gameMaster, campaignHandler.GetParty)
+		campaignGroup.POST("/:id/experience", gameMaster, campaignHandler.AwardExperience)
+		campaignGroup.POST("/:id/milestone", gameMaster, campaignHandler.AwardMilestone)
+		campaignGroup.PATCH("/:id/character/:character_id", gameMaster, campaignHandler.AdjustCharacter)
+		campaignGroup.DELETE("/:id/character/:character_id", campaignHandler.LeaveCampaign)
+		campaignGroup.POST("/:id/character/:character_id/condition", gameMaster, campaignHandler.SetCharacterCondition)
+		campaignGroup.DELETE("/:id/character/:character_id/condition/:condition_id", gameMaster,
+			campaignHandler.DeleteCharacterCondition)
+		campaignGroup.POST("/:id/character/:character_id/end-turn", gameMaster, campaignHandler.EndTurn)
+		campaignGroup.POST("/:id/encounter", gameMaster, encounterHandler.CreateEncounter)
+		campaignGroup.GET("/:id/encounter", encounterHandler.GetEncounters)
+		campaignGroup.GET("/:id/encounter/:encounter_id", encounterHandler.GetEncounter)
+		campaignGroup.DELETE("/:id/encounter/:encounter_id", gameMaster, encounterHandler.DeleteEncounter)
+		campaignGroup.POST("/:id/encounter/:encounter_id/initiative", gameMaster, encounterHandler.RollInitiative)
+		campaignGroup.POST("/:id/encounter/:encounter_id/next-turn", gameMaster, encounterHandler.NextTurn)
+		campaignGroup.POST("/:id/encounter/:encounter_id/combatant", gameMaster, encounterHandler.AddCombatant)
+		campaignGroup.PATCH("/:id/encounter/:encounter_id/combatant/:combatant_id", gameMaster,
+			encounterHandler.UpdateCombatant)
+		campaignGroup.DELETE("/:id/encounter/:encounter_id/combatant/:combatant_id", gameMaster,
+			encounterHandler.DeleteCombatant)
+		campaignGroup.POST("/:id/encounter/:encounter_id/combatant/:combatant_id/condition", gameMaster,
+			encounterHandler.SetCombatantCondition)
+		campaignGroup.DELETE("/:id/encounter/:encounter_id/combatant/:combatant_id/condition/:condition_id", gameMaster,
+			encounterHandler.DeleteCombatantCondition)
+		campaignGroup.POST("/:id/encounter/:encounter_id/combatant/:combatant_id/persistent-damage", gameMaster,
+			encounterHandler.AddPersistentDamage)
+		campaignGroup.DELETE("/:id/encounter/:encounter_id/combatant/:combatant_id/persistent-damage/:damage_id",
+			gameMaster, encounterHandler.DeletePersistentDamage)
+	}
+	conditionGroup := g.Group("/condition")
+	{
+		conditionGroup.POST("", authentication.RequireAdmin, conditionHandler.CreateCondition)
+		conditionGroup.GET("", authentication.RequireJWT, conditionHandler.GetConditions)
+		conditionGroup.GET("/:id", authentication.RequireJWT, conditionHandler.GetConditionByID)
+		conditionGroup.PATCH("/:id", authentication.RequireAdmin, conditionHandler.UpdateCondition)
+		conditionGroup.DELETE("/:id", authentication.RequireAdmin, conditionHandler.DeleteCondition)
+	}
+	runeGroup := g.Group("/rune")
+	{
+		runeGroup.POST("", authentication.RequireAdmin, runeHandler.CreateRune)
+		runeGroup.GET("", authentication.RequireJWT, runeHandler.GetRunes)
+		runeGroup.GET("/:id", authentication.RequireJWT, runeHandler.GetRuneByID)
+		runeGroup.PATCH("/:id", authentication.RequireAdmin, runeHandler.UpdateRune)
+		runeGroup.DELETE("/:id", authentication.RequireAdmin, runeHandler.DeleteRune)
 	}
-	g.POST("/character_feat", characterHandler.AddCharacterFeat)
 	godGroup := g.Group("/god").Use(authentication.RequireAdmin)
 	{
 		godGroup.POST("", godHandler.CreateGod)
@@ -141,6 +239,26 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	g.GET("/race", raceHandler.GetRaces).Use(authentication.RequireJWT)
 	g.GET("/race/:id", raceHandler.GetRaceByID).Use(authentication.RequireJWT)
 
+	g.GET("/encounter-budget", authentication.RequireJWT, encounterHandler.GetEncounterBudget)
+
+	creatureGroup := g.Group("/creature")
+	{
+		creatureGroup.POST("", authentication.RequireAdmin, creatureHandler.CreateCreature)
+		creatureGroup.PATCH("/:id", authentication.RequireAdmin, creatureHandler.UpdateCreature)
+		creatureGroup.DELETE("/:id", authentication.RequireAdmin, creatureHandler.DeleteCreature)
+		creatureGroup.GET("", authentication.RequireJWT, creatureHandler.GetCreatures)
+		creatureGroup.GET("/:id", authentication.RequireJWT, creatureHandler.GetCreatureByID)
+	}
+
+	archetypeGroup := g.Group("/archetype")
+	{
+		archetypeGroup.POST("", authentication.RequireAdmin, archetypeHandler.CreateArchetype)
+		archetypeGroup.PATCH("/:id", authentication.RequireAdmin, archetypeHandler.UpdateArchetype)
+		archetypeGroup.DELETE("/:id", authentication.RequireAdmin, archetypeHandler.DeleteArchetype)
+		archetypeGroup.GET("", authentication.RequireJWT, archetypeHandler.GetArchetypes)
+		archetypeGroup.GET("/:id", authentication.RequireJWT, archetypeHandler.GetArchetypeByID)
+	}
+
 	spellGroup := g.Group("/spell").Use(authentication.RequireAdmin)
 	{
 		spellGroup.POST("", spellHandler.CreateSpell)
@@ -200,9 +318,11 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 		characterClassGroup.POST("", characterClassHandler.CreateCharacterClass)
 		characterClassGroup.PATCH("/:id", characterClassHandler.UpdateCharacterClass)
 		characterClassGroup.DELETE("/:id", characterClassHandler.DeleteCharacterClass)
+		characterClassGroup.PUT("/:id/spell-slots", characterClassHandler.UpdateSpellSlotTable)
 	}
 	g.GET("/class", characterClassHandler.GetCharacterClasses).Use(authentication.RequireJWT)
 	g.GET("/class/:id", characterClassHandler.GetCharacterClassByID).Use(authentication.RequireJWT)
+	g.GET("/class/:id/spell-slots", authentication.RequireJWT, characterClassHandler.GetSpellSlotTable)
 	itemGroup := g.Group("/item").Use(authentication.RequireJWT)
 	{
 		itemGroup.GET("", itemHandler.GetItems)
@@ -213,6 +333,10 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 		itemGroup.GET("/weapon/:id", itemHandler.GetWeaponByID)
 		itemGroup.GET("/gear", itemHandler.GetGears)
 		itemGroup.GET("/gear/:id", itemHandler.GetGearByID)
+		itemGroup.GET("/shield", itemHandler.GetShields)
+		itemGroup.GET("/shield/:id", itemHandler.GetShieldByID)
+		itemGroup.GET("/consumable", itemHandler.GetConsumables)
+		itemGroup.GET("/consumable/:id", itemHandler.GetConsumableByID)
 	}
 	itemGroup.DELETE("/:id", itemHandler.DeleteItem).Use(authentication.RequireAdmin)
 	itemGroup.POST("/armor", itemHandler.CreateArmor).Use(authentication.RequireAdmin)
@@ -221,31 +345,51 @@ func Create(db *database.GormDatabase, conf *config.Configuration, consumer *con
 	itemGroup.PATCH("/weapon/:id", itemHandler.UpdateWeapon).Use(authentication.RequireAdmin)
 	itemGroup.POST("/gear", itemHandler.CreateGear).Use(authentication.RequireAdmin)
 	itemGroup.PATCH("/gear/:id", itemHandler.UpdateGear).Use(authentication.RequireAdmin)
+	itemGroup.POST("/shield", authentication.RequireAdmin, itemHandler.CreateShield)
+	itemGroup.PATCH("/shield/:id", authentication.RequireAdmin, itemHandler.UpdateShield)
+	itemGroup.POST("/consumable", authentication.RequireAdmin, itemHandler.CreateConsumable)
+	itemGroup.PATCH("/consumable/:id", authentication.RequireAdmin, itemHandler.UpdateConsumable)
 
+	characterItemAccess := authentication.RequireCharacterAccess(model.CharacterItemResource, "id")
 	characterItemGroup := g.Group("/character-item").Use(authentication.RequireJWT)
 	{
 		characterItemGroup.POST("", characterItemHandler.CreateCharacterItem)
-		characterItemGroup.GET("/:id", characterItemHandler.GetCharacterItemByID)
-		characterItemGroup.GET("/list/:character_id", characterItemHandler.GetCharacterItems)
-		characterItemGroup.DELETE("/:id", characterItemHandler.DeleteCharacterItem)
-		characterItemGroup.PATCH("/:id", characterItemHandler.UpdateCharacterItem)
+		characterItemGroup.GET("/:id", characterItemAccess, characterItemHandler.GetCharacterItemByID)
+		characterItemGroup.GET("/list/:character_id",
+			authentication.RequireCharacterAccess(model.CharacterResource, "character_id"),
+			characterItemHandler.GetCharacterItems)
+		characterItemGroup.DELETE("/:id", characterItemAccess, characterItemHandler.DeleteCharacterItem)
+		characterItemGroup.PATCH("/:id", characterItemAccess, characterItemHandler.UpdateCharacterItem)
+		characterItemGroup.PUT("/:id/runes", characterItemAccess, characterItemHandler.UpdateCharacterItemRunes)
+		characterItemGroup.POST("/:id/runes/transfer", characterItemAccess, characterItemHandler.TransferRunes)
+		characterItemGroup.POST("/:id/activate", characterItemAccess, characterItemHandler.ActivateCharacterItem)
 	}
 
 	characterSkillGroup := g.Group("/character-skill").Use(authentication.RequireJWT)
 	{
 		characterSkillGroup.POST("", characterSkillHandler.CharacterSkillCreate)
-		characterSkillGroup.GET("", characterSkillHandler.GetCharacterSkills)
-		characterSkillGroup.PATCH("/:id", characterSkillHandler.UpdateCharacterSkill)
+		characterSkillGroup.GET("/:id", characterAccess, characterSkillHandler.GetCharacterSkills)
+		characterSkillGroup.PATCH("/:id",
+			authentication.RequireCharacterAccess(model.CharacterSkillResource, "id"),
+			characterSkillHandler.UpdateCharacterSkill)
 	}
 
-	g.GET("/slot/:id", slotHandler.GetSlotByID).Use(authentication.RequireJWT)
-	g.PATCH("/slot/:id", slotHandler.UpdateSlot).Use(authentication.RequireJWT)
+	slotGroup := g.Group("/slot").Use(
+		authentication.RequireJWT,
+		authentication.RequireCharacterAccess(model.SlotResource, "id"))
+	{
+		slotGroup.GET("/:id", slotHandler.GetSlotByID)
+		slotGroup.PATCH("/:id", slotHandler.UpdateSlot)
+		slotGroup.POST("/:id/raise-shield", slotHandler.RaiseShield)
+	}
 
-	g.GET("/attribute/:id", attributeHandler.GetAttributeByID).Use(authentication.RequireJWT)
-	g.PATCH("/attribute/:id", attributeHandler.UpdateAttribute).Use(authentication.RequireJWT)
+	g.GET("/attribute/:id", authentication.RequireJWT, characterAccess, attributeHandler.GetAttributeByID)
 
-	g.GET("/character_boost/:id", characterBoostHandler.GetCharacterBoostByID).Use(authentication.RequireJWT)
-	g.PATCH("/character_boost/:id", characterBoostHandler.UpdateCharacterBoost).Use(authentication.RequireJWT)
+	characterBoostGroup := g.Group("/character_boost").Use(authentication.RequireJWT, characterAccess)
+	{
+		characterBoostGroup.GET("/:id", characterBoostHandler.GetCharacterBoostByID)
+		characterBoostGroup.PATCH("/:id", characterBoostHandler.UpdateCharacterBoost)
+	}
 
 	return g, func() {}
 }
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// DedicationFeatCount is the number of feats of an archetype needed before another dedication
+const DedicationFeatCount = 2
+
+// UnmetArchetypeRules returns the dedication rules the feat breaks for the character, taken are the feats
+// the character already has and archetype is the archetype of the feat
+func UnmetArchetypeRules(
+	character *model.Character,
+	feat *model.Feat,
+	archetype *model.Archetype,
+	taken []model.Feat,
+) []string {
+	unmet := []string{}
+	if feat.ArchetypeID == nil || archetype == nil {
+		return unmet
+	}
+	dedications := map[uint]model.Feat{}
+	count := map[uint]int{}
+	for _, takenFeat := range taken {
+		if takenFeat.ArchetypeID == nil || takenFeat.ID == feat.ID {
+			continue
+		}
+		if takenFeat.Dedication {
+			dedications[*takenFeat.ArchetypeID] = takenFeat
+		} else {
+			count[*takenFeat.ArchetypeID]++
+		}
+	}
+
+	if !feat.Dedication {
+		if _, ok := dedications[*feat.ArchetypeID]; !ok {
+			unmet = append(unmet, fmt.Sprintf("requires the %s dedication", archetype.Name))
+		}
+		return unmet
+	}
+	if archetype.CharacterClassID != nil && *archetype.CharacterClassID == character.CharacterClassID {
+		unmet = append(unmet, fmt.Sprintf("%s is a multiclass dedication of the character class", feat.Name))
+	}
+	for archetypeID, dedication := range dedications {
+		if archetypeID != *feat.ArchetypeID && count[archetypeID] < DedicationFeatCount {
+			unmet = append(unmet, fmt.Sprintf("requires %d more feats of %s before another dedication",
+				DedicationFeatCount-count[archetypeID], dedication.Name))
+		}
+	}
+	return unmet
+}
+
+// ApplyArchetypeProficiencies raises defence and skill masteries of the sheet to those the archetypes grant
+func ApplyArchetypeProficiencies(sheet *Sheet, archetypes []*model.Archetype) {
+	masteries := DefenceMasteries(&sheet.Defence)
+	for _, archetype := range archetypes {
+		for _, proficiency := range archetype.Proficiencies {
+			if mastery, ok := masteries[proficiency.Target]; ok {
+				*mastery = higherMastery(*mastery, proficiency.Mastery)
+				continue
+			}
+			raiseSkill(sheet, proficiency.Target, proficiency.Mastery)
+		}
+	}
+}
+
+// ArchetypeSpellcasting returns the class whose spellcasting an archetype grants when the character class casts none
+func ArchetypeSpellcasting(characterClass *model.CharacterClass, archetypes []*model.Archetype) *model.CharacterClass {
+	if characterClass.Spellcasting != nil {
+		return nil
+	}
+	for _, archetype := range archetypes {
+		if archetype.Spellcasting && archetype.CharacterClass != nil && archetype.CharacterClass.Spellcasting != nil {
+			return archetype.CharacterClass
+		}
+	}
+	return nil
+}
+
+func raiseSkill(sheet *Sheet, name string, mastery model.MasteryLevel) {
+	skills := append([]model.CharacterSkill(nil), sheet.Skills...)
+	for i := range skills {
+		if skills[i].Name == name {
+			skills[i].Mastery = higherMastery(skills[i].Mastery, mastery)
+			sheet.Skills = skills
+			return
+		}
+	}
+	sheet.Skills = append(skills, model.CharacterSkill{CharacterID: sheet.CharacterID, Name: name, Mastery: mastery})
+}
+
+func higherMastery(current, granted model.MasteryLevel) model.MasteryLevel {
+	if MasteryRank(granted) > MasteryRank(current) {
+		return granted
+	}
+	return current
+}
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestUnmetArchetypeRules(t *testing.T) {
+	fighterID, wizardID := uint(1), uint(2)
+	fighter := &model.Archetype{ID: 10, Name: "Fighter", CharacterClassID: &fighterID}
+	wizard := &model.Archetype{ID: 11, Name: "Wizard", CharacterClassID: &wizardID}
+	character := &model.Character{CharacterClassID: wizardID}
+
+	dedication := &model.Feat{ID: 100, Name: "Fighter Dedication", ArchetypeID: &fighter.ID, Dedication: true}
+	followUp := &model.Feat{ID: 101, Name: "Basic Maneuver", ArchetypeID: &fighter.ID}
+	assert.Empty(t, UnmetArchetypeRules(character, dedication, fighter, nil))
+	assert.Equal(t, []string{"requires the Fighter dedication"}, UnmetArchetypeRules(character, followUp, fighter, nil))
+	assert.Empty(t, UnmetArchetypeRules(character, followUp, fighter, []model.Feat{*dedication}))
+
+	wizardDedication := &model.Feat{ID: 200, Name: "Wizard Dedication", ArchetypeID: &wizard.ID, Dedication: true}
+	assert.Equal(t, []string{"Wizard Dedication is a multiclass dedication of the character class"},
+		UnmetArchetypeRules(character, wizardDedication, wizard, nil))
+
+	character.CharacterClassID = 3
+	taken := []model.Feat{*dedication, *followUp}
+	assert.Equal(t, []string{"requires 1 more feats of Fighter Dedication before another dedication"},
+		UnmetArchetypeRules(character, wizardDedication, wizard, taken))
+	taken = append(taken, model.Feat{ID: 102, Name: "Opportunist", ArchetypeID: &fighter.ID})
+	assert.Empty(t, UnmetArchetypeRules(character, wizardDedication, wizard, taken))
+
+	assert.Empty(t, UnmetArchetypeRules(character, &model.Feat{ID: 300, Name: "Toughness"}, nil, taken))
+}
+
+func TestApplyArchetypeProficiencies(t *testing.T) {
+	skills := []model.CharacterSkill{{Name: "Athletics", Mastery: model.Expert}}
+	sheet := &Sheet{
+		Defence: model.CharacterDefence{MartialWeapon: model.None, Will: model.Master},
+		Skills:  skills,
+	}
+	ApplyArchetypeProficiencies(sheet, []*model.Archetype{{Proficiencies: []model.ArchetypeProficiency{
+		{Target: "martial_weapon", Mastery: model.Train},
+		{Target: "will", Mastery: model.Train},
+		{Target: "Athletics", Mastery: model.Train},
+		{Target: "Arcana", Mastery: model.Train},
+	}}})
+	assert.Equal(t, model.Train, sheet.Defence.MartialWeapon)
+	assert.Equal(t, model.Master, sheet.Defence.Will)
+	assert.Equal(t, []model.CharacterSkill{
+		{Name: "Athletics", Mastery: model.Expert},
+		{Name: "Arcana", Mastery: model.Train},
+	}, sheet.Skills)
+	assert.Equal(t, model.Expert, skills[0].Mastery)
+}
+
+func TestArchetypeSpellcasting(t *testing.T) {
+	prepared := model.Prepared
+	wizard := &model.CharacterClass{Name: "Wizard", Spellcasting: &prepared}
+	fighter := &model.CharacterClass{Name: "Fighter"}
+	archetypes := []*model.Archetype{
+		{Name: "Champion", CharacterClass: &model.CharacterClass{Name: "Champion"}, Spellcasting: true},
+		{Name: "Wizard", CharacterClass: wizard, Spellcasting: true},
+	}
+	assert.Equal(t, wizard, ArchetypeSpellcasting(fighter, archetypes))
+	assert.Nil(t, ArchetypeSpellcasting(wizard, archetypes))
+	assert.Nil(t, ArchetypeSpellcasting(fighter, nil))
+}
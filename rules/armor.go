@@ -0,0 +1,87 @@
+package rules
+
+import "kingdom/model"
+
+// SourceArmor is the modifier source of the armor check penalty
+const SourceArmor = "Armor"
+
+// Armor traits changing where the check penalty applies
+const (
+	TraitFlexible = "Flexible"
+	TraitNoisy    = "Noisy"
+)
+
+// MeetStrengthSpeedReduction is how much of the speed penalty is ignored when the Strength requirement is met
+const MeetStrengthSpeedReduction = 5
+
+// EncumberedSpeedPenalty is the Speed penalty of the Encumbered condition
+const EncumberedSpeedPenalty = 10
+
+// MinimumSpeed is the lowest Speed penalties can reduce a character to
+const MinimumSpeed = 5
+
+// ArmorMastery returns the proficiency of the defence in the armor category, armor without a category is light
+func ArmorMastery(defence *model.CharacterDefence, category model.ArmorCategory) model.MasteryLevel {
+	switch category {
+	case model.UnarmoredDefense:
+		return defence.Unarmed
+	case model.MediumArmor:
+		return defence.MediumArmor
+	case model.HeavyArmor:
+		return defence.HeavyArmor
+	default:
+		return defence.LightArmor
+	}
+}
+
+// MeetsArmorStrength reports whether the Strength score meets the requirement of the armor
+func MeetsArmorStrength(armor *model.Armor, strength uint8) bool {
+	return strength >= armor.Strength
+}
+
+// ArmorCheckPenalty returns the penalty the armor gives to a skill check, it applies to Strength and
+// Dexterity skills unless the Strength requirement is met, flexible armor spares Acrobatics and Athletics
+// and noisy armor keeps the penalty on Stealth
+func ArmorCheckPenalty(armor *model.Armor, strength uint8, skill string, ability model.Ability) int {
+	if armor == nil || armor.CheckPenalty == 0 || (ability != model.Strength && ability != model.Dexterity) {
+		return 0
+	}
+	if hasTrait(armor.Traits, TraitFlexible) && containsName([]string{"Acrobatics", "Athletics"}, skill) {
+		return 0
+	}
+	noisy := hasTrait(armor.Traits, TraitNoisy) && skill == "Stealth"
+	if MeetsArmorStrength(armor, strength) && !noisy {
+		return 0
+	}
+	return int(armor.CheckPenalty)
+}
+
+// ArmorSpeedPenalty returns the Speed penalty of the armor, reduced when the Strength requirement is met
+func ArmorSpeedPenalty(armor *model.Armor, strength uint8) int {
+	if armor == nil {
+		return 0
+	}
+	penalty := int(armor.SpeedPenalty)
+	if MeetsArmorStrength(armor, strength) {
+		penalty -= MeetStrengthSpeedReduction
+	}
+	return max(penalty, 0)
+}
+
+// Speed returns the land Speed after the armor and Encumbered penalties
+func Speed(base uint8, armor *model.Armor, strength uint8, encumbered bool) uint8 {
+	speed := int(base) - ArmorSpeedPenalty(armor, strength)
+	if encumbered {
+		speed -= EncumberedSpeedPenalty
+	}
+	return uint8(max(speed, MinimumSpeed))
+}
+
+func hasTrait(traits []model.Trait, name string) bool {
+	for _, trait := range traits {
+		if trait.Name == name {
+			return true
+		}
+	}
+	return false
+}
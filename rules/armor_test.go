@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestArmorMastery(t *testing.T) {
+	defence := &model.CharacterDefence{Unarmed: model.Expert, LightArmor: model.Train, HeavyArmor: model.None}
+	assert.Equal(t, model.Expert, ArmorMastery(defence, model.UnarmoredDefense))
+	assert.Equal(t, model.Train, ArmorMastery(defence, model.LightArmor))
+	assert.Equal(t, model.Train, ArmorMastery(defence, ""))
+	assert.Equal(t, model.None, ArmorMastery(defence, model.HeavyArmor))
+}
+
+func TestArmorCheckPenalty(t *testing.T) {
+	chainMail := &model.Armor{Category: model.MediumArmor, CheckPenalty: 2, SpeedPenalty: 5, Strength: 16,
+		Traits: []model.Trait{{Name: TraitFlexible}, {Name: TraitNoisy}}}
+
+	assert.Equal(t, 0, ArmorCheckPenalty(nil, 10, "Athletics", model.Strength))
+	assert.Equal(t, 0, ArmorCheckPenalty(chainMail, 10, "Athletics", model.Strength))
+	assert.Equal(t, 0, ArmorCheckPenalty(chainMail, 10, "Diplomacy", model.Charisma))
+	assert.Equal(t, 2, ArmorCheckPenalty(chainMail, 14, "Thievery", model.Dexterity))
+	assert.Equal(t, 0, ArmorCheckPenalty(chainMail, 16, "Thievery", model.Dexterity))
+	assert.Equal(t, 2, ArmorCheckPenalty(chainMail, 16, "Stealth", model.Dexterity))
+}
+
+func TestSpeed(t *testing.T) {
+	fullPlate := &model.Armor{Category: model.HeavyArmor, SpeedPenalty: 10, Strength: 18}
+	assert.Equal(t, uint8(25), Speed(25, nil, 10, false))
+	assert.Equal(t, uint8(15), Speed(25, fullPlate, 16, false))
+	assert.Equal(t, uint8(20), Speed(25, fullPlate, 18, false))
+	assert.Equal(t, uint8(10), Speed(25, fullPlate, 18, true))
+	assert.Equal(t, uint8(MinimumSpeed), Speed(20, fullPlate, 10, true))
+}
+
+func TestComputeArmor(t *testing.T) {
+	dexCap := uint8(0)
+	sheet := &Sheet{
+		Level:     1,
+		Attribute: model.Attribute{Strength: 14, Dexterity: 16, Constitution: 10, Intelligence: 10, Wisdom: 10, Charisma: 10},
+		Defence:   model.CharacterDefence{Unarmed: model.Train, LightArmor: model.Train, HeavyArmor: model.None},
+		Skills:    []model.CharacterSkill{{Name: "Acrobatics", Mastery: model.Train}},
+		SkillAbility: map[string]model.Ability{
+			"Acrobatics": model.Dexterity,
+		},
+		Armor: &model.Armor{ArmorClass: 6, Category: model.HeavyArmor, DexCap: &dexCap, CheckPenalty: 3, Strength: 18},
+	}
+
+	// untrained in heavy armor and the Dex cap drops the +3 Dexterity
+	stats := Compute(sheet)
+	assert.Equal(t, 16, stats.ArmorClass.Value)
+	assert.Equal(t, model.None, stats.ArmorClass.Mastery)
+	assert.Contains(t, stats.ArmorClass.Modifiers, model.Modifier{Source: string(model.Dexterity), Value: 0})
+	assert.Equal(t, 3, stats.Skills[0].Value)
+	assert.Contains(t, stats.Skills[0].Modifiers, model.Modifier{Source: SourceArmor, Value: -3})
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// BaseAttributeScore is the score of every attribute before boosts and flaws
+const BaseAttributeScore = 10
+
+// Abilities lists all abilities in sheet order
+var Abilities = []model.Ability{
+	model.Strength, model.Dexterity, model.Constitution,
+	model.Intelligence, model.Wisdom, model.Charisma,
+}
+
+// ComputeAttributes returns attribute scores built from the ancestry flaw and boosts applied in order
+func ComputeAttributes(flaw *model.Ability, boosts []*model.AttributeBoost) model.Attribute {
+	attribute := model.Attribute{}
+	for _, ability := range Abilities {
+		*AttributeRef(&attribute, ability) = BaseAttributeScore
+	}
+	if flaw != nil {
+		if ref := AttributeRef(&attribute, *flaw); ref != nil {
+			*ref -= 2
+		}
+	}
+	for _, boost := range boosts {
+		if ref := AttributeRef(&attribute, boost.Ability); ref != nil {
+			*ref = BoostAttribute(*ref)
+		}
+	}
+	return attribute
+}
+
+// MatchesBoostHistory tells if the attribute scores are the ones built from the boosts, scores set before
+// boosts were tracked have no history to rebuild them from
+func MatchesBoostHistory(attribute model.Attribute, flaw *model.Ability, boosts []*model.AttributeBoost) bool {
+	computed := ComputeAttributes(flaw, boosts)
+	for _, ability := range Abilities {
+		if *AttributeRef(&attribute, ability) != *AttributeRef(&computed, ability) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateBoosts checks that the abilities may be boosted from the source at character creation
+func ValidateBoosts(
+	characterBoost *model.CharacterBoost,
+	flaw *model.Ability,
+	keyAbility *model.Ability,
+	source model.BoostSource,
+	abilities []model.Ability,
+) error {
+	var allowed int
+	switch source {
+	case model.AncestryBoostSource:
+		allowed = int(characterBoost.AncestryBoost)
+	case model.BackgroundBoostSource:
+		if characterBoost.BackgroundBoost {
+			allowed = 1
+		}
+	case model.ClassBoostSource:
+		if characterBoost.ClassBoost {
+			allowed = 1
+		}
+	case model.FreeBoostSource:
+		allowed = int(characterBoost.FreeBoost)
+	default:
+		return fmt.Errorf("boosts from %s can't be spent here", source)
+	}
+	if len(abilities) > allowed {
+		return fmt.Errorf("only %d %s boosts available, got %d", allowed, source, len(abilities))
+	}
+
+	picked := map[model.Ability]bool{}
+	for _, ability := range abilities {
+		if AttributeRef(&model.Attribute{}, ability) == nil {
+			return fmt.Errorf("unknown attribute %s", ability)
+		}
+		if picked[ability] {
+			return fmt.Errorf("attribute %s boosted twice from %s", ability, source)
+		}
+		picked[ability] = true
+		if source == model.AncestryBoostSource && flaw != nil && *flaw == ability {
+			return fmt.Errorf("ancestry can't boost its own flaw %s", ability)
+		}
+		if source == model.ClassBoostSource && keyAbility != nil && *keyAbility != ability {
+			return fmt.Errorf("class boost must go to key attribute %s", *keyAbility)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestComputeAttributes(t *testing.T) {
+	flaw := model.Charisma
+	attribute := ComputeAttributes(&flaw, nil)
+	assert.Equal(t, uint8(10), attribute.Strength)
+	assert.Equal(t, uint8(8), attribute.Charisma)
+
+	attribute = ComputeAttributes(&flaw, []*model.AttributeBoost{
+		{Source: model.AncestryBoostSource, Level: 1, Ability: model.Strength},
+		{Source: model.BackgroundBoostSource, Level: 1, Ability: model.Strength},
+		{Source: model.ClassBoostSource, Level: 1, Ability: model.Strength},
+		{Source: model.FreeBoostSource, Level: 1, Ability: model.Strength},
+		{Source: model.LevelBoostSource, Level: 5, Ability: model.Strength},
+		{Source: model.FreeBoostSource, Level: 1, Ability: model.Charisma},
+	})
+	assert.Equal(t, uint8(19), attribute.Strength)
+	assert.Equal(t, uint8(10), attribute.Charisma)
+	assert.Equal(t, uint8(10), attribute.Wisdom)
+}
+
+func TestMatchesBoostHistory(t *testing.T) {
+	flaw := model.Charisma
+	boosts := []*model.AttributeBoost{{Source: model.AncestryBoostSource, Level: 1, Ability: model.Strength}}
+	attribute := ComputeAttributes(&flaw, boosts)
+	attribute.ID, attribute.CharacterID = 3, 7
+	assert.True(t, MatchesBoostHistory(attribute, &flaw, boosts))
+
+	// scores set before the boosts were tracked
+	legacy := model.Attribute{Strength: 18, Dexterity: 14, Constitution: 12, Intelligence: 10, Wisdom: 12, Charisma: 10}
+	assert.False(t, MatchesBoostHistory(legacy, &flaw, nil))
+}
+
+func TestValidateBoosts(t *testing.T) {
+	characterBoost := &model.CharacterBoost{AncestryBoost: 2, BackgroundBoost: true, ClassBoost: true, FreeBoost: 4}
+	flaw := model.Charisma
+	key := model.Strength
+
+	assert.NoError(t, ValidateBoosts(characterBoost, &flaw, &key, model.AncestryBoostSource,
+		[]model.Ability{model.Strength, model.Dexterity}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.AncestryBoostSource,
+		[]model.Ability{model.Strength, model.Dexterity, model.Wisdom}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.AncestryBoostSource,
+		[]model.Ability{model.Charisma}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.FreeBoostSource,
+		[]model.Ability{model.Wisdom, model.Wisdom}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.FreeBoostSource,
+		[]model.Ability{"Luck"}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.ClassBoostSource,
+		[]model.Ability{model.Wisdom}))
+	assert.NoError(t, ValidateBoosts(characterBoost, &flaw, &key, model.ClassBoostSource,
+		[]model.Ability{model.Strength}))
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.LevelBoostSource,
+		[]model.Ability{model.Strength}))
+
+	characterBoost.BackgroundBoost = false
+	assert.Error(t, ValidateBoosts(characterBoost, &flaw, &key, model.BackgroundBoostSource,
+		[]model.Ability{model.Strength}))
+}
@@ -0,0 +1,160 @@
+package rules
+
+import (
+	"kingdom/model"
+	"math"
+)
+
+// StandardPartySize is the party size the threat budgets are given for
+const StandardPartySize = 4
+
+// MaxCreatureLevelDifference is the furthest a creature level may be from the party level
+const MaxCreatureLevelDifference = 4
+
+// MaxSuggestedCreatures limits the number of creatures in one suggested encounter
+const MaxSuggestedCreatures = 8
+
+// Threats are ordered from the easiest
+var Threats = []model.Threat{
+	model.ThreatTrivial, model.ThreatLow, model.ThreatModerate, model.ThreatSevere, model.ThreatExtreme,
+}
+
+var threatBudgets = map[model.Threat][2]int{
+	model.ThreatTrivial:  {40, 10},
+	model.ThreatLow:      {60, 15},
+	model.ThreatModerate: {80, 20},
+	model.ThreatSevere:   {120, 30},
+	model.ThreatExtreme:  {160, 40},
+}
+
+// creatureXP by creature level minus party level starting at -4
+var creatureXP = []int{10, 15, 20, 30, 40, 60, 80, 120, 160}
+
+// PartyLevel returns the average level of the party rounded to the nearest
+func PartyLevel(levels []int8) int8 {
+	if len(levels) == 0 {
+		return 1
+	}
+	total := 0
+	for _, level := range levels {
+		total += int(level)
+	}
+	return int8(math.Round(float64(total) / float64(len(levels))))
+}
+
+// EncounterBudget returns the XP budget of the threat adjusted for the party size
+func EncounterBudget(threat model.Threat, partySize int) int {
+	budget := threatBudgets[threat]
+	return max(budget[0]+(partySize-StandardPartySize)*budget[1], 0)
+}
+
+// ThreatAdjustment returns the XP the budget of the threat changes by for each character
+func ThreatAdjustment(threat model.Threat) int {
+	return threatBudgets[threat][1]
+}
+
+// CreatureXP returns the XP of a creature against the party level, false when the creature
+// is too powerful for the party. Creatures far below the party are worth nothing
+func CreatureXP(creatureLevel int8, partyLevel int8) (int, bool) {
+	difference := int(creatureLevel) - int(partyLevel)
+	if difference > MaxCreatureLevelDifference {
+		return 0, false
+	}
+	if difference < -MaxCreatureLevelDifference {
+		return 0, true
+	}
+	return creatureXP[difference+MaxCreatureLevelDifference], true
+}
+
+// ThreatOf returns the hardest threat whose budget the XP reaches, trivial below that
+func ThreatOf(xp int, partySize int) model.Threat {
+	threat := model.ThreatTrivial
+	for _, candidate := range Threats {
+		if xp >= EncounterBudget(candidate, partySize) {
+			threat = candidate
+		}
+	}
+	return threat
+}
+
+// FitsBudget reports whether the XP spends the budget without exceeding it
+// by less than one character adjustment of the threat
+func FitsBudget(xp int, threat model.Threat, partySize int) bool {
+	budget := EncounterBudget(threat, partySize)
+	return xp <= budget && xp > budget-ThreatAdjustment(threat)
+}
+
+// SuggestEncounters returns up to limit groups of creatures fitting the threat budget,
+// each creature stands for its level so groups differ by the levels of creatures
+func SuggestEncounters(
+	creatures []*model.Creature,
+	partyLevel int8,
+	partySize int,
+	threat model.Threat,
+	limit int,
+) [][]model.CreatureXPExternal {
+	byDifference := map[int][]*model.Creature{}
+	var differences []int
+	for difference := MaxCreatureLevelDifference; difference >= -MaxCreatureLevelDifference; difference-- {
+		for _, creature := range creatures {
+			if int(creature.Level)-int(partyLevel) == difference {
+				byDifference[difference] = append(byDifference[difference], creature)
+			}
+		}
+		if len(byDifference[difference]) > 0 {
+			differences = append(differences, difference)
+		}
+	}
+
+	var suggestions [][]model.CreatureXPExternal
+	budget := EncounterBudget(threat, partySize)
+	counts := make([]int, len(differences))
+	var search func(index int, xp int, total int)
+	search = func(index int, xp int, total int) {
+		if len(suggestions) >= limit {
+			return
+		}
+		if FitsBudget(xp, threat, partySize) && total > 0 {
+			suggestions = append(suggestions, pickCreatures(byDifference, differences, counts, len(suggestions)))
+			return
+		}
+		if index == len(differences) || total == MaxSuggestedCreatures {
+			return
+		}
+		value := creatureXP[differences[index]+MaxCreatureLevelDifference]
+		for count := (budget - xp) / value; count >= 0; count-- {
+			if total+count > MaxSuggestedCreatures {
+				continue
+			}
+			counts[index] = count
+			search(index+1, xp+count*value, total+count)
+			counts[index] = 0
+		}
+	}
+	search(0, 0, 0)
+	return suggestions
+}
+
+func pickCreatures(
+	byDifference map[int][]*model.Creature,
+	differences []int,
+	counts []int,
+	variant int,
+) []model.CreatureXPExternal {
+	var group []model.CreatureXPExternal
+	for i, count := range counts {
+		if count == 0 {
+			continue
+		}
+		candidates := byDifference[differences[i]]
+		creature := candidates[variant%len(candidates)]
+		group = append(group, model.CreatureXPExternal{
+			CreatureID: creature.ID,
+			Name:       creature.Name,
+			Level:      creature.Level,
+			Count:      count,
+			XP:         count * creatureXP[differences[i]+MaxCreatureLevelDifference],
+		})
+	}
+	return group
+}
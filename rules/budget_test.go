@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestEncounterBudget(t *testing.T) {
+	assert.Equal(t, int8(3), PartyLevel([]int8{3, 3, 4, 2}))
+	assert.Equal(t, int8(4), PartyLevel([]int8{3, 4}))
+
+	assert.Equal(t, 80, EncounterBudget(model.ThreatModerate, 4))
+	assert.Equal(t, 100, EncounterBudget(model.ThreatModerate, 5))
+	assert.Equal(t, 45, EncounterBudget(model.ThreatLow, 3))
+	assert.Equal(t, 240, EncounterBudget(model.ThreatExtreme, 6))
+
+	xp, ok := CreatureXP(5, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 80, xp)
+	xp, ok = CreatureXP(-1, 5)
+	assert.True(t, ok)
+	assert.Equal(t, 0, xp)
+	_, ok = CreatureXP(8, 3)
+	assert.False(t, ok)
+
+	assert.Equal(t, model.ThreatTrivial, ThreatOf(30, 4))
+	assert.Equal(t, model.ThreatSevere, ThreatOf(130, 4))
+	assert.True(t, FitsBudget(80, model.ThreatModerate, 4))
+	assert.True(t, FitsBudget(70, model.ThreatModerate, 4))
+	assert.False(t, FitsBudget(60, model.ThreatModerate, 4))
+	assert.False(t, FitsBudget(90, model.ThreatModerate, 4))
+}
+
+func TestSuggestEncounters(t *testing.T) {
+	creatures := []*model.Creature{
+		{ID: 1, Name: "Goblin Warrior", Level: -1},
+		{ID: 2, Name: "Goblin Commando", Level: 1},
+		{ID: 3, Name: "Hobgoblin Soldier", Level: 1},
+		{ID: 4, Name: "Ogre", Level: 3},
+		{ID: 5, Name: "Young Red Dragon", Level: 10},
+	}
+	suggestions := SuggestEncounters(creatures, 1, 4, model.ThreatModerate, 5)
+	assert.Len(t, suggestions, 4)
+	for _, group := range suggestions {
+		xp, total := 0, 0
+		for _, entry := range group {
+			assert.NotEqual(t, uint(5), entry.CreatureID)
+			xp += entry.XP
+			total += entry.Count
+		}
+		assert.True(t, FitsBudget(xp, model.ThreatModerate, 4))
+		assert.LessOrEqual(t, total, MaxSuggestedCreatures)
+	}
+	assert.Equal(t, []model.CreatureXPExternal{{CreatureID: 4, Name: "Ogre", Level: 3, Count: 1, XP: 80}}, suggestions[0])
+
+	assert.Empty(t, SuggestEncounters(creatures[4:], 1, 4, model.ThreatModerate, 5))
+}
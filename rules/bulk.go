@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+	"math"
+)
+
+// LightPerBulk is the number of light items adding up to one Bulk
+const LightPerBulk = 10
+
+// CarriedArmorBulk is the extra Bulk of armor carried instead of worn
+const CarriedArmorBulk = 1
+
+const armorOwnerType = "armors"
+
+// Encumbrance is the Bulk a character carries against the limits of its Strength
+type Encumbrance struct {
+	Bulk         int
+	Light        int
+	EncumberedAt int
+	MaxBulk      int
+	Encumbered   bool
+	Overloaded   bool
+}
+
+// Total returns the Bulk with the leftover light items as tenths
+func (e *Encumbrance) Total() float64 {
+	return float64(e.Bulk) + float64(e.Light)/LightPerBulk
+}
+
+// ItemLight returns the Bulk of one item in light units, anything under L is negligible
+func ItemLight(bulk float64) int {
+	if bulk < 1.0/LightPerBulk {
+		return 0
+	}
+	return int(math.Round(bulk * LightPerBulk))
+}
+
+// EncumberedAt returns the Bulk above which the character is encumbered
+func EncumberedAt(strength uint8) int {
+	return 5 + AttributeModifier(strength)
+}
+
+// MaxBulk returns the Bulk above which the character can't carry more
+func MaxBulk(strength uint8) int {
+	return 10 + AttributeModifier(strength)
+}
+
+// InventoryBulk returns the Bulk of the character items in light units, armor other than the worn one
+// counts one Bulk more and a worn container ignores the reduction of its contents
+func InventoryBulk(items []*model.CharacterItem, wornArmorID *uint) int {
+	total := 0
+	contents := map[uint]int{}
+	for _, item := range items {
+		light := itemStackLight(item, wornArmorID)
+		if item.ContainerID != nil {
+			contents[*item.ContainerID] += light
+		} else {
+			total += light
+		}
+	}
+	for _, item := range items {
+		light, ok := contents[item.ID]
+		if !ok {
+			continue
+		}
+		if item.Placement == model.WornItem {
+			light -= ItemLight(item.Item.BulkReduction)
+		}
+		total += max(light, 0)
+		delete(contents, item.ID)
+	}
+	// items in a container the character no longer has are counted as carried
+	for _, light := range contents {
+		total += light
+	}
+	return total
+}
+
+// ComputeEncumbrance returns the Bulk of the items against the Strength limits, leftover light items
+// don't count towards the limits
+func ComputeEncumbrance(items []*model.CharacterItem, wornArmorID *uint, strength uint8) *Encumbrance {
+	light := InventoryBulk(items, wornArmorID)
+	encumbrance := &Encumbrance{
+		Bulk:         light / LightPerBulk,
+		Light:        light % LightPerBulk,
+		EncumberedAt: EncumberedAt(strength),
+		MaxBulk:      MaxBulk(strength),
+	}
+	encumbrance.Encumbered = encumbrance.Bulk > encumbrance.EncumberedAt
+	encumbrance.Overloaded = encumbrance.Bulk > encumbrance.MaxBulk
+	return encumbrance
+}
+
+// ValidateContainer returns the reason the item can't be stowed in its container, the container must be
+// another not stowed item of the character with room for the item
+func ValidateContainer(items []*model.CharacterItem, item *model.CharacterItem) error {
+	if item.ContainerID == nil {
+		return nil
+	}
+	var container *model.CharacterItem
+	for _, candidate := range items {
+		if candidate.ID == *item.ContainerID {
+			container = candidate
+		}
+	}
+	switch {
+	case container == nil:
+		return fmt.Errorf("container %d isn't an item of the character", *item.ContainerID)
+	case container.ID == item.ID:
+		return fmt.Errorf("%s can't be stowed in itself", container.Item.Name)
+	case container.Item.Capacity <= 0:
+		return fmt.Errorf("%s isn't a container", container.Item.Name)
+	case container.ContainerID != nil:
+		return fmt.Errorf("%s is stowed in another container", container.Item.Name)
+	}
+	used := itemStackLight(item, nil)
+	for _, candidate := range items {
+		if candidate.ID != item.ID && candidate.ContainerID != nil && *candidate.ContainerID == container.ID {
+			used += itemStackLight(candidate, nil)
+		}
+	}
+	if used > ItemLight(container.Item.Capacity) {
+		return fmt.Errorf("%s holds at most %g Bulk", container.Item.Name, container.Item.Capacity)
+	}
+	return nil
+}
+
+func itemStackLight(item *model.CharacterItem, wornArmorID *uint) int {
+	light := ItemLight(item.Item.Bulk) * int(item.Quantity)
+	if item.Item.OwnerType == armorOwnerType && (wornArmorID == nil || *wornArmorID != item.ID) {
+		light += CarriedArmorBulk * LightPerBulk * int(item.Quantity)
+	}
+	return light
+}
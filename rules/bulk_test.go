@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestItemLight(t *testing.T) {
+	assert.Equal(t, 0, ItemLight(0))
+	assert.Equal(t, 0, ItemLight(0.001))
+	assert.Equal(t, 1, ItemLight(0.1))
+	assert.Equal(t, 20, ItemLight(2))
+}
+
+func TestComputeEncumbrance(t *testing.T) {
+	backpackID := uint(2)
+	armor := &model.CharacterItem{ID: 1, Quantity: 1, Placement: model.WornItem,
+		Item: model.Item{Name: "Chain Mail", Bulk: 2, OwnerType: "armors"}}
+	backpack := &model.CharacterItem{ID: 2, Quantity: 1, Placement: model.WornItem,
+		Item: model.Item{Name: "Backpack", Bulk: 0.1, Capacity: 4, BulkReduction: 2}}
+	rope := &model.CharacterItem{ID: 3, Quantity: 1, Placement: model.StowedItem, ContainerID: &backpackID,
+		Item: model.Item{Name: "Rope", Bulk: 0.1}}
+	rations := &model.CharacterItem{ID: 4, Quantity: 2, Placement: model.StowedItem, ContainerID: &backpackID,
+		Item: model.Item{Name: "Bedroll", Bulk: 1}}
+	torches := &model.CharacterItem{ID: 5, Quantity: 9, Placement: model.CarriedItem,
+		Item: model.Item{Name: "Torch", Bulk: 0.1}}
+	coins := &model.CharacterItem{ID: 6, Quantity: 100, Item: model.Item{Name: "Coin", Bulk: 0.001}}
+	items := []*model.CharacterItem{armor, backpack, rope, rations, torches, coins}
+
+	// 2 armor + L backpack + (2 L - 2) contents + 9 L torches
+	encumbrance := ComputeEncumbrance(items, &armor.ID, 10)
+	assert.Equal(t, 3, encumbrance.Bulk)
+	assert.Equal(t, 1, encumbrance.Light)
+	assert.Equal(t, 3.1, encumbrance.Total())
+	assert.Equal(t, 5, encumbrance.EncumberedAt)
+	assert.Equal(t, 10, encumbrance.MaxBulk)
+	assert.False(t, encumbrance.Encumbered)
+
+	// carried armor counts one more and a carried backpack doesn't reduce its contents
+	backpack.Placement = model.CarriedItem
+	encumbrance = ComputeEncumbrance(items, nil, 8)
+	assert.Equal(t, 6, encumbrance.Bulk)
+	assert.Equal(t, 4, encumbrance.EncumberedAt)
+	assert.True(t, encumbrance.Encumbered)
+	assert.False(t, encumbrance.Overloaded)
+}
+
+func TestValidateContainer(t *testing.T) {
+	backpackID, ropeID := uint(2), uint(3)
+	backpack := &model.CharacterItem{ID: 2, Quantity: 1, Item: model.Item{Name: "Backpack", Capacity: 4}}
+	rope := &model.CharacterItem{ID: 3, Quantity: 1, Item: model.Item{Name: "Rope", Bulk: 0.1}}
+	items := []*model.CharacterItem{backpack, rope}
+
+	assert.NoError(t, ValidateContainer(items, &model.CharacterItem{ID: 4, Quantity: 4, ContainerID: &backpackID,
+		Item: model.Item{Bulk: 1}}))
+	assert.EqualError(t, ValidateContainer(items, &model.CharacterItem{ID: 4, Quantity: 5, ContainerID: &backpackID,
+		Item: model.Item{Bulk: 1}}), "Backpack holds at most 4 Bulk")
+	assert.EqualError(t, ValidateContainer(items, &model.CharacterItem{ID: 4, Quantity: 1, ContainerID: &ropeID}),
+		"Rope isn't a container")
+	missing := uint(9)
+	assert.EqualError(t, ValidateContainer(items, &model.CharacterItem{ID: 4, ContainerID: &missing}),
+		"container 9 isn't an item of the character")
+	assert.NoError(t, ValidateContainer(items, rope))
+}
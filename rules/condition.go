@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"kingdom/model"
+	"sort"
+)
+
+const (
+	StatusPenalty       = "status"
+	CircumstancePenalty = "circumstance"
+)
+
+// Conditions kept on the character defence instead of the condition list
+const (
+	ConditionDying   = "Dying"
+	ConditionWounded = "Wounded"
+)
+
+// ConditionEncumbered is applied and removed automatically from the Bulk a character carries
+const ConditionEncumbered = "Encumbered"
+
+// ConditionPenalties returns the worst penalty of each type the conditions impose on a check
+// or DC based on the ability, armorClass adds the fixed penalties to armor class
+func ConditionPenalties(
+	conditions []model.CharacterCondition,
+	ability *model.Ability,
+	armorClass bool,
+) []model.Modifier {
+	worst := map[string]model.Modifier{}
+	for _, characterCondition := range conditions {
+		condition := characterCondition.Condition
+		penalty := 0
+		if condition.AllChecks || (ability != nil && conditionAbility(&condition, *ability)) {
+			penalty = conditionValue(characterCondition)
+		}
+		if armorClass && int(condition.ArmorClass) > penalty {
+			penalty = int(condition.ArmorClass)
+		}
+		if penalty == 0 {
+			continue
+		}
+		if current, ok := worst[condition.PenaltyType]; !ok || -penalty < current.Value {
+			worst[condition.PenaltyType] = model.Modifier{Source: condition.Name, Value: -penalty}
+		}
+	}
+
+	penaltyTypes := make([]string, 0, len(worst))
+	for penaltyType := range worst {
+		penaltyTypes = append(penaltyTypes, penaltyType)
+	}
+	sort.Strings(penaltyTypes)
+	modifiers := make([]model.Modifier, 0, len(worst))
+	for _, penaltyType := range penaltyTypes {
+		modifiers = append(modifiers, worst[penaltyType])
+	}
+	return modifiers
+}
+
+// EndTurn decrements conditions that fade at the end of turn and returns the changed ones,
+// a condition with count 0 must be removed
+func EndTurn(conditions []model.CharacterCondition) []model.CharacterCondition {
+	var changed []model.CharacterCondition
+	for _, characterCondition := range conditions {
+		count, ok := DecrementCondition(&characterCondition.Condition, characterCondition.Count)
+		if !ok {
+			continue
+		}
+		characterCondition.Count = count
+		changed = append(changed, characterCondition)
+	}
+	return changed
+}
+
+// DecrementCondition returns the count of the condition after the end of turn
+// and whether the condition fades at all
+func DecrementCondition(condition *model.Condition, count int8) (int8, bool) {
+	if !condition.Decrement {
+		return count, false
+	}
+	if condition.Valued && count > 1 {
+		return count - 1, true
+	}
+	return 0, true
+}
+
+func conditionAbility(condition *model.Condition, ability model.Ability) bool {
+	for _, conditionAbility := range condition.Abilities {
+		if conditionAbility.Ability == ability {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionValue(characterCondition model.CharacterCondition) int {
+	if characterCondition.Condition.Valued {
+		return int(characterCondition.Count)
+	}
+	return 1
+}
@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestConditionPenalties(t *testing.T) {
+	dexterity := model.Dexterity
+	frightened := model.Condition{Name: "Frightened", Valued: true, Decrement: true, PenaltyType: StatusPenalty, AllChecks: true}
+	sickened := model.Condition{Name: "Sickened", Valued: true, PenaltyType: StatusPenalty, AllChecks: true}
+	clumsy := model.Condition{Name: "Clumsy", Valued: true, PenaltyType: StatusPenalty,
+		Abilities: []model.ConditionAbility{{Ability: dexterity}}}
+	offGuard := model.Condition{Name: "Off-Guard", PenaltyType: CircumstancePenalty, ArmorClass: 2}
+	conditions := []model.CharacterCondition{
+		{Count: 2, Condition: frightened},
+		{Count: 1, Condition: sickened},
+		{Count: 1, Condition: offGuard},
+	}
+
+	assert.Equal(t, []model.Modifier{{Source: "Frightened", Value: -2}},
+		ConditionPenalties(conditions, nil, false))
+	assert.Equal(t, []model.Modifier{
+		{Source: "Off-Guard", Value: -2},
+		{Source: "Frightened", Value: -2},
+	}, ConditionPenalties(conditions, &dexterity, true))
+
+	conditions = append(conditions, model.CharacterCondition{Count: 3, Condition: clumsy})
+	assert.Equal(t, []model.Modifier{{Source: "Clumsy", Value: -3}},
+		ConditionPenalties(conditions, &dexterity, false))
+	assert.Empty(t, ConditionPenalties(nil, &dexterity, true))
+
+	stupefied := model.Condition{Name: "Stupefied", Valued: true, PenaltyType: StatusPenalty,
+		Abilities: []model.ConditionAbility{
+			{Ability: model.Intelligence}, {Ability: model.Wisdom}, {Ability: model.Charisma},
+		}}
+	conditions = []model.CharacterCondition{{Count: 2, Condition: stupefied}}
+	for _, ability := range []model.Ability{model.Intelligence, model.Wisdom, model.Charisma} {
+		assert.Equal(t, []model.Modifier{{Source: "Stupefied", Value: -2}},
+			ConditionPenalties(conditions, &ability, false))
+	}
+	assert.Empty(t, ConditionPenalties(conditions, &dexterity, false))
+}
+
+func TestEndTurn(t *testing.T) {
+	conditions := []model.CharacterCondition{
+		{ID: 1, Count: 2, Condition: model.Condition{Name: "Frightened", Valued: true, Decrement: true}},
+		{ID: 2, Count: 1, Condition: model.Condition{Name: "Sickened", Valued: true}},
+		{ID: 3, Count: 1, Condition: model.Condition{Name: "Frightened", Valued: true, Decrement: true}},
+		{ID: 4, Count: 1, Condition: model.Condition{Name: "Stunned", Decrement: true}},
+	}
+
+	changed := EndTurn(conditions)
+	assert.Len(t, changed, 3)
+	assert.Equal(t, uint(1), changed[0].ID)
+	assert.Equal(t, int8(1), changed[0].Count)
+	assert.Equal(t, int8(0), changed[1].Count)
+	assert.Equal(t, int8(0), changed[2].Count)
+	assert.Equal(t, int8(2), conditions[0].Count)
+}
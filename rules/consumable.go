@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// ActivationResult is what is left of the item after an activation, Charges is nil when the item is full
+// and Split asks for one wand or staff to be taken off the stack before it's saved
+type ActivationResult struct {
+	Quantity uint
+	Charges  *uint8
+	Consumed bool
+	Split    bool
+}
+
+// ConsumableCharges returns the uses or charges left on the item, an untracked item has all of them
+func ConsumableCharges(consumable *model.Consumable, characterItem *model.CharacterItem) uint8 {
+	if characterItem.Charges != nil {
+		return *characterItem.Charges
+	}
+	return MaxCharges(consumable)
+}
+
+// MaxCharges returns the uses of one consumable or the charges a wand or staff gets every day
+func MaxCharges(consumable *model.Consumable) uint8 {
+	if consumable.Kind == model.WandItem || consumable.Kind == model.StaffItem {
+		return consumable.Charges
+	}
+	return max(consumable.Uses, 1)
+}
+
+// Activate spends a use or a charge, a consumable without uses left is used up and the next one starts full,
+// a wand or staff keeps its charges spent until the daily preparations, each one in a stack has its own charges
+func Activate(consumable *model.Consumable, characterItem *model.CharacterItem) (*ActivationResult, error) {
+	if characterItem.Placement == model.StowedItem {
+		return nil, fmt.Errorf("%s must be retrieved before it's activated", characterItem.Item.Name)
+	}
+	if characterItem.Quantity == 0 {
+		return nil, fmt.Errorf("%s is used up", characterItem.Item.Name)
+	}
+	charges := ConsumableCharges(consumable, characterItem)
+	if charges == 0 {
+		return nil, fmt.Errorf("%s has no charges left today", characterItem.Item.Name)
+	}
+	left := charges - 1
+	result := &ActivationResult{Quantity: characterItem.Quantity, Charges: &left}
+	if consumable.Kind == model.WandItem || consumable.Kind == model.StaffItem {
+		if result.Quantity > 1 {
+			result.Quantity = 1
+			result.Split = true
+		}
+		return result, nil
+	}
+	if left > 0 {
+		return result, nil
+	}
+	result.Quantity--
+	result.Charges = nil
+	result.Consumed = result.Quantity == 0
+	return result, nil
+}
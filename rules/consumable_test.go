@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestActivateConsumable(t *testing.T) {
+	potion := &model.Consumable{Kind: model.ConsumableItem, Uses: 1}
+	potions := &model.CharacterItem{Quantity: 2, Item: model.Item{Name: "Healing Potion"}}
+
+	// the next potion of the stack starts full, so the stack stays plain
+	result, err := Activate(potion, potions)
+	assert.NoError(t, err)
+	assert.Equal(t, &ActivationResult{Quantity: 1}, result)
+
+	potions.Quantity = 1
+	result, err = Activate(potion, potions)
+	assert.NoError(t, err)
+	assert.True(t, result.Consumed)
+
+	salve := &model.Consumable{Kind: model.ConsumableItem, Uses: 3}
+	used := uint8(2)
+	jar := &model.CharacterItem{Quantity: 1, Charges: &used, Item: model.Item{Name: "Salve"}}
+	result, err = Activate(salve, jar)
+	assert.NoError(t, err)
+	left := uint8(1)
+	assert.Equal(t, &ActivationResult{Quantity: 1, Charges: &left}, result)
+
+	jar.Placement = model.StowedItem
+	_, err = Activate(salve, jar)
+	assert.EqualError(t, err, "Salve must be retrieved before it's activated")
+}
+
+func TestActivateWand(t *testing.T) {
+	wand := &model.Consumable{Kind: model.WandItem, Charges: 1}
+	item := &model.CharacterItem{Quantity: 1, Item: model.Item{Name: "Wand of Heal"}}
+	assert.Equal(t, uint8(1), ConsumableCharges(wand, item))
+
+	result, err := Activate(wand, item)
+	assert.NoError(t, err)
+	empty := uint8(0)
+	assert.Equal(t, &ActivationResult{Quantity: 1, Charges: &empty}, result)
+
+	item.Charges = result.Charges
+	_, err = Activate(wand, item)
+	assert.EqualError(t, err, "Wand of Heal has no charges left today")
+
+	// one wand of a stack is used, the others keep their charges
+	stack := &model.CharacterItem{Quantity: 3, Item: model.Item{Name: "Wand of Heal"}}
+	result, err = Activate(wand, stack)
+	assert.NoError(t, err)
+	assert.Equal(t, &ActivationResult{Quantity: 1, Charges: &empty, Split: true}, result)
+}
@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Coin values in copper pieces
+const (
+	CopperPiece   = 1
+	SilverPiece   = 10
+	GoldPiece     = 100
+	PlatinumPiece = 1000
+)
+
+var (
+	pricePattern = regexp.MustCompile(`^(\s*\d+\s*(cp|sp|gp|pp)\s*)+$`)
+	coinPattern  = regexp.MustCompile(`(\d+)\s*(cp|sp|gp|pp)`)
+	coinValues   = map[string]uint{"cp": CopperPiece, "sp": SilverPiece, "gp": GoldPiece, "pp": PlatinumPiece}
+)
+
+// ParsePrice returns the price in copper pieces, like 1 gp 5 sp, an empty price or a dash has no price
+func ParsePrice(price string) (*uint, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(price), ",", ""))
+	if normalized == "" || NotForSale(normalized) {
+		return nil, nil
+	}
+	if !pricePattern.MatchString(normalized) {
+		return nil, fmt.Errorf("price %q isn't in cp, sp, gp or pp", price)
+	}
+	total := uint(0)
+	for _, coins := range coinPattern.FindAllStringSubmatch(normalized, -1) {
+		amount, err := strconv.ParseUint(coins[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("price %q isn't in cp, sp, gp or pp", price)
+		}
+		total += uint(amount) * coinValues[coins[2]]
+	}
+	return &total, nil
+}
+
+// NotForSale tells if the price is a dash, the item can't be bought
+func NotForSale(price string) bool {
+	switch strings.TrimSpace(price) {
+	case "-", "–", "—":
+		return true
+	}
+	return false
+}
+
+// FormatPrice writes the copper pieces as gold, silver and copper pieces, like 1 gp 5 sp
+func FormatPrice(copper uint) string {
+	if copper == 0 {
+		return "0 cp"
+	}
+	parts := []string{}
+	for _, coin := range []struct {
+		name  string
+		value uint
+	}{{"gp", GoldPiece}, {"sp", SilverPiece}, {"cp", CopperPiece}} {
+		if copper >= coin.value {
+			parts = append(parts, fmt.Sprintf("%d %s", copper/coin.value, coin.name))
+			copper %= coin.value
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// PurseValue returns the coins of the character in copper pieces
+func PurseValue(purse model.Purse) uint {
+	return purse.Copper*CopperPiece + purse.Silver*SilverPiece + purse.Gold*GoldPiece + purse.Platinum*PlatinumPiece
+}
+
+// Pay spends the smallest coins first and breaks a larger coin when no exact payment is left,
+// the change comes back in smaller coins
+func Pay(purse model.Purse, cost uint) (model.Purse, error) {
+	if PurseValue(purse) < cost {
+		return purse, fmt.Errorf("%s short", FormatPrice(cost-PurseValue(purse)))
+	}
+	coins := []*uint{&purse.Copper, &purse.Silver, &purse.Gold, &purse.Platinum}
+	values := []uint{CopperPiece, SilverPiece, GoldPiece, PlatinumPiece}
+	remaining := cost
+	for i, count := range coins {
+		spent := min(*count, remaining/values[i])
+		*count -= spent
+		remaining -= spent * values[i]
+	}
+	if remaining == 0 {
+		return purse, nil
+	}
+	// every coin left is worth more than the remaining cost
+	for i, count := range coins {
+		if *count == 0 {
+			continue
+		}
+		*count--
+		change := values[i] - remaining
+		for j := i - 1; j >= 0; j-- {
+			*coins[j] += change / values[j]
+			change %= values[j]
+		}
+		break
+	}
+	return purse, nil
+}
+
+// Receive adds the copper pieces to the purse as gold, silver and copper pieces
+func Receive(purse model.Purse, copper uint) model.Purse {
+	purse.Gold += copper / GoldPiece
+	purse.Silver += copper % GoldPiece / SilverPiece
+	purse.Copper += copper % SilverPiece
+	return purse
+}
+
+// TotalPrice returns the price of the quantity in copper pieces or an error when it doesn't fit in a purse
+func TotalPrice(price uint, quantity uint) (uint, error) {
+	total := price * quantity
+	if quantity != 0 && total/quantity != price {
+		return 0, fmt.Errorf("price of %d items is too high", quantity)
+	}
+	return total, nil
+}
+
+// SellPrice returns what a merchant pays for the items, half of their price
+func SellPrice(price uint, quantity uint) uint {
+	return price * quantity / 2
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"math"
+	"testing"
+)
+
+func TestParsePrice(t *testing.T) {
+	for price, copper := range map[string]uint{
+		"1 gp 5 sp": 150,
+		"2,000 gp":  200000,
+		"3 cp":      3,
+		"1 PP":      1000,
+		"0 gp":      0,
+	} {
+		parsed, err := ParsePrice(price)
+		assert.NoError(t, err, price)
+		if assert.NotNil(t, parsed, price) {
+			assert.Equal(t, copper, *parsed, price)
+		}
+	}
+	for _, price := range []string{"—", "-", ""} {
+		parsed, err := ParsePrice(price)
+		assert.NoError(t, err, price)
+		assert.Nil(t, parsed, price)
+	}
+	assert.True(t, NotForSale("—"))
+	assert.False(t, NotForSale(""))
+	assert.False(t, NotForSale("1 gp"))
+	_, err := ParsePrice("5 gp per day")
+	assert.EqualError(t, err, `price "5 gp per day" isn't in cp, sp, gp or pp`)
+
+	assert.Equal(t, "1 gp 5 sp", FormatPrice(150))
+	assert.Equal(t, "12 gp 3 cp", FormatPrice(1203))
+	assert.Equal(t, "0 cp", FormatPrice(0))
+}
+
+func TestPay(t *testing.T) {
+	purse, err := Pay(model.Purse{Copper: 5, Silver: 2, Gold: 1}, 25)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Purse{Gold: 1}, purse)
+
+	// a gold piece is broken for the last 3 copper pieces
+	purse, err = Pay(model.Purse{Copper: 5, Gold: 1}, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Purse{Copper: 7, Silver: 9}, purse)
+	assert.Equal(t, uint(97), PurseValue(purse))
+
+	purse, err = Pay(model.Purse{Platinum: 1}, 150)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Purse{Silver: 5, Gold: 8}, purse)
+
+	_, err = Pay(model.Purse{Gold: 1}, 250)
+	assert.EqualError(t, err, "1 gp 5 sp short")
+}
+
+func TestReceive(t *testing.T) {
+	assert.Equal(t, model.Purse{Copper: 3, Silver: 5, Gold: 2, Platinum: 1},
+		Receive(model.Purse{Copper: 1, Platinum: 1}, 252))
+	assert.Equal(t, uint(75), SellPrice(50, 3))
+}
+
+func TestTotalPrice(t *testing.T) {
+	total, err := TotalPrice(150, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(450), total)
+
+	_, err = TotalPrice(GoldPiece, math.MaxUint/GoldPiece+1)
+	assert.Error(t, err)
+}
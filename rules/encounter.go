@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"kingdom/model"
+	"sort"
+)
+
+// PersistentDamageDC is the DC of the flat check that ends persistent damage
+const PersistentDamageDC = 15
+
+// InitiativeOrder returns the combatants from the highest initiative, creatures act before
+// characters on a tie and combatants without initiative come last
+func InitiativeOrder(combatants []model.Combatant) []model.Combatant {
+	order := append([]model.Combatant(nil), combatants...)
+	sort.SliceStable(order, func(i, j int) bool {
+		first, second := order[i], order[j]
+		if (first.Initiative == nil) != (second.Initiative == nil) {
+			return first.Initiative != nil
+		}
+		if first.Initiative != nil && *first.Initiative != *second.Initiative {
+			return *first.Initiative > *second.Initiative
+		}
+		if (first.CharacterID == nil) != (second.CharacterID == nil) {
+			return first.CharacterID == nil
+		}
+		return first.ID < second.ID
+	})
+	return order
+}
+
+// NextCombatant returns the combatant acting after the current one in the order
+// and whether a new round begins
+func NextCombatant(order []model.Combatant, currentID *uint) (*model.Combatant, bool) {
+	if len(order) == 0 {
+		return nil, false
+	}
+	if currentID != nil {
+		for i := range order {
+			if order[i].ID == *currentID && i+1 < len(order) {
+				return &order[i+1], false
+			}
+		}
+	}
+	return &order[0], true
+}
+
+// DamageCombatant spends temporary hit points first, a creature goes down at 0 hit points
+func DamageCombatant(combatant *model.Combatant, amount uint16) {
+	absorbed := min(amount, combatant.TemporaryHitPoint)
+	combatant.TemporaryHitPoint -= absorbed
+	combatant.HitPoint -= min(amount-absorbed, combatant.HitPoint)
+}
+
+// HealCombatant restores hit points of a creature up to its maximum
+func HealCombatant(combatant *model.Combatant, amount uint16) {
+	combatant.HitPoint = uint16(min(uint32(combatant.HitPoint)+uint32(amount), uint32(combatant.MaxHitPoint)))
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestInitiativeOrder(t *testing.T) {
+	characterID := uint(7)
+	high, tie := 21, 15
+	order := InitiativeOrder([]model.Combatant{
+		{ID: 1, Name: "Unrolled"},
+		{ID: 2, Name: "Valeros", CharacterID: &characterID, Initiative: &tie},
+		{ID: 3, Name: "Goblin", Initiative: &tie},
+		{ID: 4, Name: "Ezren", Initiative: &high},
+	})
+	var names []string
+	for _, combatant := range order {
+		names = append(names, combatant.Name)
+	}
+	assert.Equal(t, []string{"Ezren", "Goblin", "Valeros", "Unrolled"}, names)
+
+	next, newRound := NextCombatant(order, nil)
+	assert.Equal(t, uint(4), next.ID)
+	assert.True(t, newRound)
+	current := uint(3)
+	next, newRound = NextCombatant(order, &current)
+	assert.Equal(t, uint(2), next.ID)
+	assert.False(t, newRound)
+	current = 1
+	next, newRound = NextCombatant(order, &current)
+	assert.Equal(t, uint(4), next.ID)
+	assert.True(t, newRound)
+	next, _ = NextCombatant(nil, &current)
+	assert.Nil(t, next)
+}
+
+func TestDamageCombatant(t *testing.T) {
+	combatant := &model.Combatant{MaxHitPoint: 20, HitPoint: 20, TemporaryHitPoint: 3}
+	DamageCombatant(combatant, 5)
+	assert.Equal(t, uint16(0), combatant.TemporaryHitPoint)
+	assert.Equal(t, uint16(18), combatant.HitPoint)
+	DamageCombatant(combatant, 30)
+	assert.Equal(t, uint16(0), combatant.HitPoint)
+	HealCombatant(combatant, 50)
+	assert.Equal(t, uint16(20), combatant.HitPoint)
+}
+
+func TestDecrementCondition(t *testing.T) {
+	frightened := &model.Condition{Name: "Frightened", Valued: true, Decrement: true}
+	count, ok := DecrementCondition(frightened, 2)
+	assert.True(t, ok)
+	assert.Equal(t, int8(1), count)
+	count, _ = DecrementCondition(frightened, 1)
+	assert.Equal(t, int8(0), count)
+
+	_, ok = DecrementCondition(&model.Condition{Name: "Prone"}, 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,38 @@
+package rules
+
+import "kingdom/model"
+
+// ExperiencePerLevel is the experience a character needs to gain a level
+const ExperiencePerLevel = 1000
+
+// ReadyToLevelUp reports whether the character reached 1000 XP or was granted a milestone
+func ReadyToLevelUp(character *model.Character) bool {
+	return character.Level < MaxLevel && (character.LevelReady || character.Experience >= ExperiencePerLevel)
+}
+
+// GainExperience adds the amount to the character experience, a negative amount removes it
+func GainExperience(character *model.Character, amount int) {
+	character.Experience = uint16(min(max(int(character.Experience)+amount, 0), 65535))
+}
+
+// SpendLevelExperience takes the granted milestone or the experience of one level on level up
+// and records the payment on the level
+func SpendLevelExperience(character *model.Character, level *model.CharacterLevel) {
+	if character.LevelReady {
+		character.LevelReady = false
+		level.Milestone = true
+		return
+	}
+	if character.Experience >= ExperiencePerLevel {
+		character.Experience -= ExperiencePerLevel
+		level.Experience = ExperiencePerLevel
+	}
+}
+
+// RefundLevelExperience gives back the milestone or the experience the level was paid with on level down
+func RefundLevelExperience(character *model.Character, level *model.CharacterLevel) {
+	if level.Milestone {
+		character.LevelReady = true
+	}
+	GainExperience(character, int(level.Experience))
+}
@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestExperience(t *testing.T) {
+	character := &model.Character{Level: 3, Experience: 960}
+	assert.False(t, ReadyToLevelUp(character))
+	GainExperience(character, 80)
+	assert.Equal(t, uint16(1040), character.Experience)
+	assert.True(t, ReadyToLevelUp(character))
+	SpendLevelExperience(character, &model.CharacterLevel{})
+	assert.Equal(t, uint16(40), character.Experience)
+	GainExperience(character, -100)
+	assert.Equal(t, uint16(0), character.Experience)
+
+	character.LevelReady = true
+	assert.True(t, ReadyToLevelUp(character))
+	SpendLevelExperience(character, &model.CharacterLevel{})
+	assert.False(t, character.LevelReady)
+
+	character = &model.Character{Level: MaxLevel, Experience: 1200}
+	assert.False(t, ReadyToLevelUp(character))
+}
+
+func TestLevelExperienceRoundTrip(t *testing.T) {
+	character := &model.Character{Level: 1, Experience: 1040}
+	level := &model.CharacterLevel{Level: 2}
+	SpendLevelExperience(character, level)
+	assert.Equal(t, uint16(40), character.Experience)
+	assert.Equal(t, uint16(ExperiencePerLevel), level.Experience)
+	RefundLevelExperience(character, level)
+	assert.Equal(t, uint16(1040), character.Experience)
+	assert.False(t, character.LevelReady)
+
+	character = &model.Character{Level: 1, Experience: 1200, LevelReady: true}
+	level = &model.CharacterLevel{Level: 2}
+	SpendLevelExperience(character, level)
+	assert.True(t, level.Milestone)
+	assert.Equal(t, uint16(0), level.Experience)
+	RefundLevelExperience(character, level)
+	assert.True(t, character.LevelReady)
+	assert.Equal(t, uint16(1200), character.Experience)
+}
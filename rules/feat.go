@@ -0,0 +1,207 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+	"strings"
+)
+
+// FeatCatalog holds the names feat prerequisites and restrictions refer to
+type FeatCatalog struct {
+	Skills     map[uint]string
+	Ancestries map[string]bool
+	Classes    map[string]bool
+	Archetypes map[uint]*model.Archetype
+}
+
+// NewFeatCatalog builds the catalog from skills, ancestry and class names, names are matched case-insensitively
+func NewFeatCatalog(skills []*model.Skill, ancestries []string, classes []string) *FeatCatalog {
+	catalog := &FeatCatalog{
+		Skills:     map[uint]string{},
+		Ancestries: map[string]bool{},
+		Classes:    map[string]bool{},
+		Archetypes: map[uint]*model.Archetype{},
+	}
+	for _, skill := range skills {
+		catalog.Skills[skill.ID] = skill.Name
+	}
+	for _, name := range ancestries {
+		catalog.Ancestries[strings.ToLower(name)] = true
+	}
+	for _, name := range classes {
+		catalog.Classes[strings.ToLower(name)] = true
+	}
+	return catalog
+}
+
+// UnmetFeatPrerequisites returns every prerequisite of the feat the character doesn't meet, a feat linked
+// to an ancestry or class or with its trait is restricted to characters of that ancestry or class
+func UnmetFeatPrerequisites(character *model.Character, feat *model.Feat, catalog *FeatCatalog) []string {
+	unmet := []string{}
+	taken := map[uint]bool{}
+	for _, characterFeat := range character.CharacterFeat {
+		taken[characterFeat.FeatID] = true
+	}
+	if taken[feat.ID] {
+		unmet = append(unmet, fmt.Sprintf("feat %s is already taken", feat.Name))
+	}
+	if int8(feat.Level) > character.Level {
+		unmet = append(unmet, fmt.Sprintf("requires level %d", feat.Level))
+	}
+	if feat.PrerequisiteSkillID != nil && MasteryRank(feat.PrerequisiteMastery) > 0 {
+		name := catalog.Skills[*feat.PrerequisiteSkillID]
+		if MasteryRank(skillMastery(character, name)) < MasteryRank(feat.PrerequisiteMastery) {
+			unmet = append(unmet, fmt.Sprintf("requires %s mastery in %s", feat.PrerequisiteMastery, name))
+		}
+	}
+	for _, prerequisite := range feat.PrerequisiteFeats {
+		if !taken[prerequisite.ID] {
+			unmet = append(unmet, fmt.Sprintf("requires feat %s", prerequisite.Name))
+		}
+	}
+
+	var ancestries, classes []string
+	for _, trait := range feat.Traits {
+		name := strings.ToLower(trait.Name)
+		if catalog.Ancestries[name] {
+			ancestries = append(ancestries, trait.Name)
+		}
+		if catalog.Classes[name] {
+			classes = append(classes, trait.Name)
+		}
+	}
+	if feat.Race != nil && !containsName(ancestries, feat.Race.Name) {
+		ancestries = append(ancestries, feat.Race.Name)
+	}
+	if feat.CharacterClass != nil && !containsName(classes, feat.CharacterClass.Name) {
+		classes = append(classes, feat.CharacterClass.Name)
+	}
+	if len(ancestries) > 0 && !containsName(ancestries, character.Race.Name) {
+		unmet = append(unmet, fmt.Sprintf("requires ancestry %s", strings.Join(ancestries, " or ")))
+	}
+	if len(classes) > 0 && !containsName(classes, character.CharacterClass.Name) {
+		unmet = append(unmet, fmt.Sprintf("requires class %s", strings.Join(classes, " or ")))
+	}
+	return unmet
+}
+
+// UnmetFeatRules returns the unmet prerequisites and the dedication rules the feat breaks, taken are
+// the feats the character already has
+func UnmetFeatRules(character *model.Character, feat *model.Feat, catalog *FeatCatalog, taken []model.Feat) []string {
+	unmet := UnmetFeatPrerequisites(character, feat, catalog)
+	if feat.ArchetypeID != nil {
+		unmet = append(unmet, UnmetArchetypeRules(character, feat, catalog.Archetypes[*feat.ArchetypeID], taken)...)
+	}
+	return unmet
+}
+
+// SlotCategories lists the feat categories each feat slot accepts, archetype feats take class feat slots
+// and skill feats are general feats too
+var SlotCategories = map[model.FeatCategory][]model.FeatCategory{
+	model.AncestryFeat: {model.AncestryFeat},
+	model.ClassFeat:    {model.ClassFeat, model.ArchetypeFeat},
+	model.SkillFeat:    {model.SkillFeat},
+	model.GeneralFeat:  {model.GeneralFeat, model.SkillFeat},
+}
+
+// FeatSlots returns the feat slots granted up to the level by class features keyed by slot category
+func FeatSlots(level int8, features []model.ClassFeature) map[model.FeatCategory]int {
+	slots := map[model.FeatCategory]int{}
+	for _, feature := range features {
+		if int8(feature.Level) > level {
+			continue
+		}
+		for _, slot := range GrantedFeatSlots(&feature) {
+			slots[slot]++
+		}
+	}
+	return slots
+}
+
+// GrantedFeatSlots returns the slot categories a class feature grants
+func GrantedFeatSlots(feature *model.ClassFeature) []model.FeatCategory {
+	var slots []model.FeatCategory
+	if feature.IsAncestryFeat {
+		slots = append(slots, model.AncestryFeat)
+	}
+	if feature.IsClassFeat {
+		slots = append(slots, model.ClassFeat)
+	}
+	if feature.IsSkillFeat {
+		slots = append(slots, model.SkillFeat)
+	}
+	if feature.IsGeneralFeat {
+		slots = append(slots, model.GeneralFeat)
+	}
+	return slots
+}
+
+// FreeFeatSlots returns the slots still open after the taken feats fill them, the background feat
+// doesn't take a slot and a feat goes to the first slot accepting its category
+func FreeFeatSlots(character *model.Character, features []model.ClassFeature, taken []model.Feat) map[model.FeatCategory]int {
+	free := FeatSlots(character.Level, features)
+	for _, feat := range taken {
+		if character.Background.FeatID != nil && *character.Background.FeatID == feat.ID {
+			continue
+		}
+		if slot, ok := SlotFor(free, feat.Category); ok {
+			free[slot]--
+		}
+	}
+	return free
+}
+
+// SlotFor returns a free slot accepting the feat category, preferring the slot of the same category
+func SlotFor(free map[model.FeatCategory]int, category model.FeatCategory) (model.FeatCategory, bool) {
+	if category == "" {
+		category = model.GeneralFeat
+	}
+	for _, slot := range []model.FeatCategory{category, model.ClassFeat, model.GeneralFeat} {
+		if free[slot] > 0 && SlotAccepts(slot, category) {
+			return slot, true
+		}
+	}
+	return "", false
+}
+
+// SlotAccepts reports whether a feat of the category can be taken in the slot
+func SlotAccepts(slot model.FeatCategory, category model.FeatCategory) bool {
+	if category == "" {
+		category = model.GeneralFeat
+	}
+	for _, accepted := range SlotCategories[slot] {
+		if accepted == category {
+			return true
+		}
+	}
+	return false
+}
+
+// PrerequisiteFeatNames splits the free-text prerequisite into candidate feat names
+func PrerequisiteFeatNames(text string) []string {
+	var names []string
+	for _, part := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ';' }) {
+		if name := strings.TrimSpace(part); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func skillMastery(character *model.Character, name string) model.MasteryLevel {
+	for _, skill := range character.CharacterSkill {
+		if strings.EqualFold(skill.Name, name) {
+			return skill.Mastery
+		}
+	}
+	return model.None
+}
+
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
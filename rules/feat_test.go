@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestUnmetFeatPrerequisites(t *testing.T) {
+	skillID := uint(1)
+	catalog := NewFeatCatalog([]*model.Skill{{ID: skillID, Name: "Athletics"}}, []string{"Elf", "Dwarf"}, []string{"Fighter"})
+	character := &model.Character{
+		Level:          2,
+		Race:           model.Race{Name: "Dwarf"},
+		CharacterClass: model.CharacterClass{Name: "Wizard"},
+		CharacterSkill: []model.CharacterSkill{{Name: "Athletics", Mastery: model.Train}},
+		CharacterFeat:  []model.CharacterFeat{{FeatID: 10}},
+	}
+
+	feat := &model.Feat{ID: 20, Name: "Plain", Level: 1}
+	assert.Empty(t, UnmetFeatPrerequisites(character, feat, catalog))
+
+	feat = &model.Feat{
+		ID:                  21,
+		Name:                "Everything",
+		Level:               4,
+		PrerequisiteSkillID: &skillID,
+		PrerequisiteMastery: model.Expert,
+		PrerequisiteFeats:   []model.Feat{{ID: 10, Name: "Taken"}, {ID: 11, Name: "Missing"}},
+		Traits:              []model.Trait{{Name: "elf"}, {Name: "Fighter"}},
+	}
+	assert.Equal(t, []string{
+		"requires level 4",
+		"requires Expert mastery in Athletics",
+		"requires feat Missing",
+		"requires ancestry elf",
+		"requires class Fighter",
+	}, UnmetFeatPrerequisites(character, feat, catalog))
+
+	feat = &model.Feat{ID: 10, Name: "Taken", Traits: []model.Trait{{Name: "Dwarf"}, {Name: "General"}}}
+	assert.Equal(t, []string{"feat Taken is already taken"}, UnmetFeatPrerequisites(character, feat, catalog))
+}
+
+func TestFeatSlots(t *testing.T) {
+	features := []model.ClassFeature{
+		{Level: 1, IsClassFeat: true, IsAncestryFeat: true},
+		{Level: 2, IsClassFeat: true, IsSkillFeat: true},
+		{Level: 3, IsGeneralFeat: true},
+	}
+	assert.Equal(t, map[model.FeatCategory]int{model.ClassFeat: 2, model.AncestryFeat: 1, model.SkillFeat: 1},
+		FeatSlots(2, features))
+
+	featID := uint(1)
+	character := &model.Character{Level: 3, Background: model.Background{FeatID: &featID}}
+	taken := []model.Feat{
+		{ID: 1, Category: model.SkillFeat},
+		{ID: 2, Category: model.ArchetypeFeat},
+		{ID: 3, Category: model.SkillFeat},
+		{ID: 4, Category: model.SkillFeat},
+	}
+	assert.Equal(t, map[model.FeatCategory]int{
+		model.ClassFeat:    1,
+		model.AncestryFeat: 1,
+		model.SkillFeat:    0,
+		model.GeneralFeat:  0,
+	}, FreeFeatSlots(character, features, taken))
+}
+
+func TestSlotFor(t *testing.T) {
+	free := map[model.FeatCategory]int{model.ClassFeat: 1, model.GeneralFeat: 1}
+	slot, ok := SlotFor(free, model.ArchetypeFeat)
+	assert.True(t, ok)
+	assert.Equal(t, model.ClassFeat, slot)
+	slot, ok = SlotFor(free, model.SkillFeat)
+	assert.True(t, ok)
+	assert.Equal(t, model.GeneralFeat, slot)
+	_, ok = SlotFor(free, model.AncestryFeat)
+	assert.False(t, ok)
+	assert.True(t, SlotAccepts(model.GeneralFeat, ""))
+	assert.False(t, SlotAccepts(model.SkillFeat, model.GeneralFeat))
+}
+
+func TestLinkedFeatRestrictions(t *testing.T) {
+	catalog := NewFeatCatalog(nil, nil, nil)
+	character := &model.Character{Level: 1, Race: model.Race{Name: "Elf"}, CharacterClass: model.CharacterClass{Name: "Fighter"}}
+	feat := &model.Feat{
+		Name:           "Linked",
+		Level:          1,
+		Race:           &model.Race{Name: "Dwarf"},
+		CharacterClass: &model.CharacterClass{Name: "Fighter"},
+	}
+	assert.Equal(t, []string{"requires ancestry Dwarf"}, UnmetFeatPrerequisites(character, feat, catalog))
+}
+
+func TestPrerequisiteFeatNames(t *testing.T) {
+	assert.Equal(t, []string{"Power Attack", "Sudden Charge"}, PrerequisiteFeatNames(" Power Attack, Sudden Charge;"))
+	assert.Empty(t, PrerequisiteFeatNames(""))
+}
@@ -0,0 +1,98 @@
+package rules
+
+import "kingdom/model"
+
+// DyingDeath is the dying value at which the character dies
+const DyingDeath = 4
+
+// IsDead reports whether the character has died
+func IsDead(defence *model.CharacterDefence) bool {
+	return defence.Dying >= DyingDeath
+}
+
+// ApplyDamage spends temporary hit points first, knocks the character out at 0 hit points
+// and kills it outright on damage of at least double its maximum hit points
+func ApplyDamage(defence *model.CharacterDefence, amount uint16, critical bool) {
+	if IsDead(defence) {
+		return
+	}
+	if uint32(amount) >= 2*uint32(defence.MaxHitPoint) {
+		defence.HitPoint = 0
+		defence.TemporaryHitPoint = 0
+		defence.Dying = DyingDeath
+		return
+	}
+
+	absorbed := min(amount, defence.TemporaryHitPoint)
+	defence.TemporaryHitPoint -= absorbed
+	amount -= absorbed
+	if amount == 0 {
+		return
+	}
+
+	increase := uint8(1)
+	if critical {
+		increase = 2
+	}
+	if defence.HitPoint == 0 {
+		addDying(defence, increase)
+		return
+	}
+	if amount < defence.HitPoint {
+		defence.HitPoint -= amount
+		return
+	}
+	defence.HitPoint = 0
+	addDying(defence, increase+defence.Wounded)
+}
+
+// Heal restores hit points up to the maximum, a dying character recovers and becomes more wounded
+func Heal(defence *model.CharacterDefence, amount uint16) {
+	if IsDead(defence) || amount == 0 {
+		return
+	}
+	defence.HitPoint = uint16(min(uint32(defence.HitPoint)+uint32(amount), uint32(defence.MaxHitPoint)))
+	if defence.Dying > 0 {
+		recoverFromDying(defence)
+	}
+}
+
+// RecoveryCheckDC returns the DC of the flat recovery check of a dying character
+func RecoveryCheckDC(defence *model.CharacterDefence) int {
+	return 10 + int(defence.Dying)
+}
+
+// RecoveryCheck applies the result of the recovery check roll to a dying character
+func RecoveryCheck(defence *model.CharacterDefence, roll int) {
+	if defence.Dying == 0 || IsDead(defence) {
+		return
+	}
+	dc := RecoveryCheckDC(defence)
+	switch {
+	case roll == 20 || roll >= dc+10:
+		reduceDying(defence, 2)
+	case roll == 1 || roll <= dc-10:
+		addDying(defence, 2)
+	case roll >= dc:
+		reduceDying(defence, 1)
+	default:
+		addDying(defence, 1)
+	}
+}
+
+func addDying(defence *model.CharacterDefence, value uint8) {
+	defence.Dying = min(defence.Dying+value, DyingDeath)
+}
+
+func reduceDying(defence *model.CharacterDefence, value uint8) {
+	if value >= defence.Dying {
+		recoverFromDying(defence)
+		return
+	}
+	defence.Dying -= value
+}
+
+func recoverFromDying(defence *model.CharacterDefence) {
+	defence.Dying = 0
+	defence.Wounded++
+}
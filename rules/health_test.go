@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestApplyDamage(t *testing.T) {
+	defence := &model.CharacterDefence{MaxHitPoint: 20, HitPoint: 20, TemporaryHitPoint: 5}
+	ApplyDamage(defence, 8, false)
+	assert.Equal(t, uint16(0), defence.TemporaryHitPoint)
+	assert.Equal(t, uint16(17), defence.HitPoint)
+
+	defence.Wounded = 1
+	ApplyDamage(defence, 30, false)
+	assert.Equal(t, uint16(0), defence.HitPoint)
+	assert.Equal(t, uint8(2), defence.Dying)
+	assert.False(t, IsDead(defence))
+
+	ApplyDamage(defence, 1, true)
+	assert.Equal(t, uint8(4), defence.Dying)
+	assert.True(t, IsDead(defence))
+
+	defence = &model.CharacterDefence{MaxHitPoint: 20, HitPoint: 20}
+	ApplyDamage(defence, 20, true)
+	assert.Equal(t, uint8(2), defence.Dying)
+
+	defence = &model.CharacterDefence{MaxHitPoint: 20, HitPoint: 20, TemporaryHitPoint: 10}
+	ApplyDamage(defence, 40, false)
+	assert.True(t, IsDead(defence))
+}
+
+func TestHeal(t *testing.T) {
+	defence := &model.CharacterDefence{MaxHitPoint: 20, HitPoint: 15}
+	Heal(defence, 10)
+	assert.Equal(t, uint16(20), defence.HitPoint)
+	assert.Equal(t, uint8(0), defence.Wounded)
+
+	defence = &model.CharacterDefence{MaxHitPoint: 20, Dying: 2, Wounded: 1}
+	Heal(defence, 5)
+	assert.Equal(t, uint16(5), defence.HitPoint)
+	assert.Equal(t, uint8(0), defence.Dying)
+	assert.Equal(t, uint8(2), defence.Wounded)
+
+	defence = &model.CharacterDefence{MaxHitPoint: 20, Dying: DyingDeath}
+	Heal(defence, 5)
+	assert.Equal(t, uint16(0), defence.HitPoint)
+}
+
+func TestRecoveryCheck(t *testing.T) {
+	defence := &model.CharacterDefence{Dying: 2}
+	assert.Equal(t, 12, RecoveryCheckDC(defence))
+	RecoveryCheck(defence, 12)
+	assert.Equal(t, uint8(1), defence.Dying)
+	RecoveryCheck(defence, 10)
+	assert.Equal(t, uint8(2), defence.Dying)
+	RecoveryCheck(defence, 1)
+	assert.Equal(t, uint8(4), defence.Dying)
+	assert.True(t, IsDead(defence))
+
+	defence = &model.CharacterDefence{Dying: 1}
+	RecoveryCheck(defence, 20)
+	assert.Equal(t, uint8(0), defence.Dying)
+	assert.Equal(t, uint8(1), defence.Wounded)
+	assert.Equal(t, uint16(0), defence.HitPoint)
+}
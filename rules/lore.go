@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// LoreAbility is the attribute every Lore skill is tied to
+const LoreAbility = model.Intelligence
+
+const loreSuffix = " Lore"
+
+var loreTopicPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z' -]{0,59}$`)
+
+// RecallKnowledgeSkills are the skills besides Lore used to Recall Knowledge
+var RecallKnowledgeSkills = []string{"Arcana", "Crafting", "Medicine", "Nature", "Occultism", "Religion", "Society"}
+
+// IsLore reports whether the skill name is a Lore skill
+func IsLore(name string) bool {
+	return len(name) > len(loreSuffix) && strings.EqualFold(name[len(name)-len(loreSuffix):], loreSuffix)
+}
+
+// LoreName validates the Lore topic and returns the skill name, "sailing" and "Sailing lore" both give "Sailing Lore"
+func LoreName(topic string) (string, error) {
+	topic = strings.TrimSpace(topic)
+	if IsLore(topic) {
+		topic = strings.TrimSpace(topic[:len(topic)-len(loreSuffix)])
+	} else if strings.EqualFold(topic, strings.TrimSpace(loreSuffix)) {
+		topic = ""
+	}
+	if topic == "" {
+		return "", fmt.Errorf("lore topic is required")
+	}
+	if !loreTopicPattern.MatchString(topic) {
+		return "", fmt.Errorf("lore topic %q may only contain letters, spaces, apostrophes and hyphens", topic)
+	}
+	words := strings.Fields(topic)
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ") + loreSuffix, nil
+}
+
+// IsRecallKnowledgeSkill reports whether the skill can be used to Recall Knowledge
+func IsRecallKnowledgeSkill(name string) bool {
+	return IsLore(name) || containsName(RecallKnowledgeSkills, name)
+}
@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestLoreName(t *testing.T) {
+	for _, topic := range []string{"sailing", "Sailing Lore", "  sailing lore "} {
+		name, err := LoreName(topic)
+		assert.NoError(t, err)
+		assert.Equal(t, "Sailing Lore", name)
+	}
+	name, err := LoreName("pathfinder society")
+	assert.NoError(t, err)
+	assert.Equal(t, "Pathfinder Society Lore", name)
+
+	_, err = LoreName(" Lore")
+	assert.EqualError(t, err, "lore topic is required")
+	_, err = LoreName("Sailing; DROP")
+	assert.Error(t, err)
+
+	assert.True(t, IsLore("Warfare Lore"))
+	assert.False(t, IsLore("Lore"))
+	assert.False(t, IsLore("Arcana"))
+}
+
+func TestLoreSkill(t *testing.T) {
+	assert.True(t, IsRecallKnowledgeSkill("Sailing Lore"))
+	assert.True(t, IsRecallKnowledgeSkill("arcana"))
+	assert.False(t, IsRecallKnowledgeSkill("Athletics"))
+
+	sheet := &Sheet{
+		Level:     3,
+		Attribute: model.Attribute{Intelligence: 14},
+		Skills:    []model.CharacterSkill{{Name: "Sailing Lore", Mastery: model.Train, Lore: true}},
+	}
+	stats := Compute(sheet)
+	assert.Equal(t, 7, stats.Skills[0].Value)
+	assert.Equal(t, string(model.Intelligence), stats.Skills[0].Modifiers[0].Source)
+
+	lore := uint(1)
+	assert.Equal(t, 2, BackgroundSkills(&model.Background{FirstSkillID: &lore, LoreSkill: "Sailing Lore"}))
+}
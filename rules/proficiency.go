@@ -0,0 +1,53 @@
+package rules
+
+import "kingdom/model"
+
+var masteryRank = map[model.MasteryLevel]int{
+	model.None:      0,
+	model.Train:     1,
+	model.Expert:    2,
+	model.Master:    3,
+	model.Legendary: 4,
+}
+
+// MasteryRank returns the numeric rank of a proficiency, untrained being 0 and legendary 4
+func MasteryRank(mastery model.MasteryLevel) int {
+	return masteryRank[mastery]
+}
+
+// ProficiencyBonus returns the proficiency bonus for the mastery at the given character level
+func ProficiencyBonus(mastery model.MasteryLevel, level int8) int {
+	rank := MasteryRank(mastery)
+	if rank == 0 {
+		return 0
+	}
+	return int(level) + 2*rank
+}
+
+// AttributeModifier returns the modifier for a raw attribute score
+func AttributeModifier(score uint8) int {
+	value := int(score) - 10
+	if value < 0 {
+		return (value - 1) / 2
+	}
+	return value / 2
+}
+
+// AttributeScore returns the score of the ability in the attribute set
+func AttributeScore(attribute *model.Attribute, ability model.Ability) uint8 {
+	switch ability {
+	case model.Strength:
+		return attribute.Strength
+	case model.Dexterity:
+		return attribute.Dexterity
+	case model.Constitution:
+		return attribute.Constitution
+	case model.Intelligence:
+		return attribute.Intelligence
+	case model.Wisdom:
+		return attribute.Wisdom
+	case model.Charisma:
+		return attribute.Charisma
+	}
+	return 10
+}
@@ -0,0 +1,140 @@
+package rules
+
+import "kingdom/model"
+
+// MaxLevel is the highest level a character can reach
+const MaxLevel = 20
+
+// BoostCount is the number of attribute boosts granted at once by a class feature
+const BoostCount = 4
+
+var masteryByRank = []model.MasteryLevel{model.None, model.Train, model.Expert, model.Master, model.Legendary}
+
+// NextMastery returns the mastery one rank above, legendary stays legendary
+func NextMastery(mastery model.MasteryLevel) model.MasteryLevel {
+	rank := MasteryRank(mastery)
+	if rank+1 >= len(masteryByRank) {
+		return model.Legendary
+	}
+	return masteryByRank[rank+1]
+}
+
+// LevelHitPoints returns hit points gained for one level of the class
+func LevelHitPoints(classHitPoint uint16, constitution uint8) uint16 {
+	hitPoint := int(classHitPoint) + AttributeModifier(constitution)
+	if hitPoint < 1 {
+		return 1
+	}
+	return uint16(hitPoint)
+}
+
+// BoostAttribute returns the score after one boost, boosts above 18 are partial
+func BoostAttribute(score uint8) uint8 {
+	if score >= 18 {
+		return score + 1
+	}
+	return score + 2
+}
+
+// AttributeRef returns a pointer to the score of the ability
+func AttributeRef(attribute *model.Attribute, ability model.Ability) *uint8 {
+	switch ability {
+	case model.Strength:
+		return &attribute.Strength
+	case model.Dexterity:
+		return &attribute.Dexterity
+	case model.Constitution:
+		return &attribute.Constitution
+	case model.Intelligence:
+		return &attribute.Intelligence
+	case model.Wisdom:
+		return &attribute.Wisdom
+	case model.Charisma:
+		return &attribute.Charisma
+	}
+	return nil
+}
+
+// DefenceMasteries returns the masteries of the defence keyed by column name
+func DefenceMasteries(defence *model.CharacterDefence) map[string]*model.MasteryLevel {
+	return map[string]*model.MasteryLevel{
+		"perception":      &defence.Perception,
+		"fortitude":       &defence.Fortitude,
+		"reflex":          &defence.Reflex,
+		"will":            &defence.Will,
+		"unarmed":         &defence.Unarmed,
+		"light_armor":     &defence.LightArmor,
+		"medium_armor":    &defence.MediumArmor,
+		"heavy_armor":     &defence.HeavyArmor,
+		"un_armed_weapon": &defence.UnArmedWeapon,
+		"common_weapon":   &defence.CommonWeapon,
+		"martial_weapon":  &defence.MartialWeapon,
+	}
+}
+
+// MasteryUpgrades returns the defence masteries raised by the class features
+func MasteryUpgrades(defence *model.CharacterDefence, features []model.ClassFeature) []model.MasteryUpgrade {
+	masteries := DefenceMasteries(defence)
+	targets := map[string]model.MasteryLevel{}
+	raise := func(target string, mastery *model.MasteryLevel, onlyTrained bool) {
+		if mastery == nil {
+			return
+		}
+		current := *masteries[target]
+		if onlyTrained && MasteryRank(current) == 0 {
+			return
+		}
+		if MasteryRank(*mastery) > MasteryRank(current) && MasteryRank(*mastery) > MasteryRank(targets[target]) {
+			targets[target] = *mastery
+		}
+	}
+	for _, feature := range features {
+		raise("perception", feature.PerceptionMastery, false)
+		raise("fortitude", feature.FortitudeMastery, false)
+		raise("reflex", feature.ReflexMastery, false)
+		raise("will", feature.WillMastery, false)
+		for _, target := range []string{"unarmed", "light_armor", "medium_armor", "heavy_armor"} {
+			raise(target, feature.ArmorMastery, true)
+		}
+		for _, target := range []string{"un_armed_weapon", "common_weapon", "martial_weapon"} {
+			raise(target, feature.WeaponMastery, true)
+		}
+	}
+
+	var upgrades []model.MasteryUpgrade
+	for _, target := range []string{
+		"perception", "fortitude", "reflex", "will",
+		"unarmed", "light_armor", "medium_armor", "heavy_armor",
+		"un_armed_weapon", "common_weapon", "martial_weapon",
+	} {
+		if to, ok := targets[target]; ok {
+			upgrades = append(upgrades, model.MasteryUpgrade{Target: target, From: *masteries[target], To: to})
+		}
+	}
+	return upgrades
+}
+
+// LevelUpChoices returns what the character gains and must choose when reaching the next level
+func LevelUpChoices(
+	character *model.Character,
+	characterClass *model.CharacterClass,
+	features []model.ClassFeature,
+) *model.LevelUpChoices {
+	choices := &model.LevelUpChoices{
+		CharacterID:     character.ID,
+		Level:           character.Level + 1,
+		HitPoint:        LevelHitPoints(characterClass.HitPoint, character.Attribute.Constitution),
+		MasteryUpgrades: MasteryUpgrades(&character.CharacterDefence, features),
+	}
+	for _, feature := range features {
+		choices.ClassFeat = choices.ClassFeat || feature.IsClassFeat
+		choices.SkillFeat = choices.SkillFeat || feature.IsSkillFeat
+		choices.GeneralFeat = choices.GeneralFeat || feature.IsGeneralFeat
+		choices.AncestryFeat = choices.AncestryFeat || feature.IsAncestryFeat
+		choices.SkillIncrease = choices.SkillIncrease || feature.IsSkillIncrease
+		if feature.IsCharacterBoost {
+			choices.AttributeBoosts = BoostCount
+		}
+	}
+	return choices
+}
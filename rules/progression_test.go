@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestNextMastery(t *testing.T) {
+	assert.Equal(t, model.Train, NextMastery(model.None))
+	assert.Equal(t, model.Master, NextMastery(model.Expert))
+	assert.Equal(t, model.Legendary, NextMastery(model.Legendary))
+}
+
+func TestBoostAttribute(t *testing.T) {
+	assert.Equal(t, uint8(12), BoostAttribute(10))
+	assert.Equal(t, uint8(18), BoostAttribute(16))
+	assert.Equal(t, uint8(19), BoostAttribute(18))
+}
+
+func TestLevelUpChoices(t *testing.T) {
+	expert := model.Expert
+	character := &model.Character{
+		ID:        1,
+		Level:     4,
+		Attribute: model.Attribute{Constitution: 14},
+		CharacterDefence: model.CharacterDefence{
+			Fortitude:   model.Train,
+			Reflex:      model.Expert,
+			LightArmor:  model.Train,
+			MediumArmor: model.None,
+		},
+	}
+	features := []model.ClassFeature{
+		{Level: 5, IsCharacterBoost: true, IsAncestryFeat: true, ReflexMastery: &expert},
+		{Level: 5, IsSkillIncrease: true, FortitudeMastery: &expert, ArmorMastery: &expert},
+	}
+
+	choices := LevelUpChoices(character, &model.CharacterClass{HitPoint: 10}, features)
+	assert.Equal(t, int8(5), choices.Level)
+	assert.Equal(t, uint16(12), choices.HitPoint)
+	assert.True(t, choices.AncestryFeat)
+	assert.True(t, choices.SkillIncrease)
+	assert.False(t, choices.ClassFeat)
+	assert.Equal(t, uint8(BoostCount), choices.AttributeBoosts)
+	assert.Equal(t, []model.MasteryUpgrade{
+		{Target: "fortitude", From: model.Train, To: model.Expert},
+		{Target: "light_armor", From: model.Train, To: model.Expert},
+	}, choices.MasteryUpgrades)
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// MaxRuneGrade is the highest grade of the potency, striking and resilient runes
+const MaxRuneGrade = 3
+
+const weaponOwnerType = "weapons"
+
+// ValidateRunes returns the reason the runes can't be on the item, potency and striking runes go on weapons,
+// potency and resilient runes on armor, every property rune needs a slot from the potency rune
+// and runes are etched on a single item rather than a stack
+func ValidateRunes(item *model.CharacterItem) error {
+	ownerType := item.Item.OwnerType
+	hasRunes := item.Potency > 0 || item.Striking > 0 || item.Resilient > 0 || len(item.Runes) > 0
+	if ownerType != weaponOwnerType && ownerType != armorOwnerType {
+		if hasRunes {
+			return fmt.Errorf("%s can't hold runes", item.Item.Name)
+		}
+		return nil
+	}
+	if hasRunes && item.Quantity > 1 {
+		return fmt.Errorf("runes go on a single %s, not on a stack", item.Item.Name)
+	}
+	for _, grade := range []struct {
+		name  string
+		value uint8
+	}{{"potency", item.Potency}, {"striking", item.Striking}, {"resilient", item.Resilient}} {
+		if grade.value > MaxRuneGrade {
+			return fmt.Errorf("%s rune grade is at most %d", grade.name, MaxRuneGrade)
+		}
+	}
+	if ownerType == weaponOwnerType && item.Resilient > 0 {
+		return fmt.Errorf("resilient runes go on armor")
+	}
+	if ownerType == armorOwnerType && item.Striking > 0 {
+		return fmt.Errorf("striking runes go on weapons")
+	}
+	if len(item.Runes) > int(item.Potency) {
+		return fmt.Errorf("%s has %d property rune slots from its potency rune", item.Item.Name, item.Potency)
+	}
+	etched := map[uint]bool{}
+	for _, propertyRune := range item.Runes {
+		if propertyRune.Target != ownerType {
+			return fmt.Errorf("%s rune can't be etched on %s", propertyRune.Name, item.Item.Name)
+		}
+		if etched[propertyRune.ID] {
+			return fmt.Errorf("%s rune is already etched on %s", propertyRune.Name, item.Item.Name)
+		}
+		etched[propertyRune.ID] = true
+	}
+	return nil
+}
+
+// TransferRunes moves the chosen runes to another item of the same type, a fundamental rune only moves
+// to an item without one and both items must stay valid
+func TransferRunes(from *model.CharacterItem, to *model.CharacterItem, transfer *model.TransferRunes) error {
+	if from.ID == to.ID {
+		return fmt.Errorf("runes can't be transferred to the same item")
+	}
+	if from.Item.OwnerType != to.Item.OwnerType {
+		return fmt.Errorf("runes move only between items of the same type")
+	}
+	if !transfer.Potency && !transfer.Striking && !transfer.Resilient && len(transfer.RunesID) == 0 {
+		return fmt.Errorf("no rune to transfer")
+	}
+	for _, grade := range []struct {
+		name     string
+		chosen   bool
+		from, to *uint8
+	}{
+		{"potency", transfer.Potency, &from.Potency, &to.Potency},
+		{"striking", transfer.Striking, &from.Striking, &to.Striking},
+		{"resilient", transfer.Resilient, &from.Resilient, &to.Resilient},
+	} {
+		if !grade.chosen {
+			continue
+		}
+		if *grade.from == 0 {
+			return fmt.Errorf("%s has no %s rune", from.Item.Name, grade.name)
+		}
+		if *grade.to > 0 {
+			return fmt.Errorf("%s already has a %s rune", to.Item.Name, grade.name)
+		}
+		*grade.to, *grade.from = *grade.from, 0
+	}
+	for _, id := range transfer.RunesID {
+		index := -1
+		for i, propertyRune := range from.Runes {
+			if propertyRune.ID == id {
+				index = i
+			}
+		}
+		if index < 0 {
+			return fmt.Errorf("rune %d isn't etched on %s", id, from.Item.Name)
+		}
+		to.Runes = append(to.Runes, from.Runes[index])
+		from.Runes = append(from.Runes[:index:index], from.Runes[index+1:]...)
+	}
+	if err := ValidateRunes(from); err != nil {
+		return err
+	}
+	return ValidateRunes(to)
+}
+
+// PlainItem tells if the item has no runes, charges or damage of its own, so more of the item can stack on it
+func PlainItem(item *model.CharacterItem) bool {
+	return item.Potency == 0 && item.Striking == 0 && item.Resilient == 0 && len(item.Runes) == 0 &&
+		item.Charges == nil && item.HitPoint == nil
+}
+
+// RuneDamage returns the extra damage of the property runes, like 1d6 fire
+func RuneDamage(runes []model.Rune) []string {
+	damage := []string{}
+	for _, propertyRune := range runes {
+		if propertyRune.DamageDice == 0 {
+			continue
+		}
+		extra := fmt.Sprintf("1d%d", propertyRune.DamageDice)
+		if propertyRune.DamageType != "" {
+			extra += " " + propertyRune.DamageType
+		}
+		damage = append(damage, extra)
+	}
+	return damage
+}
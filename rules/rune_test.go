@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func runeItem(id uint, ownerType string) *model.CharacterItem {
+	return &model.CharacterItem{ID: id, Item: model.Item{Name: "Item", OwnerType: ownerType}}
+}
+
+func TestValidateRunes(t *testing.T) {
+	flaming := model.Rune{ID: 1, Name: "Flaming", Target: "weapons"}
+	frost := model.Rune{ID: 2, Name: "Frost", Target: "weapons"}
+	fortification := model.Rune{ID: 3, Name: "Fortification", Target: "armors"}
+
+	sword := runeItem(1, "weapons")
+	sword.Potency, sword.Striking = 1, 1
+	sword.Runes = []model.Rune{flaming}
+	assert.NoError(t, ValidateRunes(sword))
+
+	sword.Runes = []model.Rune{flaming, frost}
+	assert.EqualError(t, ValidateRunes(sword), "Item has 1 property rune slots from its potency rune")
+	sword.Potency = 2
+	assert.NoError(t, ValidateRunes(sword))
+	sword.Runes = []model.Rune{flaming, flaming}
+	assert.EqualError(t, ValidateRunes(sword), "Flaming rune is already etched on Item")
+	sword.Runes = []model.Rune{fortification}
+	assert.EqualError(t, ValidateRunes(sword), "Fortification rune can't be etched on Item")
+	sword.Runes = nil
+	sword.Resilient = 1
+	assert.EqualError(t, ValidateRunes(sword), "resilient runes go on armor")
+	sword.Resilient, sword.Potency = 0, 4
+	assert.EqualError(t, ValidateRunes(sword), "potency rune grade is at most 3")
+	sword.Potency, sword.Quantity = 1, 2
+	assert.EqualError(t, ValidateRunes(sword), "runes go on a single Item, not on a stack")
+
+	armor := runeItem(2, "armors")
+	armor.Striking = 1
+	assert.EqualError(t, ValidateRunes(armor), "striking runes go on weapons")
+
+	rope := runeItem(3, "gears")
+	assert.NoError(t, ValidateRunes(rope))
+	rope.Potency = 1
+	assert.EqualError(t, ValidateRunes(rope), "Item can't hold runes")
+}
+
+func TestPlainItem(t *testing.T) {
+	sword := runeItem(1, "weapons")
+	assert.True(t, PlainItem(sword))
+	sword.Runes = []model.Rune{{ID: 1, Name: "Flaming", Target: "weapons"}}
+	assert.False(t, PlainItem(sword))
+
+	wand := runeItem(2, "consumables")
+	charges := uint8(0)
+	wand.Charges = &charges
+	assert.False(t, PlainItem(wand))
+
+	shield := runeItem(3, "shields")
+	hitPoint := uint16(12)
+	shield.HitPoint = &hitPoint
+	assert.False(t, PlainItem(shield))
+}
+
+func TestTransferRunes(t *testing.T) {
+	flaming := model.Rune{ID: 1, Name: "Flaming", Target: "weapons"}
+	from := runeItem(1, "weapons")
+	from.Potency, from.Striking = 1, 1
+	from.Runes = []model.Rune{flaming}
+	to := runeItem(2, "weapons")
+
+	assert.EqualError(t, TransferRunes(from, from, &model.TransferRunes{Potency: true}),
+		"runes can't be transferred to the same item")
+	assert.EqualError(t, TransferRunes(from, runeItem(3, "armors"), &model.TransferRunes{Potency: true}),
+		"runes move only between items of the same type")
+	assert.EqualError(t, TransferRunes(from, to, &model.TransferRunes{}), "no rune to transfer")
+	assert.EqualError(t, TransferRunes(from, to, &model.TransferRunes{Resilient: true}), "Item has no resilient rune")
+	assert.EqualError(t, TransferRunes(from, to, &model.TransferRunes{RunesID: []uint{7}}),
+		"rune 7 isn't etched on Item")
+
+	// the property rune needs the potency rune on the new item
+	from.Runes = []model.Rune{flaming}
+	to = runeItem(2, "weapons")
+	assert.EqualError(t, TransferRunes(from, to, &model.TransferRunes{RunesID: []uint{1}}),
+		"Item has 0 property rune slots from its potency rune")
+
+	from = runeItem(1, "weapons")
+	from.Potency, from.Striking = 1, 1
+	from.Runes = []model.Rune{flaming}
+	to = runeItem(2, "weapons")
+	assert.NoError(t, TransferRunes(from, to, &model.TransferRunes{Potency: true, Striking: true, RunesID: []uint{1}}))
+	assert.Equal(t, uint8(0), from.Potency)
+	assert.Empty(t, from.Runes)
+	assert.Equal(t, uint8(1), to.Potency)
+	assert.Equal(t, uint8(1), to.Striking)
+	assert.Equal(t, []model.Rune{flaming}, to.Runes)
+
+	assert.EqualError(t, TransferRunes(to, from, &model.TransferRunes{Potency: true}),
+		"Item has 0 property rune slots from its potency rune")
+}
+
+func TestRuneStrike(t *testing.T) {
+	sheet := strikeSheet()
+	longsword := &Weapon{Name: "Longsword", ItemBonus: 1, Striking: 1, Weapon: model.Weapon{
+		DiceQuantity: 1, Dice: 8, DamageType: "slashing", Category: model.MartialWeapon,
+	}, Runes: []model.Rune{{Name: "Flaming", DamageDice: 6, DamageType: "fire"}, {Name: "Shifting"}}}
+	strikes := Strikes(sheet, longsword)
+	assert.Equal(t, 3+5+1, strikes[0].Attacks[0].Value)
+	assert.Equal(t, "2d8+3 slashing", strikes[0].Damage)
+	assert.Equal(t, []string{"1d6 fire"}, strikes[0].ExtraDamage)
+	assert.Equal(t, uint8(1), longsword.Weapon.DiceQuantity)
+}
+
+func TestResilientSaves(t *testing.T) {
+	sheet := strikeSheet()
+	sheet.Defence.Fortitude = model.Train
+	fortitude := Compute(sheet).Fortitude.Value
+	sheet.Armor = &model.Armor{ArmorClass: 2}
+	sheet.ArmorPotency, sheet.Resilient = 1, 2
+	stats := Compute(sheet)
+	assert.Equal(t, fortitude+2, stats.Fortitude.Value)
+	assert.Contains(t, stats.Fortitude.Modifiers, model.Modifier{Source: SourceItem, Value: 2})
+}
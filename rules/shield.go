@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// SourceShield is the modifier source of a raised shield
+const SourceShield = "Shield"
+
+// ShieldBlockResult is how damage splits between a character and the shield it blocked with
+type ShieldBlockResult struct {
+	Prevented       uint16
+	CharacterDamage uint16
+	ShieldDamage    uint16
+	HitPoint        uint16
+	Broken          bool
+	Destroyed       bool
+}
+
+// ShieldHitPoint returns the current hit points of the shield item, an untracked shield is undamaged
+func ShieldHitPoint(shield *model.Shield, characterItem *model.CharacterItem) uint16 {
+	if characterItem.HitPoint == nil {
+		return shield.HitPoint
+	}
+	return *characterItem.HitPoint
+}
+
+// ShieldBroken reports whether the shield is at or below its Broken Threshold
+func ShieldBroken(shield *model.Shield, hitPoint uint16) bool {
+	return hitPoint <= shield.BrokenThreshold
+}
+
+// CanRaiseShield returns the reason the shield can't be raised, a broken or destroyed shield grants no bonus
+func CanRaiseShield(shield *model.Shield, hitPoint uint16) error {
+	switch {
+	case hitPoint == 0:
+		return fmt.Errorf("shield is destroyed")
+	case ShieldBroken(shield, hitPoint):
+		return fmt.Errorf("shield is broken")
+	}
+	return nil
+}
+
+// ShieldBlock reduces the damage by the shield Hardness, the character and the shield both take the rest
+func ShieldBlock(shield *model.Shield, hitPoint uint16, damage uint16) *ShieldBlockResult {
+	result := &ShieldBlockResult{Prevented: min(damage, uint16(shield.Hardness))}
+	result.CharacterDamage = damage - result.Prevented
+	result.ShieldDamage = min(result.CharacterDamage, hitPoint)
+	result.HitPoint = hitPoint - result.ShieldDamage
+	result.Broken = ShieldBroken(shield, result.HitPoint)
+	result.Destroyed = result.HitPoint == 0
+	return result
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestShieldBlock(t *testing.T) {
+	steelShield := &model.Shield{ArmorClass: 2, Hardness: 5, HitPoint: 20, BrokenThreshold: 10}
+
+	block := ShieldBlock(steelShield, 20, 3)
+	assert.Equal(t, &ShieldBlockResult{Prevented: 3, HitPoint: 20}, block)
+
+	block = ShieldBlock(steelShield, 20, 12)
+	assert.Equal(t, uint16(5), block.Prevented)
+	assert.Equal(t, uint16(7), block.CharacterDamage)
+	assert.Equal(t, uint16(13), block.HitPoint)
+	assert.False(t, block.Broken)
+
+	block = ShieldBlock(steelShield, 13, 30)
+	assert.Equal(t, uint16(25), block.CharacterDamage)
+	assert.Equal(t, uint16(13), block.ShieldDamage)
+	assert.True(t, block.Broken)
+	assert.True(t, block.Destroyed)
+}
+
+func TestCanRaiseShield(t *testing.T) {
+	woodenShield := &model.Shield{Hardness: 3, HitPoint: 12, BrokenThreshold: 6}
+	hitPoint := uint16(7)
+
+	assert.Equal(t, uint16(12), ShieldHitPoint(woodenShield, &model.CharacterItem{}))
+	assert.Equal(t, hitPoint, ShieldHitPoint(woodenShield, &model.CharacterItem{HitPoint: &hitPoint}))
+	assert.NoError(t, CanRaiseShield(woodenShield, 7))
+	assert.EqualError(t, CanRaiseShield(woodenShield, 6), "shield is broken")
+	assert.EqualError(t, CanRaiseShield(woodenShield, 0), "shield is destroyed")
+}
+
+func TestComputeRaisedShield(t *testing.T) {
+	sheet := &Sheet{
+		Level:     1,
+		Attribute: model.Attribute{Strength: 10, Dexterity: 10, Constitution: 10, Intelligence: 10, Wisdom: 10, Charisma: 10},
+		Defence:   model.CharacterDefence{Unarmed: model.Train},
+		Shield:    &model.Shield{ArmorClass: 2, Hardness: 5, HitPoint: 20, BrokenThreshold: 10},
+	}
+	assert.Equal(t, 13, Compute(sheet).ArmorClass.Value)
+
+	sheet.ShieldRaised = true
+	stats := Compute(sheet)
+	assert.Equal(t, 15, stats.ArmorClass.Value)
+	assert.Contains(t, stats.ArmorClass.Modifiers, model.Modifier{Source: SourceShield, Value: 2})
+}
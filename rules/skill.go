@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+// SkillMasteryLevel is the character level each skill mastery becomes available at
+var SkillMasteryLevel = map[model.MasteryLevel]int8{
+	model.None:      1,
+	model.Train:     1,
+	model.Expert:    3,
+	model.Master:    7,
+	model.Legendary: 15,
+}
+
+// SkillTrainings returns the skills trained at creation from the class and the Intelligence modifier
+func SkillTrainings(characterClass *model.CharacterClass, intelligence uint8) int {
+	trainings := int(characterClass.TrainedSkills) + AttributeModifier(intelligence)
+	if trainings < 0 {
+		return 0
+	}
+	return trainings
+}
+
+// BackgroundSkills returns the number of distinct skills the background trains, its Lore skill included
+func BackgroundSkills(background *model.Background) int {
+	count := 0
+	if background.LoreSkill != "" {
+		count++
+	}
+	if background.FirstSkillID != nil {
+		count++
+	}
+	if background.SecondSkillID != nil && (background.FirstSkillID == nil || *background.SecondSkillID != *background.FirstSkillID) {
+		count++
+	}
+	return count
+}
+
+// SkillIncreases returns the skill increases granted by class features up to the level
+func SkillIncreases(level int8, features []model.ClassFeature) int {
+	count := 0
+	for _, feature := range features {
+		if feature.IsSkillIncrease && int8(feature.Level) <= level {
+			count++
+		}
+	}
+	return count
+}
+
+// CheckSkillMasteryLevel returns an error when the character is too low level for the mastery
+func CheckSkillMasteryLevel(mastery model.MasteryLevel, level int8) error {
+	if required := SkillMasteryLevel[mastery]; level < required {
+		return fmt.Errorf("%s mastery requires level %d", mastery, required)
+	}
+	return nil
+}
+
+// ValidateSkillChange returns the reason the skill can't be set to the mastery, a skill goes up one rank
+// at a time and every rank is paid by a background skill, a creation training or a skill increase
+func ValidateSkillChange(
+	character *model.Character,
+	skill *model.CharacterSkill,
+	mastery model.MasteryLevel,
+	features []model.ClassFeature,
+) error {
+	if _, ok := masteryRank[mastery]; !ok {
+		return fmt.Errorf("unknown mastery %s", mastery)
+	}
+	old, next := MasteryRank(skill.Mastery), MasteryRank(mastery)
+	switch {
+	case next == old:
+		return nil
+	case next < old:
+		return fmt.Errorf("skill %s can't be lowered from %s to %s", skill.Name, skill.Mastery, mastery)
+	case next > old+1:
+		return fmt.Errorf("skill %s can only be raised one rank at a time, next is %s", skill.Name, NextMastery(skill.Mastery))
+	}
+	if err := CheckSkillMasteryLevel(mastery, character.Level); err != nil {
+		return err
+	}
+	used := 0
+	for _, characterSkill := range character.CharacterSkill {
+		if characterSkill.ID != skill.ID {
+			used += MasteryRank(characterSkill.Mastery)
+		}
+	}
+	used += next
+	allowed := BackgroundSkills(&character.Background) +
+		SkillTrainings(&character.CharacterClass, character.Attribute.Intelligence) +
+		SkillIncreases(character.Level, features)
+	if used > allowed {
+		return fmt.Errorf("no skill training or increase left, %d of %d ranks already spent", used-1, allowed)
+	}
+	return nil
+}
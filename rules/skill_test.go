@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestSkillTrainings(t *testing.T) {
+	class := &model.CharacterClass{TrainedSkills: 3}
+	assert.Equal(t, 5, SkillTrainings(class, 14))
+	assert.Equal(t, 2, SkillTrainings(class, 8))
+	assert.Equal(t, 0, SkillTrainings(&model.CharacterClass{}, 8))
+
+	first, second := uint(1), uint(2)
+	assert.Equal(t, 2, BackgroundSkills(&model.Background{FirstSkillID: &first, SecondSkillID: &second}))
+	assert.Equal(t, 1, BackgroundSkills(&model.Background{FirstSkillID: &first, SecondSkillID: &first}))
+	assert.Equal(t, 0, BackgroundSkills(&model.Background{}))
+
+	features := []model.ClassFeature{{Level: 3, IsSkillIncrease: true}, {Level: 4}, {Level: 5, IsSkillIncrease: true}}
+	assert.Equal(t, 0, SkillIncreases(2, features))
+	assert.Equal(t, 2, SkillIncreases(5, features))
+}
+
+func TestValidateSkillChange(t *testing.T) {
+	first := uint(1)
+	athletics := model.CharacterSkill{ID: 1, Name: "Athletics", Mastery: model.Train}
+	stealth := model.CharacterSkill{ID: 2, Name: "Stealth", Mastery: model.None}
+	arcana := model.CharacterSkill{ID: 3, Name: "Arcana", Mastery: model.None}
+	character := &model.Character{
+		Level:          1,
+		Background:     model.Background{FirstSkillID: &first},
+		CharacterClass: model.CharacterClass{TrainedSkills: 1},
+		Attribute:      model.Attribute{Intelligence: 10},
+		CharacterSkill: []model.CharacterSkill{athletics, stealth, arcana},
+	}
+	features := []model.ClassFeature{{Level: 3, IsSkillIncrease: true}}
+
+	assert.NoError(t, ValidateSkillChange(character, &stealth, model.Train, features))
+	assert.EqualError(t, ValidateSkillChange(character, &athletics, model.None, features),
+		"skill Athletics can't be lowered from Trained to None")
+	assert.EqualError(t, ValidateSkillChange(character, &stealth, model.Expert, features),
+		"skill Stealth can only be raised one rank at a time, next is Trained")
+	assert.EqualError(t, ValidateSkillChange(character, &athletics, model.Expert, features),
+		"Expert mastery requires level 3")
+
+	character.CharacterSkill[1].Mastery = model.Train
+	assert.EqualError(t, ValidateSkillChange(character, &arcana, model.Train, features),
+		"no skill training or increase left, 2 of 2 ranks already spent")
+
+	character.Level = 3
+	assert.NoError(t, ValidateSkillChange(character, &athletics, model.Expert, features))
+	assert.NoError(t, ValidateSkillChange(character, &athletics, model.Train, features))
+	assert.EqualError(t, ValidateSkillChange(character, &athletics, "Godlike", features), "unknown mastery Godlike")
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+	"sort"
+)
+
+// MaxFocusPoints is the size of the largest focus pool
+const MaxFocusPoints = 3
+
+// SpellSlots returns the number of slots per rank the class grants at the character level,
+// rank 0 is the number of cantrips
+func SpellSlots(table []*model.SpellCharacterClass, level int8) map[uint8]uint8 {
+	sorted := append([]*model.SpellCharacterClass(nil), table...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Level < sorted[j].Level })
+	slots := map[uint8]uint8{}
+	for _, row := range sorted {
+		if row.Level <= level {
+			slots[row.Rank] = row.SpellCount
+		}
+	}
+	for rank, count := range slots {
+		if count == 0 {
+			delete(slots, rank)
+		}
+	}
+	return slots
+}
+
+// FocusPool returns the focus points granted by the known focus spells
+func FocusPool(spells []model.CharacterSpell) uint8 {
+	var pool uint8
+	for _, spell := range spells {
+		if spell.Focus && pool < MaxFocusPoints {
+			pool++
+		}
+	}
+	return pool
+}
+
+// HasTradition reports whether the spell belongs to the tradition
+func HasTradition(spell *model.Spell, traditionID *uint) bool {
+	if traditionID == nil {
+		return false
+	}
+	for _, tradition := range spell.Tradition {
+		if tradition.ID == *traditionID {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateSpellRank checks that the spell can be cast from a slot of the rank
+func ValidateSpellRank(spell *model.Spell, rank uint8, slots map[uint8]uint8) error {
+	if spell.Ritual {
+		return fmt.Errorf("%s is a ritual", spell.Name)
+	}
+	if (spell.Rank == 0) != (rank == 0) {
+		return fmt.Errorf("%s can't be cast at rank %d", spell.Name, rank)
+	}
+	if rank < spell.Rank {
+		return fmt.Errorf("%s can't be cast below rank %d", spell.Name, spell.Rank)
+	}
+	if slots[rank] == 0 {
+		return fmt.Errorf("no spell slots of rank %d", rank)
+	}
+	return nil
+}
+
+// ValidateLearnSpell checks that the character can add the spell to its repertoire or spellbook
+func ValidateLearnSpell(
+	characterClass *model.CharacterClass,
+	slots map[uint8]uint8,
+	known []model.CharacterSpell,
+	spell *model.Spell,
+	rank uint8,
+) error {
+	if characterClass.Spellcasting == nil {
+		return fmt.Errorf("%s doesn't cast spells", characterClass.Name)
+	}
+	if !HasTradition(spell, characterClass.TraditionID) {
+		return fmt.Errorf("%s isn't in the tradition of %s", spell.Name, characterClass.Name)
+	}
+	if err := ValidateSpellRank(spell, rank, slots); err != nil {
+		return err
+	}
+	if *characterClass.Spellcasting != model.Spontaneous {
+		return nil
+	}
+	var count uint8
+	for _, knownSpell := range known {
+		if !knownSpell.Focus && knownSpell.Rank == rank {
+			count++
+		}
+	}
+	if count >= slots[rank] {
+		return fmt.Errorf("repertoire of rank %d is full", rank)
+	}
+	return nil
+}
+
+// ValidatePreparation checks the daily preparation of a prepared caster against its spellbook and slots
+func ValidatePreparation(
+	slots map[uint8]uint8,
+	known []model.CharacterSpell,
+	picks []model.PreparedSpellCreate,
+) error {
+	spellbook := map[uint]*model.Spell{}
+	for i := range known {
+		if !known[i].Focus {
+			spellbook[known[i].SpellID] = &known[i].Spell
+		}
+	}
+	used := map[uint8]uint8{}
+	for _, pick := range picks {
+		spell, ok := spellbook[pick.SpellID]
+		if !ok {
+			return fmt.Errorf("spell %d isn't in the spellbook", pick.SpellID)
+		}
+		if err := ValidateSpellRank(spell, pick.Rank, slots); err != nil {
+			return err
+		}
+		used[pick.Rank]++
+		if used[pick.Rank] > slots[pick.Rank] {
+			return fmt.Errorf("too many spells prepared at rank %d", pick.Rank)
+		}
+	}
+	return nil
+}
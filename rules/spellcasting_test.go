@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestSpellSlots(t *testing.T) {
+	table := []*model.SpellCharacterClass{
+		{Level: 3, Rank: 2, SpellCount: 2},
+		{Level: 1, Rank: 0, SpellCount: 5},
+		{Level: 1, Rank: 1, SpellCount: 2},
+		{Level: 2, Rank: 1, SpellCount: 3},
+	}
+	assert.Equal(t, map[uint8]uint8{0: 5, 1: 2}, SpellSlots(table, 1))
+	assert.Equal(t, map[uint8]uint8{0: 5, 1: 3, 2: 2}, SpellSlots(table, 3))
+}
+
+func TestValidateLearnSpell(t *testing.T) {
+	arcane := uint(1)
+	spontaneous := model.Spontaneous
+	sorcerer := &model.CharacterClass{Name: "Sorcerer", TraditionID: &arcane, Spellcasting: &spontaneous}
+	slots := map[uint8]uint8{0: 5, 1: 1}
+	missile := &model.Spell{ID: 1, Name: "Force Barrage", Rank: 1, Tradition: []model.Tradition{{ID: arcane}}}
+	heal := &model.Spell{ID: 2, Name: "Heal", Rank: 1, Tradition: []model.Tradition{{ID: 2}}}
+	fireball := &model.Spell{ID: 3, Name: "Fireball", Rank: 3, Tradition: []model.Tradition{{ID: arcane}}}
+
+	assert.NoError(t, ValidateLearnSpell(sorcerer, slots, nil, missile, 1))
+	assert.Error(t, ValidateLearnSpell(sorcerer, slots, nil, heal, 1))
+	assert.Error(t, ValidateLearnSpell(sorcerer, slots, nil, fireball, 3))
+	assert.Error(t, ValidateLearnSpell(sorcerer, slots, nil, missile, 0))
+	assert.Error(t, ValidateLearnSpell(sorcerer, slots,
+		[]model.CharacterSpell{{SpellID: 4, Rank: 1}}, missile, 1))
+	assert.Error(t, ValidateLearnSpell(&model.CharacterClass{Name: "Fighter"}, slots, nil, missile, 1))
+
+	prepared := model.Prepared
+	wizard := &model.CharacterClass{Name: "Wizard", TraditionID: &arcane, Spellcasting: &prepared}
+	assert.NoError(t, ValidateLearnSpell(wizard, slots,
+		[]model.CharacterSpell{{SpellID: 4, Rank: 1}}, missile, 1))
+}
+
+func TestValidatePreparation(t *testing.T) {
+	slots := map[uint8]uint8{0: 2, 1: 1}
+	known := []model.CharacterSpell{
+		{SpellID: 1, Rank: 1, Spell: model.Spell{ID: 1, Name: "Force Barrage", Rank: 1}},
+		{SpellID: 2, Spell: model.Spell{ID: 2, Name: "Light"}},
+		{SpellID: 3, Focus: true, Spell: model.Spell{ID: 3, Name: "Force Bolt", Rank: 1}},
+	}
+
+	assert.NoError(t, ValidatePreparation(slots, known, []model.PreparedSpellCreate{
+		{SpellID: 1, Rank: 1},
+		{SpellID: 2},
+	}))
+	assert.Error(t, ValidatePreparation(slots, known, []model.PreparedSpellCreate{
+		{SpellID: 1, Rank: 1},
+		{SpellID: 1, Rank: 1},
+	}))
+	assert.Error(t, ValidatePreparation(slots, known, []model.PreparedSpellCreate{{SpellID: 3, Rank: 1}}))
+	assert.Error(t, ValidatePreparation(slots, known, []model.PreparedSpellCreate{{SpellID: 2, Rank: 1}}))
+}
+
+func TestFocusPool(t *testing.T) {
+	assert.Equal(t, uint8(0), FocusPool([]model.CharacterSpell{{SpellID: 1}}))
+	assert.Equal(t, uint8(3), FocusPool([]model.CharacterSpell{
+		{SpellID: 1, Focus: true},
+		{SpellID: 2, Focus: true},
+		{SpellID: 3, Focus: true},
+		{SpellID: 4, Focus: true},
+	}))
+}
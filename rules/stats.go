@@ -0,0 +1,158 @@
+package rules
+
+import "kingdom/model"
+
+const (
+	SourceBase        = "Base"
+	SourceProficiency = "Proficiency"
+	SourceItem        = "Item"
+)
+
+// Sheet holds everything needed to derive the statistics of a character
+type Sheet struct {
+	CharacterID  uint
+	Level        int8
+	Attribute    model.Attribute
+	Defence      model.CharacterDefence
+	KeyAbility   *model.Ability
+	Skills       []model.CharacterSkill
+	SkillAbility map[string]model.Ability
+	Armor        *model.Armor
+	ArmorPotency int
+	Resilient    int
+	Shield       *model.Shield
+	ShieldRaised bool
+	Conditions   []model.CharacterCondition
+}
+
+// Compute derives armor class, saves, perception, class DC and skill modifiers of the sheet
+func Compute(sheet *Sheet) *model.CharacterStatsExternal {
+	stats := &model.CharacterStatsExternal{
+		CharacterID: sheet.CharacterID,
+		Level:       sheet.Level,
+		ArmorClass:  armorClass(sheet),
+		Fortitude:   save(sheet, "Fortitude", model.Constitution, sheet.Defence.Fortitude),
+		Reflex:      save(sheet, "Reflex", model.Dexterity, sheet.Defence.Reflex),
+		Will:        save(sheet, "Will", model.Wisdom, sheet.Defence.Will),
+		Perception:  check(sheet, "Perception", model.Wisdom, sheet.Defence.Perception),
+		ClassDC:     classDC(sheet),
+		Skills:      []model.Statistic{},
+	}
+	for _, skill := range sheet.Skills {
+		stats.Skills = append(stats.Skills, skillCheck(sheet, skill))
+	}
+	return stats
+}
+
+// KeyAbility returns the class key ability or, when the class has none, the highest attribute
+func KeyAbility(sheet *Sheet) model.Ability {
+	if sheet.KeyAbility != nil {
+		return *sheet.KeyAbility
+	}
+	best := model.Strength
+	for _, ability := range []model.Ability{
+		model.Dexterity, model.Constitution, model.Intelligence, model.Wisdom, model.Charisma,
+	} {
+		if AttributeScore(&sheet.Attribute, ability) > AttributeScore(&sheet.Attribute, best) {
+			best = ability
+		}
+	}
+	return best
+}
+
+func armorClass(sheet *Sheet) model.Statistic {
+	stat := model.Statistic{Name: "Armor Class"}
+	addModifier(&stat, SourceBase, 10)
+	dexterity := AttributeModifier(sheet.Attribute.Dexterity)
+	if sheet.Armor != nil && sheet.Armor.DexCap != nil {
+		dexterity = min(dexterity, int(*sheet.Armor.DexCap))
+	}
+	addModifier(&stat, string(model.Dexterity), dexterity)
+
+	mastery := sheet.Defence.Unarmed
+	if sheet.Armor != nil {
+		mastery = ArmorMastery(&sheet.Defence, sheet.Armor.Category)
+	}
+	addProficiency(&stat, sheet, mastery)
+
+	if sheet.Armor != nil {
+		addModifier(&stat, SourceItem, int(sheet.Armor.ArmorClass)+sheet.ArmorPotency)
+	}
+	if sheet.Shield != nil && sheet.ShieldRaised {
+		addModifier(&stat, SourceShield, int(sheet.Shield.ArmorClass))
+	}
+	ability := model.Dexterity
+	addConditions(&stat, sheet, &ability, true)
+	return stat
+}
+
+func check(sheet *Sheet, name string, ability model.Ability, mastery model.MasteryLevel) model.Statistic {
+	stat := model.Statistic{Name: name}
+	addAttribute(&stat, sheet, ability)
+	addProficiency(&stat, sheet, mastery)
+	addConditions(&stat, sheet, &ability, false)
+	return stat
+}
+
+// save adds the item bonus of the resilient rune to the check
+func save(sheet *Sheet, name string, ability model.Ability, mastery model.MasteryLevel) model.Statistic {
+	stat := check(sheet, name, ability, mastery)
+	if sheet.Resilient != 0 {
+		addModifier(&stat, SourceItem, sheet.Resilient)
+	}
+	return stat
+}
+
+func classDC(sheet *Sheet) model.Statistic {
+	stat := model.Statistic{Name: "Class DC"}
+	addModifier(&stat, SourceBase, 10)
+	keyAbility := KeyAbility(sheet)
+	addAttribute(&stat, sheet, keyAbility)
+	addProficiency(&stat, sheet, model.Train)
+	addConditions(&stat, sheet, &keyAbility, false)
+	return stat
+}
+
+func skillCheck(sheet *Sheet, skill model.CharacterSkill) model.Statistic {
+	stat := model.Statistic{Name: skill.Name}
+	var ability *model.Ability
+	skillAbility, ok := sheet.SkillAbility[skill.Name]
+	if skill.Lore {
+		skillAbility, ok = LoreAbility, true
+	}
+	if ok {
+		ability = &skillAbility
+		addAttribute(&stat, sheet, skillAbility)
+	}
+	addProficiency(&stat, sheet, skill.Mastery)
+	if ability != nil {
+		if penalty := ArmorCheckPenalty(sheet.Armor, sheet.Attribute.Strength, skill.Name, *ability); penalty > 0 {
+			addModifier(&stat, SourceArmor, -penalty)
+		}
+	}
+	addConditions(&stat, sheet, ability, false)
+	return stat
+}
+
+func addAttribute(stat *model.Statistic, sheet *Sheet, ability model.Ability) {
+	addModifier(stat, string(ability), AttributeModifier(AttributeScore(&sheet.Attribute, ability)))
+}
+
+func addProficiency(stat *model.Statistic, sheet *Sheet, mastery model.MasteryLevel) {
+	if mastery == "" {
+		mastery = model.None
+	}
+	stat.Mastery = mastery
+	addModifier(stat, SourceProficiency, ProficiencyBonus(mastery, sheet.Level))
+}
+
+func addConditions(stat *model.Statistic, sheet *Sheet, ability *model.Ability, armorClass bool) {
+	for _, modifier := range ConditionPenalties(sheet.Conditions, ability, armorClass) {
+		addModifier(stat, modifier.Source, modifier.Value)
+	}
+}
+
+func addModifier(stat *model.Statistic, source string, value int) {
+	stat.Modifiers = append(stat.Modifiers, model.Modifier{Source: source, Value: value})
+	stat.Value += value
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func TestAttributeModifier(t *testing.T) {
+	assert.Equal(t, -1, AttributeModifier(8))
+	assert.Equal(t, -1, AttributeModifier(9))
+	assert.Equal(t, 0, AttributeModifier(10))
+	assert.Equal(t, 0, AttributeModifier(11))
+	assert.Equal(t, 4, AttributeModifier(18))
+	assert.Equal(t, -5, AttributeModifier(1))
+}
+
+func TestProficiencyBonus(t *testing.T) {
+	assert.Equal(t, 0, ProficiencyBonus(model.None, 5))
+	assert.Equal(t, 7, ProficiencyBonus(model.Train, 5))
+	assert.Equal(t, 9, ProficiencyBonus(model.Expert, 5))
+	assert.Equal(t, 11, ProficiencyBonus(model.Master, 5))
+	assert.Equal(t, 13, ProficiencyBonus(model.Legendary, 5))
+}
+
+func TestCompute(t *testing.T) {
+	sheet := &Sheet{
+		CharacterID: 1,
+		Level:       3,
+		Attribute: model.Attribute{
+			Strength: 18, Dexterity: 14, Constitution: 12,
+			Intelligence: 10, Wisdom: 12, Charisma: 8,
+		},
+		Defence: model.CharacterDefence{
+			Unarmed:    model.Train,
+			LightArmor: model.Train,
+			Fortitude:  model.Expert,
+			Reflex:     model.Train,
+			Will:       model.Train,
+			Perception: model.Expert,
+		},
+		Skills: []model.CharacterSkill{
+			{Name: "Athletics", Mastery: model.Train},
+			{Name: "Diplomacy", Mastery: model.None},
+		},
+		SkillAbility: map[string]model.Ability{
+			"Athletics": model.Strength,
+			"Diplomacy": model.Charisma,
+		},
+	}
+
+	stats := Compute(sheet)
+	assert.Equal(t, 17, stats.ArmorClass.Value)
+	assert.Equal(t, 8, stats.Fortitude.Value)
+	assert.Equal(t, 7, stats.Reflex.Value)
+	assert.Equal(t, 6, stats.Will.Value)
+	assert.Equal(t, 8, stats.Perception.Value)
+	assert.Equal(t, 19, stats.ClassDC.Value)
+	assert.Equal(t, 9, stats.Skills[0].Value)
+	assert.Equal(t, -1, stats.Skills[1].Value)
+	assert.Equal(t, model.None, stats.Skills[1].Mastery)
+
+	sheet.Armor = &model.Armor{ArmorClass: 2}
+	stats = Compute(sheet)
+	assert.Equal(t, 19, stats.ArmorClass.Value)
+	assert.Contains(t, stats.ArmorClass.Modifiers, model.Modifier{Source: SourceItem, Value: 2})
+}
+
+func TestComputeConditions(t *testing.T) {
+	dexterity := model.Dexterity
+	sheet := &Sheet{
+		Level:     1,
+		Attribute: model.Attribute{Strength: 10, Dexterity: 14, Constitution: 10, Intelligence: 10, Wisdom: 10, Charisma: 10},
+		Defence:   model.CharacterDefence{Unarmed: model.Train, Reflex: model.Train, Will: model.Train},
+		Conditions: []model.CharacterCondition{
+			{Count: 2, Condition: model.Condition{Name: "Frightened", Valued: true, PenaltyType: StatusPenalty, AllChecks: true}},
+			{Count: 1, Condition: model.Condition{Name: "Clumsy", Valued: true, PenaltyType: StatusPenalty, Abilities: []model.ConditionAbility{{Ability: dexterity}}}},
+			{Count: 1, Condition: model.Condition{Name: "Off-Guard", PenaltyType: CircumstancePenalty, ArmorClass: 2}},
+		},
+	}
+
+	stats := Compute(sheet)
+	assert.Equal(t, 11, stats.ArmorClass.Value)
+	assert.Contains(t, stats.ArmorClass.Modifiers, model.Modifier{Source: "Off-Guard", Value: -2})
+	assert.Equal(t, 3, stats.Reflex.Value)
+	assert.Equal(t, 1, stats.Will.Value)
+	assert.Equal(t, -2, stats.Perception.Value)
+}
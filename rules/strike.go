@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"fmt"
+	"kingdom/model"
+)
+
+const (
+	StrikeMelee  = "melee"
+	StrikeRanged = "ranged"
+	StrikeThrown = "thrown"
+)
+
+const SourceMultipleAttack = "Multiple Attack Penalty"
+
+// Weapon is an equipped weapon with the bonuses of its instance
+type Weapon struct {
+	CharacterItemID *uint
+	Name            string
+	Weapon          model.Weapon
+	ItemBonus       int
+	Striking        int
+	Runes           []model.Rune
+}
+
+// Fist is the unarmed attack every character has
+var Fist = model.Weapon{
+	DiceQuantity: 1,
+	Dice:         4,
+	DamageType:   "bludgeoning",
+	Category:     model.UnarmedWeapon,
+	Agile:        true,
+	Finesse:      true,
+}
+
+// WeaponMastery returns the proficiency of the defence in the weapon category
+func WeaponMastery(defence *model.CharacterDefence, category model.WeaponCategory) model.MasteryLevel {
+	switch category {
+	case model.UnarmedWeapon:
+		return defence.UnArmedWeapon
+	case model.SimpleWeapon, "":
+		return defence.CommonWeapon
+	case model.MartialWeapon:
+		return defence.MartialWeapon
+	}
+	return model.None
+}
+
+// WeaponTraits returns the names of the traits the weapon has
+func WeaponTraits(weapon *model.Weapon) []string {
+	traits := []string{}
+	for _, trait := range []struct {
+		name string
+		has  bool
+	}{
+		{"agile", weapon.Agile},
+		{"finesse", weapon.Finesse},
+		{"propulsive", weapon.Propulsive},
+		{"thrown", weapon.Thrown},
+	} {
+		if trait.has {
+			traits = append(traits, trait.name)
+		}
+	}
+	return traits
+}
+
+// Strikes returns the strikes possible with the weapon, a thrown melee weapon can also be thrown
+func Strikes(sheet *Sheet, weapon *Weapon) []model.StrikeExternal {
+	if weapon.Weapon.Ranged {
+		return []model.StrikeExternal{strike(sheet, weapon, StrikeRanged)}
+	}
+	strikes := []model.StrikeExternal{strike(sheet, weapon, StrikeMelee)}
+	if weapon.Weapon.Thrown {
+		strikes = append(strikes, strike(sheet, weapon, StrikeThrown))
+	}
+	return strikes
+}
+
+func strike(sheet *Sheet, weapon *Weapon, kind string) model.StrikeExternal {
+	ability := attackAbility(sheet, &weapon.Weapon, kind)
+	first := model.Statistic{Name: weapon.Name}
+	addAttribute(&first, sheet, ability)
+	addProficiency(&first, sheet, WeaponMastery(&sheet.Defence, weapon.Weapon.Category))
+	if weapon.ItemBonus != 0 {
+		addModifier(&first, SourceItem, weapon.ItemBonus)
+	}
+	addConditions(&first, sheet, &ability, false)
+
+	penalty := 5
+	if weapon.Weapon.Agile {
+		penalty = 4
+	}
+	attacks := []model.Statistic{first}
+	for i := 1; i <= 2; i++ {
+		attack := first
+		attack.Modifiers = append([]model.Modifier(nil), first.Modifiers...)
+		addModifier(&attack, SourceMultipleAttack, -penalty*i)
+		attacks = append(attacks, attack)
+	}
+
+	damageModifiers := []model.Modifier{}
+	if modifier, ok := strengthDamage(sheet, &weapon.Weapon, kind); ok {
+		damageModifiers = append(damageModifiers, model.Modifier{Source: string(model.Strength), Value: modifier})
+	}
+	// the striking rune adds weapon damage dice
+	damageWeapon := weapon.Weapon
+	damageWeapon.DiceQuantity += uint8(weapon.Striking)
+	return model.StrikeExternal{
+		CharacterItemID: weapon.CharacterItemID,
+		Name:            weapon.Name,
+		Kind:            kind,
+		Category:        weapon.Weapon.Category,
+		Traits:          WeaponTraits(&weapon.Weapon),
+		Attacks:         attacks,
+		Damage:          DamageExpression(&damageWeapon, damageModifiers),
+		DamageModifiers: damageModifiers,
+		ExtraDamage:     RuneDamage(weapon.Runes),
+	}
+}
+
+// attackAbility returns Dexterity for ranged attacks and for finesse weapons when it is higher
+func attackAbility(sheet *Sheet, weapon *model.Weapon, kind string) model.Ability {
+	if kind != StrikeMelee {
+		return model.Dexterity
+	}
+	if weapon.Finesse && AttributeScore(&sheet.Attribute, model.Dexterity) > AttributeScore(&sheet.Attribute, model.Strength) {
+		return model.Dexterity
+	}
+	return model.Strength
+}
+
+// strengthDamage returns the Strength modifier added to damage, propulsive weapons add half of a bonus
+func strengthDamage(sheet *Sheet, weapon *model.Weapon, kind string) (int, bool) {
+	modifier := AttributeModifier(sheet.Attribute.Strength)
+	switch {
+	case kind == StrikeMelee || kind == StrikeThrown || weapon.Thrown:
+		return modifier, modifier != 0
+	case weapon.Propulsive && modifier > 0:
+		return modifier / 2, modifier/2 != 0
+	case weapon.Propulsive:
+		return modifier, modifier != 0
+	}
+	return 0, false
+}
+
+// DamageExpression formats the damage roll of the weapon, like 1d8+4 slashing
+func DamageExpression(weapon *model.Weapon, modifiers []model.Modifier) string {
+	expression := fmt.Sprintf("%dd%d", weapon.DiceQuantity, weapon.Dice)
+	total := 0
+	for _, modifier := range modifiers {
+		total += modifier.Value
+	}
+	if total != 0 {
+		expression += fmt.Sprintf("%+d", total)
+	}
+	if weapon.DamageType != "" {
+		expression += " " + weapon.DamageType
+	}
+	return expression
+}
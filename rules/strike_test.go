@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"kingdom/model"
+	"testing"
+)
+
+func strikeSheet() *Sheet {
+	return &Sheet{
+		Level: 1,
+		Attribute: model.Attribute{
+			Strength: 16, Dexterity: 18, Constitution: 10,
+			Intelligence: 10, Wisdom: 10, Charisma: 10,
+		},
+		Defence: model.CharacterDefence{
+			UnArmedWeapon: model.Train,
+			CommonWeapon:  model.Train,
+			MartialWeapon: model.Expert,
+		},
+	}
+}
+
+func TestStrikes(t *testing.T) {
+	sheet := strikeSheet()
+	longsword := &Weapon{Name: "Longsword", Weapon: model.Weapon{
+		DiceQuantity: 1, Dice: 8, DamageType: "slashing", Category: model.MartialWeapon,
+	}}
+	strikes := Strikes(sheet, longsword)
+	assert.Len(t, strikes, 1)
+	assert.Equal(t, StrikeMelee, strikes[0].Kind)
+	assert.Equal(t, 3+5, strikes[0].Attacks[0].Value)
+	assert.Equal(t, 3, strikes[0].Attacks[1].Value)
+	assert.Equal(t, -2, strikes[0].Attacks[2].Value)
+	assert.Equal(t, "1d8+3 slashing", strikes[0].Damage)
+
+	dagger := &Weapon{Name: "Dagger", ItemBonus: 1, Weapon: model.Weapon{
+		DiceQuantity: 1, Dice: 4, DamageType: "piercing", Category: model.SimpleWeapon,
+		Agile: true, Finesse: true, Thrown: true, Range: 10,
+	}}
+	strikes = Strikes(sheet, dagger)
+	assert.Len(t, strikes, 2)
+	assert.Equal(t, []string{"agile", "finesse", "thrown"}, strikes[0].Traits)
+	assert.Equal(t, 4+3+1, strikes[0].Attacks[0].Value)
+	assert.Equal(t, 4, strikes[0].Attacks[1].Value)
+	assert.Equal(t, 0, strikes[0].Attacks[2].Value)
+	assert.Equal(t, StrikeThrown, strikes[1].Kind)
+	assert.Equal(t, "1d4+3 piercing", strikes[1].Damage)
+
+	shortbow := &Weapon{Name: "Composite Shortbow", Weapon: model.Weapon{
+		DiceQuantity: 1, Dice: 6, DamageType: "piercing", Category: model.MartialWeapon,
+		Ranged: true, Range: 60, Propulsive: true,
+	}}
+	strikes = Strikes(sheet, shortbow)
+	assert.Len(t, strikes, 1)
+	assert.Equal(t, 4+5, strikes[0].Attacks[0].Value)
+	assert.Equal(t, "1d6+1 piercing", strikes[0].Damage)
+
+	crossbow := &Weapon{Name: "Crossbow", Weapon: model.Weapon{
+		DiceQuantity: 1, Dice: 8, DamageType: "piercing", Category: model.SimpleWeapon, Ranged: true,
+	}}
+	assert.Equal(t, "1d8 piercing", Strikes(sheet, crossbow)[0].Damage)
+
+	fist := &Weapon{Name: "Fist", Weapon: Fist}
+	assert.Contains(t, Strikes(sheet, fist)[0].Attacks[1].Modifiers,
+		model.Modifier{Source: SourceMultipleAttack, Value: -4})
+	assert.Equal(t, model.None, WeaponMastery(&sheet.Defence, model.AdvancedWeapon))
+}
@@ -33,4 +33,70 @@ BEGIN
     IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'rarity') THEN
 CREATE TYPE rarity AS ENUM ('Common', 'Uncommon', 'Rare', 'Mythic');
 END IF;
-END $$;
\ No newline at end of file
+END $$;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'character_defences' AND column_name = 'wounded' AND data_type = 'boolean') THEN
+        ALTER TABLE character_defences ALTER COLUMN wounded DROP DEFAULT;
+        ALTER TABLE character_defences ALTER COLUMN wounded TYPE smallint USING CASE WHEN wounded THEN 1 ELSE 0 END;
+        ALTER TABLE character_defences ALTER COLUMN wounded SET DEFAULT 0;
+    END IF;
+END $$;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'spellcasting') THEN
+        CREATE TYPE spellcasting AS ENUM ('Prepared', 'Spontaneous');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'weapon_category') THEN
+        CREATE TYPE weapon_category AS ENUM ('Unarmed', 'Simple', 'Martial', 'Advanced');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'feat_category') THEN
+        CREATE TYPE feat_category AS ENUM ('Ancestry', 'Class', 'Skill', 'General', 'Archetype');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'item_placement') THEN
+        CREATE TYPE item_placement AS ENUM ('Worn', 'Carried', 'Stowed');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'armor_category') THEN
+        CREATE TYPE armor_category AS ENUM ('Unarmored', 'Light', 'Medium', 'Heavy');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'consumable_kind') THEN
+        CREATE TYPE consumable_kind AS ENUM ('Consumable', 'Wand', 'Staff');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_indexes WHERE indexname = 'idx_character_item' AND indexdef LIKE 'CREATE UNIQUE%') THEN
+        DROP INDEX idx_character_item;
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'items' AND column_name = 'price_copper' AND column_default = '0') THEN
+        ALTER TABLE items ALTER COLUMN price_copper DROP DEFAULT;
+        UPDATE items SET price_copper = NULL WHERE price_copper = 0;
+    END IF;
+END $$;
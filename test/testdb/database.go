@@ -18,7 +18,9 @@ type Database struct {
 
 func NewDBWithDefaultUser(t *testing.T) *Database {
 	db, err := gorm.Open(sqlite.Open("file:%s?mode=memory&cache=shared"), &gorm.Config{})
-	db.AutoMigrate(new(model.User), new(model.Character), new(model.Domain), new(model.God))
+	db.AutoMigrate(new(model.User), new(model.Character), new(model.Domain), new(model.God),
+		new(model.Item), new(model.Slot), new(model.CharacterItem), new(model.CharacterSkill),
+		new(model.Campaign))
 	userCount := int64(0)
 	db.Find(new(model.User)).Count(&userCount)
 	if userCount == 0 {
@@ -38,7 +40,9 @@ func NewDBWithDefaultUser(t *testing.T) *Database {
 
 func NewDB(t *testing.T) *Database {
 	db, err := gorm.Open(sqlite.Open("file:%s?mode=memory&cache=shared"), &gorm.Config{})
-	db.AutoMigrate(new(model.User), new(model.Character), new(model.Domain), new(model.God))
+	db.AutoMigrate(new(model.User), new(model.Character), new(model.Domain), new(model.God),
+		new(model.Item), new(model.Slot), new(model.CharacterItem), new(model.CharacterSkill),
+		new(model.Campaign))
 	assert.Nil(t, err)
 	assert.NotNil(t, db)
 	tdb := &database.GormDatabase{